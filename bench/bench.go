@@ -0,0 +1,313 @@
+package bench
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+bench.go
+Description:
+	A benchmark harness which solves a set of model files under several
+	parameter configurations and random seeds, collects runtime, gap and node
+	statistics, and summarizes them in a comparison table.
+*/
+
+/*
+Config
+Description:
+
+	A named set of parameter values which is applied to every model before it is solved.
+*/
+type Config struct {
+	Name         string
+	IntParams    map[string]int
+	DBLParams    map[string]float64
+	StringParams map[string]string
+}
+
+/*
+Run
+Description:
+
+	The outcome of solving one model file with one configuration and seed.
+	Metrics which are not available (e.g., the gap of an LP) are NaN.
+	Err is set if the model could not be loaded or solved.
+*/
+type Run struct {
+	Model     string
+	Config    string
+	Seed      int
+	Status    gurobi.Status
+	ObjVal    float64
+	MIPGap    float64
+	Runtime   float64
+	NodeCount float64
+	Err       error
+}
+
+/*
+Harness
+Description:
+
+	Describes a benchmark: every file in ModelFiles is solved under every
+	Config with every seed in Seeds. Solver output is written to LogFile
+	(which may be empty to disable logging to a file).
+*/
+type Harness struct {
+	ModelFiles []string
+	Configs    []Config
+	Seeds      []int
+	LogFile    string
+}
+
+/*
+Check
+Description:
+
+	Checks that the harness has at least one model, configuration and seed,
+	and that the configuration names are unique.
+*/
+func (h *Harness) Check() error {
+	if len(h.ModelFiles) == 0 {
+		return fmt.Errorf("the harness has no model files")
+	}
+	if len(h.Configs) == 0 {
+		return fmt.Errorf("the harness has no configurations")
+	}
+	if len(h.Seeds) == 0 {
+		return fmt.Errorf("the harness has no seeds")
+	}
+
+	names := map[string]bool{}
+	for _, config := range h.Configs {
+		if names[config.Name] {
+			return fmt.Errorf("the configuration name \"%v\" is used more than once", config.Name)
+		}
+		names[config.Name] = true
+	}
+	return nil
+}
+
+/*
+Run
+Description:
+
+	Runs the whole benchmark and returns one Run per (model, config, seed).
+	A failure to solve a single model is recorded in the Run's Err rather than
+	stopping the benchmark; an error is returned only for an invalid harness
+	or when no environment could be created.
+*/
+func (h *Harness) Run() ([]Run, error) {
+	if err := h.Check(); err != nil {
+		return nil, err
+	}
+
+	env, err := gurobi.NewEnv(h.LogFile)
+	if err != nil {
+		return nil, err
+	}
+	defer env.Free()
+
+	runs := []Run{}
+	for _, file := range h.ModelFiles {
+		for _, config := range h.Configs {
+			for _, seed := range h.Seeds {
+				runs = append(runs, solveOne(env, file, config, seed))
+			}
+		}
+	}
+	return runs, nil
+}
+
+// solveOne loads file, applies config and seed, optimizes and collects the statistics.
+func solveOne(env *gurobi.Env, file string, config Config, seed int) Run {
+	run := Run{
+		Model:     filepath.Base(file),
+		Config:    config.Name,
+		Seed:      seed,
+		ObjVal:    math.NaN(),
+		MIPGap:    math.NaN(),
+		Runtime:   math.NaN(),
+		NodeCount: math.NaN(),
+	}
+
+	model, err := gurobi.LoadModel(file, env)
+	if err != nil {
+		run.Err = err
+		return run
+	}
+	defer model.Free()
+
+	if err := applyConfig(model, config, seed); err != nil {
+		run.Err = err
+		return run
+	}
+
+	if err := model.Optimize(); err != nil {
+		run.Err = err
+		return run
+	}
+
+	if run.Status, err = model.Status(); err != nil {
+		run.Err = err
+		return run
+	}
+
+	metrics := []struct {
+		dst *float64
+		get func() (float64, error)
+	}{
+		{&run.ObjVal, model.ObjVal},
+		{&run.MIPGap, model.MIPGap},
+		{&run.Runtime, model.Runtime},
+		{&run.NodeCount, model.NodeCount},
+	}
+	for _, m := range metrics {
+		value, err := m.get()
+		if errors.Is(err, gurobi.ErrDataNotAvailable) {
+			continue
+		} else if err != nil {
+			run.Err = err
+			return run
+		}
+		*m.dst = value
+	}
+
+	return run
+}
+
+// applyConfig sets the parameters of config (and the Seed parameter) on the model.
+func applyConfig(model *gurobi.Model, config Config, seed int) error {
+	for name, value := range config.IntParams {
+		if err := model.SetIntParam(name, value); err != nil {
+			return err
+		}
+	}
+	for name, value := range config.DBLParams {
+		if err := model.SetDBLParam(name, value); err != nil {
+			return err
+		}
+	}
+	for name, value := range config.StringParams {
+		if err := model.SetStringParam(name, value); err != nil {
+			return err
+		}
+	}
+	return model.SetIntParam("Seed", seed)
+}
+
+/*
+Summary
+Description:
+
+	Aggregated statistics of all seeds of one (model, config) pair.
+	Means are taken over the runs without errors for which the metric is available.
+*/
+type Summary struct {
+	Model         string
+	Config        string
+	Runs          int
+	Failed        int
+	Optimal       int
+	MeanRuntime   float64
+	MeanMIPGap    float64
+	MeanNodeCount float64
+}
+
+/*
+Summarize
+Description:
+
+	Groups runs by model and configuration and computes their Summary.
+	The summaries are sorted by model, then by the configuration's first
+	appearance in runs.
+*/
+func Summarize(runs []Run) []Summary {
+	type key struct{ model, config string }
+
+	configOrder := map[string]int{}
+	groups := map[key][]Run{}
+	for _, run := range runs {
+		if _, ok := configOrder[run.Config]; !ok {
+			configOrder[run.Config] = len(configOrder)
+		}
+		k := key{run.Model, run.Config}
+		groups[k] = append(groups[k], run)
+	}
+
+	summaries := make([]Summary, 0, len(groups))
+	for k, group := range groups {
+		s := Summary{Model: k.model, Config: k.config, Runs: len(group)}
+		runtimes, gaps, nodes := []float64{}, []float64{}, []float64{}
+		for _, run := range group {
+			if run.Err != nil {
+				s.Failed++
+				continue
+			}
+			if run.Status == gurobi.StatusOptimal {
+				s.Optimal++
+			}
+			runtimes = appendIfNumber(runtimes, run.Runtime)
+			gaps = appendIfNumber(gaps, run.MIPGap)
+			nodes = appendIfNumber(nodes, run.NodeCount)
+		}
+		s.MeanRuntime = mean(runtimes)
+		s.MeanMIPGap = mean(gaps)
+		s.MeanNodeCount = mean(nodes)
+		summaries = append(summaries, s)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Model != summaries[j].Model {
+			return summaries[i].Model < summaries[j].Model
+		}
+		return configOrder[summaries[i].Config] < configOrder[summaries[j].Config]
+	})
+	return summaries
+}
+
+/*
+WriteTable
+Description:
+
+	Writes the summaries as an aligned comparison table.
+*/
+func WriteTable(w io.Writer, summaries []Summary) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "MODEL\tCONFIG\tRUNS\tOPTIMAL\tFAILED\tRUNTIME\tGAP\tNODES")
+	for _, s := range summaries {
+		fmt.Fprintf(
+			tw, "%v\t%v\t%v\t%v\t%v\t%.3f\t%.4g\t%.0f\n",
+			s.Model, s.Config, s.Runs, s.Optimal, s.Failed,
+			s.MeanRuntime, s.MeanMIPGap, s.MeanNodeCount,
+		)
+	}
+	return tw.Flush()
+}
+
+func appendIfNumber(values []float64, x float64) []float64 {
+	if math.IsNaN(x) {
+		return values
+	}
+	return append(values, x)
+}
+
+// mean returns the mean of values (NaN for an empty slice).
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	sum := 0.0
+	for _, x := range values {
+		sum += x
+	}
+	return sum / float64(len(values))
+}
@@ -0,0 +1,418 @@
+package boolean
+
+import (
+	"fmt"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+compiler.go
+Description:
+	Compiles boolean formulas into linear or general constraints on a gurobi model.
+*/
+
+/*
+Encoding
+Description:
+
+	Chooses how AND/OR operators are turned into constraints.
+*/
+type Encoding int
+
+const (
+	// LinearEncoding uses only linear inequalities on the binary variables.
+	LinearEncoding Encoding = iota
+	// GeneralEncoding uses Gurobi's AND/OR general constraints where possible.
+	GeneralEncoding
+)
+
+/*
+Compiler
+Description:
+
+	Compiles formulas into constraints on Model.
+	When Tseitin is true, Assert introduces an auxiliary binary variable for every
+	compound subformula and fixes the variable of the root formula to 1 (a
+	Tseitin-style transformation). Otherwise, Assert encodes conjunctions and
+	clauses directly and only introduces auxiliaries for nested subformulas.
+	Auxiliary variables and constraints are named using Prefix.
+*/
+type Compiler struct {
+	Model    *gurobi.Model
+	Encoding Encoding
+	Tseitin  bool
+	Prefix   string
+
+	nAux     int
+	nConstrs int
+	negCache map[int32]*gurobi.Var
+}
+
+// term is a variable or its negation (1 - Var) in a linear expression.
+type term struct {
+	Var     *gurobi.Var
+	Negated bool
+}
+
+/*
+NewCompiler
+Description:
+
+	Creates a compiler for model which uses the linear encoding and no Tseitin auxiliaries.
+*/
+func NewCompiler(model *gurobi.Model) *Compiler {
+	return &Compiler{
+		Model:    model,
+		Encoding: LinearEncoding,
+		Prefix:   "bool",
+		negCache: map[int32]*gurobi.Var{},
+	}
+}
+
+/*
+Check
+Description:
+
+	Checks that the compiler's model and encoding are valid.
+*/
+func (c *Compiler) Check() error {
+	if c == nil {
+		return fmt.Errorf("the compiler is nil")
+	}
+
+	if err := c.Model.Check(); err != nil {
+		return err
+	}
+
+	switch c.Encoding {
+	case LinearEncoding, GeneralEncoding:
+	default:
+		return fmt.Errorf("unrecognized encoding %v", c.Encoding)
+	}
+
+	if c.negCache == nil {
+		c.negCache = map[int32]*gurobi.Var{}
+	}
+
+	return nil
+}
+
+/*
+Assert
+Description:
+
+	Adds constraints to the model requiring that f is true.
+*/
+func (c *Compiler) Assert(f Formula) error {
+	if err := c.Check(); err != nil {
+		return err
+	}
+	if f == nil {
+		return fmt.Errorf("the formula is nil")
+	}
+	if err := f.Check(); err != nil {
+		return err
+	}
+
+	if c.Tseitin {
+		y, err := c.reify(f)
+		if err != nil {
+			return err
+		}
+		return c.addLinear([]term{{Var: y}}, []float64{1.0}, gurobi.Ge, 1.0)
+	}
+
+	return c.assert(f)
+}
+
+/*
+Reify
+Description:
+
+	Returns a binary variable which is 1 exactly when f is true.
+	For a literal, the literal's own variable is returned.
+*/
+func (c *Compiler) Reify(f Formula) (*gurobi.Var, error) {
+	if err := c.Check(); err != nil {
+		return nil, err
+	}
+	if f == nil {
+		return nil, fmt.Errorf("the formula is nil")
+	}
+	if err := f.Check(); err != nil {
+		return nil, err
+	}
+
+	return c.reify(f)
+}
+
+// assert encodes f == true directly, pushing negations inward where possible.
+func (c *Compiler) assert(f Formula) error {
+	switch g := f.(type) {
+	case AndFormula:
+		for _, arg := range g.Args {
+			if err := c.assert(arg); err != nil {
+				return err
+			}
+		}
+		return nil
+	case OrFormula:
+		// Clause: sum of the argument terms >= 1
+		terms := make([]term, len(g.Args))
+		for i, arg := range g.Args {
+			t, err := c.termOf(arg)
+			if err != nil {
+				return err
+			}
+			terms[i] = t
+		}
+		return c.addLinear(terms, ones(len(terms)), gurobi.Ge, 1.0)
+	case ImpliesFormula:
+		return c.assert(Or(Not(g.A), g.B))
+	case XorFormula:
+		a, b, err := c.termPair(g.A, g.B)
+		if err != nil {
+			return err
+		}
+		return c.addLinear([]term{a, b}, []float64{1.0, 1.0}, gurobi.Eq, 1.0)
+	case NotFormula:
+		switch h := g.Arg.(type) {
+		case AndFormula:
+			negated := make([]Formula, len(h.Args))
+			for i, arg := range h.Args {
+				negated[i] = Not(arg)
+			}
+			return c.assert(Or(negated...))
+		case OrFormula:
+			negated := make([]Formula, len(h.Args))
+			for i, arg := range h.Args {
+				negated[i] = Not(arg)
+			}
+			return c.assert(And(negated...))
+		case ImpliesFormula:
+			return c.assert(And(h.A, Not(h.B)))
+		case XorFormula:
+			a, b, err := c.termPair(h.A, h.B)
+			if err != nil {
+				return err
+			}
+			return c.addLinear([]term{a, b}, []float64{1.0, -1.0}, gurobi.Eq, 0.0)
+		}
+	}
+
+	// Literals (and negations of literals)
+	t, err := c.termOf(f)
+	if err != nil {
+		return err
+	}
+	return c.addLinear([]term{t}, []float64{1.0}, gurobi.Ge, 1.0)
+}
+
+// reify returns a binary variable equal to the truth value of f.
+func (c *Compiler) reify(f Formula) (*gurobi.Var, error) {
+	switch g := f.(type) {
+	case Literal:
+		return g.Var, nil
+	case NotFormula:
+		t, err := c.termOf(g)
+		if err != nil {
+			return nil, err
+		}
+		return c.varOf(t)
+	case AndFormula:
+		return c.reifyAndOr(true, g.Args)
+	case OrFormula:
+		return c.reifyAndOr(false, g.Args)
+	case ImpliesFormula:
+		return c.reify(Or(Not(g.A), g.B))
+	case XorFormula:
+		a, b, err := c.termPair(g.A, g.B)
+		if err != nil {
+			return nil, err
+		}
+		y, err := c.newAux()
+		if err != nil {
+			return nil, err
+		}
+		yt := term{Var: y}
+
+		// y <= a + b, y >= a - b, y >= b - a, y <= 2 - a - b
+		rows := [][]float64{{1, -1, -1}, {1, -1, 1}, {1, 1, -1}, {1, 1, 1}}
+		senses := []gurobi.Sense{gurobi.Le, gurobi.Ge, gurobi.Ge, gurobi.Le}
+		rhs := []float64{0, 0, 0, 2}
+		for i := range rows {
+			if err := c.addLinear([]term{yt, a, b}, rows[i], senses[i], rhs[i]); err != nil {
+				return nil, err
+			}
+		}
+		return y, nil
+	}
+
+	return nil, fmt.Errorf("unsupported formula type %T", f)
+}
+
+// reifyAndOr returns a variable equal to the AND (isAnd) or OR of args.
+func (c *Compiler) reifyAndOr(isAnd bool, args []Formula) (*gurobi.Var, error) {
+	terms := make([]term, len(args))
+	for i, arg := range args {
+		t, err := c.termOf(arg)
+		if err != nil {
+			return nil, err
+		}
+		terms[i] = t
+	}
+
+	y, err := c.newAux()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Encoding == GeneralEncoding {
+		vars := make([]*gurobi.Var, len(terms))
+		for i, t := range terms {
+			if vars[i], err = c.varOf(t); err != nil {
+				return nil, err
+			}
+		}
+
+		name := c.nextConstrName()
+		if isAnd {
+			_, err = c.Model.AddGenConstrAnd(y, vars, name)
+		} else {
+			_, err = c.Model.AddGenConstrOr(y, vars, name)
+		}
+		return y, err
+	}
+
+	yt := term{Var: y}
+	n := float64(len(terms))
+	sense := gurobi.Le
+	if !isAnd {
+		sense = gurobi.Ge
+	}
+
+	// AND: y <= t_i and y >= sum(t_i) - (n - 1)
+	// OR:  y >= t_i and y <= sum(t_i)
+	for _, t := range terms {
+		if err := c.addLinear([]term{yt, t}, []float64{1.0, -1.0}, sense, 0.0); err != nil {
+			return nil, err
+		}
+	}
+
+	all := append([]term{yt}, terms...)
+	coefs := append([]float64{1.0}, negOnes(len(terms))...)
+	if isAnd {
+		err = c.addLinear(all, coefs, gurobi.Ge, 1.0-n)
+	} else {
+		err = c.addLinear(all, coefs, gurobi.Le, 0.0)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return y, nil
+}
+
+// termOf returns the term which represents f, reifying f when it is not a (negated) literal.
+func (c *Compiler) termOf(f Formula) (term, error) {
+	switch g := f.(type) {
+	case Literal:
+		return term{Var: g.Var}, nil
+	case NotFormula:
+		t, err := c.termOf(g.Arg)
+		if err != nil {
+			return term{}, err
+		}
+		t.Negated = !t.Negated
+		return t, nil
+	}
+
+	y, err := c.reify(f)
+	if err != nil {
+		return term{}, err
+	}
+	return term{Var: y}, nil
+}
+
+func (c *Compiler) termPair(a, b Formula) (term, term, error) {
+	ta, err := c.termOf(a)
+	if err != nil {
+		return term{}, term{}, err
+	}
+	tb, err := c.termOf(b)
+	if err != nil {
+		return term{}, term{}, err
+	}
+	return ta, tb, nil
+}
+
+// varOf returns a variable equal to t, creating (and caching) an auxiliary for negated terms.
+func (c *Compiler) varOf(t term) (*gurobi.Var, error) {
+	if !t.Negated {
+		return t.Var, nil
+	}
+
+	if y, ok := c.negCache[t.Var.Index]; ok {
+		return y, nil
+	}
+
+	y, err := c.newAux()
+	if err != nil {
+		return nil, err
+	}
+
+	// y + x == 1
+	if err := c.addLinear([]term{{Var: y}, {Var: t.Var}}, []float64{1.0, 1.0}, gurobi.Eq, 1.0); err != nil {
+		return nil, err
+	}
+
+	c.negCache[t.Var.Index] = y
+	return y, nil
+}
+
+// newAux adds a new auxiliary binary variable to the model.
+func (c *Compiler) newAux() (*gurobi.Var, error) {
+	name := fmt.Sprintf("%v_aux%v", c.Prefix, c.nAux)
+	c.nAux++
+	return c.Model.AddVar(gurobi.Binary, 0.0, 0.0, 1.0, name, []*gurobi.Constr{}, []float64{})
+}
+
+func (c *Compiler) nextConstrName() string {
+	name := fmt.Sprintf("%v_c%v", c.Prefix, c.nConstrs)
+	c.nConstrs++
+	return name
+}
+
+// addLinear adds the constraint sum(coefs[i] * terms[i]) sense rhs, where a negated term is (1 - Var).
+func (c *Compiler) addLinear(terms []term, coefs []float64, sense gurobi.Sense, rhs float64) error {
+	vars := make([]*gurobi.Var, len(terms))
+	vals := make([]float64, len(terms))
+	for i, t := range terms {
+		vars[i] = t.Var
+		vals[i] = coefs[i]
+		if t.Negated {
+			vals[i] = -coefs[i]
+			rhs -= coefs[i]
+		}
+	}
+
+	_, err := c.Model.AddConstr(vars, vals, sense, rhs, c.nextConstrName())
+	return err
+}
+
+func ones(n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = 1.0
+	}
+	return out
+}
+
+func negOnes(n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = -1.0
+	}
+	return out
+}
@@ -0,0 +1,195 @@
+package boolean
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+formula.go
+Description:
+	Defines boolean formulas (AND, OR, NOT, XOR, IMPLIES) over binary gurobi
+	variables. Formulas are built with Lit, Not, And, Or, Xor and Implies and
+	compiled into constraints with a Compiler.
+*/
+
+// Formula is a boolean formula over binary variables.
+type Formula interface {
+	String() string
+	Check() error
+}
+
+/*
+Literal
+Description:
+
+	The formula which is true exactly when the binary variable Var is 1.
+*/
+type Literal struct {
+	Var *gurobi.Var
+}
+
+// NotFormula is the negation of Arg.
+type NotFormula struct {
+	Arg Formula
+}
+
+// AndFormula is true when all of Args are true.
+type AndFormula struct {
+	Args []Formula
+}
+
+// OrFormula is true when at least one of Args is true.
+type OrFormula struct {
+	Args []Formula
+}
+
+// XorFormula is true when exactly one of A and B is true.
+type XorFormula struct {
+	A Formula
+	B Formula
+}
+
+// ImpliesFormula is true unless A is true and B is false.
+type ImpliesFormula struct {
+	A Formula
+	B Formula
+}
+
+/*
+Constructors
+*/
+
+func Lit(v *gurobi.Var) Formula {
+	return Literal{Var: v}
+}
+
+func Not(f Formula) Formula {
+	return NotFormula{Arg: f}
+}
+
+func And(fs ...Formula) Formula {
+	return AndFormula{Args: fs}
+}
+
+func Or(fs ...Formula) Formula {
+	return OrFormula{Args: fs}
+}
+
+func Xor(a, b Formula) Formula {
+	return XorFormula{A: a, B: b}
+}
+
+func Implies(a, b Formula) Formula {
+	return ImpliesFormula{A: a, B: b}
+}
+
+/*
+Check
+Description:
+
+	Each Check verifies that the formula (and all of its subformulas) are well formed.
+*/
+
+func (l Literal) Check() error {
+	if l.Var == nil || l.Var.Index < 0 {
+		return fmt.Errorf("the literal's variable is invalid")
+	}
+	return nil
+}
+
+func (f NotFormula) Check() error {
+	return checkArgs("Not", []Formula{f.Arg})
+}
+
+func (f AndFormula) Check() error {
+	if len(f.Args) == 0 {
+		return fmt.Errorf("And requires at least one argument")
+	}
+	return checkArgs("And", f.Args)
+}
+
+func (f OrFormula) Check() error {
+	if len(f.Args) == 0 {
+		return fmt.Errorf("Or requires at least one argument")
+	}
+	return checkArgs("Or", f.Args)
+}
+
+func (f XorFormula) Check() error {
+	return checkArgs("Xor", []Formula{f.A, f.B})
+}
+
+func (f ImpliesFormula) Check() error {
+	return checkArgs("Implies", []Formula{f.A, f.B})
+}
+
+// checkArgs checks each argument of the operator op.
+func checkArgs(op string, args []Formula) error {
+	for i, arg := range args {
+		if arg == nil {
+			return fmt.Errorf("argument %v of %v is nil", i, op)
+		}
+		if err := arg.Check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+String
+Description:
+
+	Each String returns a readable representation of the formula, with
+	literals written as x<index>.
+*/
+
+func (l Literal) String() string {
+	if l.Var == nil {
+		return "x?"
+	}
+	return fmt.Sprintf("x%v", l.Var.Index)
+}
+
+func (f NotFormula) String() string {
+	return fmt.Sprintf("NOT %v", argString(f.Arg))
+}
+
+func (f AndFormula) String() string {
+	return joinArgs(" AND ", f.Args)
+}
+
+func (f OrFormula) String() string {
+	return joinArgs(" OR ", f.Args)
+}
+
+func (f XorFormula) String() string {
+	return joinArgs(" XOR ", []Formula{f.A, f.B})
+}
+
+func (f ImpliesFormula) String() string {
+	return joinArgs(" => ", []Formula{f.A, f.B})
+}
+
+// argString wraps compound formulas in parentheses.
+func argString(f Formula) string {
+	switch f.(type) {
+	case nil:
+		return "<nil>"
+	case Literal, NotFormula:
+		return f.String()
+	default:
+		return "(" + f.String() + ")"
+	}
+}
+
+func joinArgs(sep string, args []Formula) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		parts[i] = argString(arg)
+	}
+	return strings.Join(parts, sep)
+}
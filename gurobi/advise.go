@@ -0,0 +1,197 @@
+package gurobi
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+/*
+advise.go
+Description:
+	A structural analysis of the linear part of a model which recommends
+	presolve and symmetry parameters. The analysis looks for
+	  - identical columns (same objective, bounds, type and coefficients),
+	    which indicate symmetry,
+	  - big-M coefficients on binary variables, which cause numerical trouble
+	    when presolve aggregates rows,
+	  - dense rows, which presolve can sparsify,
+	  - continuous models with many more rows than columns, whose dual is
+	    smaller.
+	The suggestions are heuristics; they are a starting point for tuning.
+*/
+
+const (
+	// adviseBigM is the magnitude from which a coefficient on a binary variable is a big-M.
+	adviseBigM = 1e4
+	// adviseDenseFraction is the fraction of the variables from which a row is dense.
+	adviseDenseFraction = 0.3
+	// adviseDenseMin is the number of nonzeros from which a row can be dense.
+	adviseDenseMin = 100
+	// adviseDualRatio is the ratio of rows to columns from which the dual is preferred.
+	adviseDualRatio = 2.0
+)
+
+/*
+Suggestion
+Description:
+
+	A recommended value for an integer parameter, with the reason for it.
+*/
+type Suggestion struct {
+	Param  string `json:"param"`
+	Value  int    `json:"value"`
+	Reason string `json:"reason"`
+}
+
+/*
+Advice
+Description:
+
+	The findings of Model.Advise and the parameter values they suggest.
+	- IdenticalColumns: The number of columns which are identical to an
+	  earlier column.
+	- BigMRows: The number of rows with a big-M coefficient on a binary variable.
+	- DenseRows: The number of dense rows.
+*/
+type Advice struct {
+	IdenticalColumns int          `json:"identical_columns"`
+	BigMRows         int          `json:"big_m_rows"`
+	DenseRows        int          `json:"dense_rows"`
+	Suggestions      []Suggestion `json:"suggestions"`
+}
+
+/*
+Advise
+Description:
+
+	Analyzes the structure of the model (see advise.go) and recommends
+	parameters. Pending changes are applied first. Apply the suggestions
+	with Advice.Apply.
+*/
+func (model *Model) Advise() (*Advice, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if model.dryRun != nil {
+		return nil, fmt.Errorf("Advise: %w", ErrDryRun)
+	}
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	// Algorithm
+	data, err := model.LinearData()
+	if err != nil {
+		return nil, err
+	}
+	obj, err := model.getDoubleAttrArray(DBL_ATTR_OBJ, 0, int32(len(data.LB)))
+	if err != nil {
+		return nil, err
+	}
+	return data.Advise(obj), nil
+}
+
+/*
+Advise
+Description:
+
+	Analyzes the linear data with the objective coefficients obj (one per
+	variable) and recommends parameters. See Model.Advise.
+*/
+func (data *LinearData) Advise(obj []float64) *Advice {
+	advice := &Advice{Suggestions: []Suggestion{}}
+	numVars := len(data.LB)
+	numConstrs := len(data.RHS)
+
+	isMIP := false
+	for _, vt := range data.VTypes {
+		isMIP = isMIP || vt != Continuous
+	}
+
+	// Rows
+	for i := 0; i < numConstrs; i++ {
+		nnz := int(data.Beg[i+1] - data.Beg[i])
+		if nnz >= adviseDenseMin && float64(nnz) >= adviseDenseFraction*float64(numVars) {
+			advice.DenseRows++
+		}
+		for k := data.Beg[i]; k < data.Beg[i+1]; k++ {
+			if data.VTypes[data.Ind[k]] == Binary && math.Abs(data.Val[k]) >= adviseBigM {
+				advice.BigMRows++
+				break
+			}
+		}
+	}
+
+	// Columns
+	columns := make([][]string, numVars)
+	for i := 0; i < numConstrs; i++ {
+		for k := data.Beg[i]; k < data.Beg[i+1]; k++ {
+			columns[data.Ind[k]] = append(columns[data.Ind[k]], fmt.Sprintf("%v:%v", i, data.Val[k]))
+		}
+	}
+	seen := map[string]bool{}
+	for j := 0; j < numVars; j++ {
+		if len(columns[j]) == 0 {
+			continue
+		}
+		sort.Strings(columns[j])
+		key := fmt.Sprintf("%v|%v|%v|%v|%v", obj[j], data.LB[j], data.UB[j], data.VTypes[j], strings.Join(columns[j], ","))
+		if seen[key] {
+			advice.IdenticalColumns++
+		}
+		seen[key] = true
+	}
+
+	// Suggestions
+	if isMIP && advice.IdenticalColumns > 0 {
+		advice.Suggestions = append(advice.Suggestions, Suggestion{
+			Param:  "Symmetry",
+			Value:  2,
+			Reason: fmt.Sprintf("%v columns are identical to another column; aggressive symmetry detection may pay off", advice.IdenticalColumns),
+		})
+	}
+	if advice.BigMRows > 0 {
+		advice.Suggestions = append(advice.Suggestions, Suggestion{
+			Param:  "Aggregate",
+			Value:  0,
+			Reason: fmt.Sprintf("%v rows contain big-M coefficients on binary variables; aggregating them can amplify numerical errors (consider indicator constraints)", advice.BigMRows),
+		})
+	}
+	if advice.DenseRows > 0 {
+		advice.Suggestions = append(advice.Suggestions, Suggestion{
+			Param:  "PreSparsify",
+			Value:  1,
+			Reason: fmt.Sprintf("%v rows are dense; presolve can reduce their nonzeros", advice.DenseRows),
+		})
+	}
+	if !isMIP && numVars > 0 && float64(numConstrs) >= adviseDualRatio*float64(numVars) {
+		advice.Suggestions = append(advice.Suggestions, Suggestion{
+			Param:  "PreDual",
+			Value:  1,
+			Reason: fmt.Sprintf("the model has %v rows but only %v columns; its dual is smaller", numConstrs, numVars),
+		})
+	}
+
+	return advice
+}
+
+/*
+Apply
+Description:
+
+	Sets each suggested parameter on the model.
+*/
+func (advice *Advice) Apply(model *Model) error {
+	for _, s := range advice.Suggestions {
+		if err := model.SetIntParam(s.Param, s.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package gurobi
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+/*
+anonymize.go
+Description:
+	Writes an anonymized copy of a model, so that proprietary models can be
+	shared with support or attached to bug reports. All names are replaced
+	by generic identifiers and the objective can be perturbed so that the
+	original costs cannot be read from the file. The model itself is not
+	changed.
+*/
+
+/*
+AnonymizeOptions
+Description:
+
+	Controls how a model is anonymized.
+	- Perturbation: If positive, each objective coefficient (and the
+	  objective constant) is multiplied by a random factor in
+	  [1 - Perturbation, 1 + Perturbation]. Zero coefficients stay zero.
+	- Seed: The seed of the random factors, so that runs can be repeated.
+*/
+type AnonymizeOptions struct {
+	Perturbation float64
+	Seed         int64
+}
+
+/*
+Anonymize
+Description:
+
+	Writes a copy of the model to path, which must have the extension .mps
+	(optionally compressed, e.g. .mps.gz). In the copy, the model is named
+	"anonymous", and the variables, linear, quadratic and general
+	constraints are named x<i>, c<i>, q<i> and g<i>. Only the primary
+	objective is perturbed.
+*/
+func (model *Model) Anonymize(path string, opts AnonymizeOptions) error {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return err
+	}
+
+	if !hasFileExt(path, ".mps") {
+		return fmt.Errorf("an anonymized model can only be written to a .mps file; received %v", path)
+	}
+	if opts.Perturbation < 0 || opts.Perturbation >= 1 || math.IsNaN(opts.Perturbation) {
+		return fmt.Errorf("the perturbation must be at least 0 and less than 1; received %v", opts.Perturbation)
+	}
+
+	// Algorithm
+	anonymous, err := model.Copy()
+	if err != nil {
+		return err
+	}
+	defer anonymous.Free()
+
+	if err := anonymous.SetStringAttr("ModelName", "anonymous"); err != nil {
+		return err
+	}
+
+	counts := []struct {
+		attr   string
+		prefix string
+		count  func() (int32, error)
+	}{
+		{"VarName", "x", anonymous.NumVars},
+		{"ConstrName", "c", anonymous.NumConstrs},
+		{"QCName", "q", anonymous.NumQConstrs},
+		{"GenConstrName", "g", anonymous.NumGenConstrs},
+	}
+	for _, c := range counts {
+		n, err := c.count()
+		if err != nil {
+			return err
+		}
+		names := make([]string, n)
+		for i := range names {
+			names[i] = fmt.Sprintf("%v%v", c.prefix, i)
+		}
+		if err := anonymous.setStringAttrArray(c.attr, 0, names); err != nil {
+			return err
+		}
+	}
+
+	if opts.Perturbation > 0 {
+		if err := anonymous.perturbObjective(opts.Perturbation, opts.Seed); err != nil {
+			return err
+		}
+	}
+
+	if err := anonymous.Update(); err != nil {
+		return err
+	}
+	return anonymous.Write(path)
+}
+
+// perturbObjective multiplies the objective coefficients and constant by random factors in [1 - p, 1 + p].
+func (model *Model) perturbObjective(p float64, seed int64) error {
+	rng := rand.New(rand.NewSource(seed))
+	factor := func() float64 { return 1 + p*(2*rng.Float64()-1) }
+
+	numVars, err := model.NumVars()
+	if err != nil {
+		return err
+	}
+	obj, err := model.getDoubleAttrArray(DBL_ATTR_OBJ, 0, numVars)
+	if err != nil {
+		return err
+	}
+	for i := range obj {
+		obj[i] *= factor()
+	}
+	if err := model.setDoubleAttrArray(DBL_ATTR_OBJ, 0, obj); err != nil {
+		return err
+	}
+
+	objCon, err := model.GetObjConstant()
+	if err != nil {
+		return err
+	}
+	return model.SetObjConstant(objCon * factor())
+}
@@ -0,0 +1,83 @@
+package gurobi
+
+/*
+arena.go
+Description:
+	Chunked storage for the Var and Constr handles of a model. Handles are
+	allocated from fixed-capacity chunks which are never reallocated, so the
+	pointers returned by AddVar, AddConstr, ... stay valid as the model grows,
+	and removing items updates the indices of the existing handles in place.
+	model.Variables and model.Constraints hold copies of the handles.
+Notes:
+	A chunk is released once no handle in it is referenced anymore.
+*/
+
+// arenaChunkSize is the number of handles per chunk.
+const arenaChunkSize = 4096
+
+type varArena struct {
+	chunk []Var
+}
+
+// alloc returns a new handle for the variable at index of model.
+func (a *varArena) alloc(model *Model, index int32) *Var {
+	if len(a.chunk) == cap(a.chunk) {
+		a.chunk = make([]Var, 0, arenaChunkSize)
+	}
+	a.chunk = append(a.chunk, Var{model, index})
+	return &a.chunk[len(a.chunk)-1]
+}
+
+type constrArena struct {
+	chunk []Constr
+}
+
+// alloc returns a new handle for the constraint at index of model.
+func (a *constrArena) alloc(model *Model, index int32) *Constr {
+	if len(a.chunk) == cap(a.chunk) {
+		a.chunk = make([]Constr, 0, arenaChunkSize)
+	}
+	a.chunk = append(a.chunk, Constr{model, index})
+	return &a.chunk[len(a.chunk)-1]
+}
+
+// appendVars adds count new variables with the given names (nil if they are
+// unnamed) to model.Variables and returns their handles.
+func (model *Model) appendVars(count int, names []string) []*Var {
+	vars := make([]*Var, count)
+	xcols := len(model.vars)
+	for i := range vars {
+		vars[i] = model.varArena.alloc(model, int32(xcols+i))
+		model.Variables = append(model.Variables, *vars[i])
+	}
+	model.vars = append(model.vars, vars...)
+	model.names.addVars(vars, names)
+	return vars
+}
+
+// appendConstrs adds count new constraints with the given names (nil if they
+// are unnamed) to model.Constraints and returns their handles.
+func (model *Model) appendConstrs(count int, names []string) []*Constr {
+	constrs := make([]*Constr, count)
+	xrows := len(model.constrs)
+	for i := range constrs {
+		constrs[i] = model.constrArena.alloc(model, int32(xrows+i))
+		model.Constraints = append(model.Constraints, *constrs[i])
+	}
+	model.constrs = append(model.constrs, constrs...)
+	model.names.addConstrs(constrs, names)
+	return constrs
+}
+
+// syncItems rebuilds model.Variables and model.Constraints from the handles
+// after their indices have changed.
+func (model *Model) syncItems() {
+	model.Variables = make([]Var, len(model.vars))
+	for i, v := range model.vars {
+		model.Variables[i] = *v
+	}
+	model.Constraints = make([]Constr, len(model.constrs))
+	for i, c := range model.constrs {
+		model.Constraints[i] = *c
+	}
+}
@@ -0,0 +1,100 @@
+package gurobi
+
+/*
+attrcache.go
+Description:
+	An optional cache for the attribute getters (model attributes like
+	NumVars and attributes of single variables or constraints like VarName,
+	LB or UB), so that inspecting a model repeatedly does not cross the cgo
+	boundary for every read.
+Notes:
+	Gurobi only applies pending modifications when the model is updated, so
+	attribute values can only change when the model is updated, optimized,
+	reset or analyzed (ComputeIIS, Tune); each of these clears the cache.
+	So does setting an int parameter, since SolutionNumber, ObjNumber and
+	ScenarioNumber select which values some attributes return.
+	Array getters (e.g., GetDoubleAttrVars) are not cached.
+*/
+
+// attrCacheKey identifies a cached attribute value; ind is -1 for model attributes.
+type attrCacheKey struct {
+	attr string
+	ind  int32
+	kind byte
+}
+
+// The kinds of cached attribute values.
+const (
+	attrCacheInt byte = iota
+	attrCacheChar
+	attrCacheDouble
+	attrCacheString
+)
+
+type attrCache struct {
+	values map[attrCacheKey]interface{}
+	hits   int
+	misses int
+}
+
+// get returns the cached value of attr (or of its element ind), if any.
+// It does nothing if the cache is disabled (nil).
+func (c *attrCache) get(kind byte, attr string, ind int32) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	value, ok := c.values[attrCacheKey{attr, ind, kind}]
+	if ok {
+		c.hits++
+	} else {
+		c.misses++
+	}
+	return value, ok
+}
+
+// put caches the value of attr (or of its element ind).
+func (c *attrCache) put(kind byte, attr string, ind int32, value interface{}) {
+	if c == nil {
+		return
+	}
+	c.values[attrCacheKey{attr, ind, kind}] = value
+}
+
+// clear drops all cached values.
+func (c *attrCache) clear() {
+	if c == nil {
+		return
+	}
+	c.values = map[attrCacheKey]interface{}{}
+}
+
+/*
+SetAttrCache
+Description:
+
+	Enables (or disables and drops) the attribute cache of the model.
+*/
+func (model *Model) SetAttrCache(enabled bool) {
+	if !enabled {
+		model.attrCache = nil
+		return
+	}
+	if model.attrCache == nil {
+		model.attrCache = &attrCache{values: map[attrCacheKey]interface{}{}}
+	}
+}
+
+/*
+AttrCacheStats
+Description:
+
+	Returns the number of attribute reads which were answered by the cache
+	and the number of reads which had to call Gurobi since the cache was
+	enabled.
+*/
+func (model *Model) AttrCacheStats() (hits int, misses int) {
+	if model.attrCache == nil {
+		return 0, 0
+	}
+	return model.attrCache.hits, model.attrCache.misses
+}
@@ -0,0 +1,121 @@
+package gurobi
+
+/*
+#include <stdlib.h>
+#include <gurobi_passthrough.h>
+*/
+import "C"
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+/*
+attrinto.go
+Description:
+	Variants of the attribute array getters which write into a buffer
+	supplied by the caller, for hot loops (e.g., iterative algorithms which
+	read X or RC after every solve) where allocating a new slice per call
+	adds up.
+Notes:
+	These functions do not allocate on the Go heap: the attribute name is
+	copied into C memory, the list of indices is kept in a scratch buffer of
+	the model and calls are only traced when tracing is enabled.
+*/
+
+/*
+GetDoubleAttrArrayInto
+Description:
+
+	Reads the values of the double attribute attrname for the len(dst)
+	items starting at start (e.g., the first len(dst) variables) into dst.
+*/
+func (model *Model) GetDoubleAttrArrayInto(dst []float64, attrname string, start int32) error {
+	if err := model.checkAttrInto("GetDoubleAttrArrayInto", attrname); err != nil {
+		return err
+	}
+	if start < 0 {
+		return fmt.Errorf("the start index must be nonnegative; received %v", start)
+	}
+	if len(dst) == 0 {
+		return nil
+	}
+
+	cname := C.CString(attrname)
+	defer C.free(unsafe.Pointer(cname))
+
+	done := noopTrace
+	if traceEnabled.Load() {
+		done = traceCall("GRBgetdblattrarray", attrname, start, len(dst))
+	}
+	errCode := C.GRBgetdblattrarray(model.AsGRBModel, cname, C.int(start), C.int(len(dst)), (*C.double)(unsafe.Pointer(&dst[0])))
+	runtime.KeepAlive(dst)
+	done(errCode)
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+GetDoubleAttrVarsInto
+Description:
+
+	Reads the values of the double attribute attrname (e.g., "X" or "RC") of
+	vars into dst, which must have the same length as vars.
+*/
+func (model *Model) GetDoubleAttrVarsInto(dst []float64, attrname string, vars []*Var) error {
+	if err := model.checkAttrInto("GetDoubleAttrVarsInto", attrname); err != nil {
+		return err
+	}
+	if len(dst) != len(vars) {
+		return MismatchedLengthError{
+			Length1: len(dst),
+			Name1:   "dst",
+			Length2: len(vars),
+			Name2:   "vars",
+		}
+	}
+	if len(vars) == 0 {
+		return nil
+	}
+
+	if cap(model.scratchInd) < len(vars) {
+		model.scratchInd = make([]int32, len(vars))
+	}
+	ind := model.scratchInd[:len(vars)]
+	for i, v := range vars {
+		if v == nil || v.Index < 0 {
+			return fmt.Errorf("the variable at position %v is invalid", i)
+		}
+		ind[i] = v.Index
+	}
+
+	cname := C.CString(attrname)
+	defer C.free(unsafe.Pointer(cname))
+
+	done := noopTrace
+	if traceEnabled.Load() {
+		done = traceCall("GRBgetdblattrlist", attrname)
+	}
+	errCode := C.GRBgetdblattrlist(model.AsGRBModel, cname, C.int(len(ind)), (*C.int)(unsafe.Pointer(&ind[0])), (*C.double)(unsafe.Pointer(&dst[0])))
+	runtime.KeepAlive(ind)
+	runtime.KeepAlive(dst)
+	done(errCode)
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	return nil
+}
+
+// checkAttrInto returns an error if attrname can not be read by op right now.
+func (model *Model) checkAttrInto(op string, attrname string) error {
+	if err := model.checkFor(op); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		return fmt.Errorf("attribute %v: %w", attrname, ErrDryRun)
+	}
+	return nil
+}
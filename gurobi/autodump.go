@@ -0,0 +1,199 @@
+package gurobi
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+/*
+autodump.go
+Description:
+	Automatically writes the model, its parameters and a report of the
+	environment to a debug directory when an optimization fails, so that
+	failures can be reproduced (and attached to bug reports).
+*/
+
+/*
+AutoDumpConfig
+Description:
+
+	Configures the automatic dump of a model after a failed optimization.
+	- Dir: The directory in which a new subdirectory is created for each dump.
+	- BadStatuses: Statuses which count as a failure even when Optimize
+	  returned no error (defaults to DefaultAutoDumpStatuses).
+	- Formats: The file extensions the model is written in (defaults to "lp" and "mps").
+*/
+type AutoDumpConfig struct {
+	Dir         string
+	BadStatuses []Status
+	Formats     []string
+
+	// LastDump is the directory of the most recent dump ("" if there was none).
+	LastDump string
+}
+
+// DefaultAutoDumpStatuses are the statuses which trigger a dump unless BadStatuses is set.
+var DefaultAutoDumpStatuses = []Status{StatusInfeasible, StatusInfOrUnbd, StatusUnbounded, StatusNumeric}
+
+/*
+EnableAutoDump
+Description:
+
+	Makes Optimize dump the model to a subdirectory of dir whenever it returns
+	an error or ends with one of DefaultAutoDumpStatuses. The returned config
+	can be modified to change what is dumped.
+*/
+func (model *Model) EnableAutoDump(dir string) (*AutoDumpConfig, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if dir == "" {
+		return nil, fmt.Errorf("the auto dump directory must not be empty")
+	}
+
+	model.autoDump = &AutoDumpConfig{
+		Dir:         dir,
+		BadStatuses: DefaultAutoDumpStatuses,
+		Formats:     []string{"lp", "mps"},
+	}
+	return model.autoDump, nil
+}
+
+/*
+DisableAutoDump
+Description:
+
+	Turns off the automatic dump of the model.
+*/
+func (model *Model) DisableAutoDump() {
+	if model != nil {
+		model.autoDump = nil
+	}
+}
+
+// dumpOnFailure dumps the model if optErr is non-nil or the status is bad, and returns optErr.
+// Problems while dumping are reported alongside optErr (or on their own if optErr is nil).
+func (model *Model) dumpOnFailure(optErr error) error {
+	if model.autoDump == nil {
+		return optErr
+	}
+
+	status, statusErr := model.Status()
+	failed := optErr != nil
+	if !failed && statusErr == nil {
+		for _, bad := range model.autoDump.BadStatuses {
+			if status == bad {
+				failed = true
+				break
+			}
+		}
+	}
+
+	if !failed {
+		return optErr
+	}
+
+	dumpErr := model.DumpArtifacts(optErr)
+	switch {
+	case dumpErr == nil:
+		return optErr
+	case optErr == nil:
+		return fmt.Errorf("writing debug artifacts failed: %v", dumpErr)
+	default:
+		return fmt.Errorf("%w (writing debug artifacts also failed: %v)", optErr, dumpErr)
+	}
+}
+
+/*
+DumpArtifacts
+Description:
+
+	Writes the model (in every configured format), its parameter file
+	(model.prm) and a report (report.txt) describing the environment, the
+	Gurobi version, the status and cause to a new subdirectory of the
+	auto dump directory. Auto dump must be enabled.
+*/
+func (model *Model) DumpArtifacts(cause error) error {
+	if model.autoDump == nil {
+		return fmt.Errorf("auto dump is not enabled for this model")
+	}
+
+	name, _ := model.GetStringAttr("ModelName")
+	if name == "" {
+		name = "model"
+	}
+
+	dir := filepath.Join(
+		model.autoDump.Dir,
+		fmt.Sprintf("%v-%v", sanitizeFilename(name), time.Now().Format("20060102-150405.000")),
+	)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	model.autoDump.LastDump = dir
+
+	problems := []string{}
+	for _, format := range model.autoDump.Formats {
+		if err := model.Write(filepath.Join(dir, "model."+format)); err != nil {
+			problems = append(problems, fmt.Sprintf("model.%v: %v", format, err))
+		}
+	}
+
+	if env, err := model.ModelEnv(); err != nil {
+		problems = append(problems, fmt.Sprintf("model.prm: %v", err))
+	} else if err := env.WriteParams(filepath.Join(dir, "model.prm")); err != nil {
+		problems = append(problems, fmt.Sprintf("model.prm: %v", err))
+	}
+
+	report := model.dumpReport(cause)
+	if err := os.WriteFile(filepath.Join(dir, "report.txt"), []byte(report), 0o644); err != nil {
+		problems = append(problems, fmt.Sprintf("report.txt: %v", err))
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("could not write %v", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// dumpReport describes the environment in which the failure happened.
+func (model *Model) dumpReport(cause error) string {
+	major, minor, technical := Version()
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "time: %v\n", time.Now().Format(time.RFC3339))
+	fmt.Fprintf(&sb, "gurobi: %v.%v.%v\n", major, minor, technical)
+	fmt.Fprintf(&sb, "go: %v %v/%v\n", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+
+	if status, err := model.Status(); err == nil {
+		fmt.Fprintf(&sb, "status: %v\n", status)
+	}
+	if numVars, err := model.NumVars(); err == nil {
+		fmt.Fprintf(&sb, "vars: %v\n", numVars)
+	}
+	if numConstrs, err := model.NumConstrs(); err == nil {
+		fmt.Fprintf(&sb, "constrs: %v\n", numConstrs)
+	}
+	if cause != nil {
+		fmt.Fprintf(&sb, "error: %v\n", cause)
+	}
+	return sb.String()
+}
+
+// sanitizeFilename replaces characters which are not safe in file names.
+func sanitizeFilename(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
@@ -0,0 +1,151 @@
+package gurobi
+
+import (
+	"fmt"
+	"math"
+)
+
+/*
+auxiliary.go
+Description:
+	Expression-level helpers (Abs, Min, Max) which introduce auxiliary
+	variables and the corresponding general constraints behind the scenes.
+	Each helper returns a Var which can be used in further expressions.
+*/
+
+/*
+ExprToVar
+Description:
+
+	Returns a variable which is equal to expr. If expr is just a single
+	variable (with coefficient 1 and no constant), that variable is returned;
+	otherwise a free continuous auxiliary variable z is added along with the
+	constraint expr - z == 0.
+*/
+func (model *Model) ExprToVar(expr *LinExpr, name string) (*Var, error) {
+	if expr == nil {
+		return nil, fmt.Errorf("the expression must not be nil")
+	}
+
+	if len(expr.Ind) == 1 && len(expr.Val) == 1 && expr.Val[0] == 1.0 && expr.Offset == 0.0 {
+		return expr.Ind[0], nil
+	}
+
+	z, err := model.AddVar(Continuous, 0.0, -INFINITY, INFINITY, name, []*Constr{}, []float64{})
+	if err != nil {
+		return nil, err
+	}
+
+	defining := LinExpr{
+		Ind:    append(append([]*Var{}, expr.Ind...), z),
+		Val:    append(append([]float64{}, expr.Val...), -1.0),
+		Offset: expr.Offset,
+	}
+	if _, err := model.AddTempConstr(defining.Equal(0.0), name+"_def"); err != nil {
+		return nil, err
+	}
+
+	return z, nil
+}
+
+/*
+Abs
+Description:
+
+	Returns a new variable equal to |expr|.
+*/
+func (model *Model) Abs(expr *LinExpr, name string) (*Var, error) {
+	arg, err := model.ExprToVar(expr, name+"_arg")
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := model.AddVar(Continuous, 0.0, 0.0, INFINITY, name, []*Constr{}, []float64{})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := model.AddGenConstrAbs(res, arg, name+"_abs"); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+/*
+Max
+Description:
+
+	Returns a new variable equal to the maximum of exprs.
+	Expressions which are constants are folded into the constant of the max constraint.
+*/
+func (model *Model) Max(name string, exprs ...*LinExpr) (*Var, error) {
+	return model.minMax(true, name, exprs)
+}
+
+/*
+Min
+Description:
+
+	Returns a new variable equal to the minimum of exprs.
+	Expressions which are constants are folded into the constant of the min constraint.
+*/
+func (model *Model) Min(name string, exprs ...*LinExpr) (*Var, error) {
+	return model.minMax(false, name, exprs)
+}
+
+// minMax implements Max (isMax) and Min.
+func (model *Model) minMax(isMax bool, name string, exprs []*LinExpr) (*Var, error) {
+	if len(exprs) == 0 {
+		return nil, fmt.Errorf("at least one expression is required")
+	}
+
+	constant := math.Inf(1)
+	if isMax {
+		constant = math.Inf(-1)
+	}
+
+	vars := []*Var{}
+	for i, expr := range exprs {
+		if expr == nil {
+			return nil, fmt.Errorf("expression %v must not be nil", i)
+		}
+
+		// Fold constants into the constant of the general constraint.
+		if len(expr.Ind) == 0 {
+			if isMax {
+				constant = math.Max(constant, expr.Offset)
+			} else {
+				constant = math.Min(constant, expr.Offset)
+			}
+			continue
+		}
+
+		v, err := model.ExprToVar(expr, fmt.Sprintf("%v_arg%v", name, i))
+		if err != nil {
+			return nil, err
+		}
+		vars = append(vars, v)
+	}
+
+	// Gurobi represents an absent constant with +/- INFINITY.
+	if math.IsInf(constant, 0) {
+		constant = math.Copysign(INFINITY, constant)
+	}
+
+	res, err := model.AddVar(Continuous, 0.0, -INFINITY, INFINITY, name, []*Constr{}, []float64{})
+	if err != nil {
+		return nil, err
+	}
+
+	if isMax {
+		_, err = model.AddGenConstrMax(res, vars, constant, name+"_max")
+	} else {
+		_, err = model.AddGenConstrMin(res, vars, constant, name+"_min")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,180 @@
+package gurobi
+
+import "fmt"
+
+/*
+barrier.go
+Description:
+	Groups the parameters that control the barrier algorithm and crossover so
+	that they can be read and applied as a single unit.
+*/
+
+/*
+BarrierConfig
+Description:
+
+	The parameters which control barrier and crossover.
+	- BarConvTol: Relative primal-dual gap at which barrier terminates.
+	- BarIterLimit: Maximum number of barrier iterations.
+	- Crossover: Strategy used to obtain a basic solution from the barrier solution.
+	- CrossoverBasis: 0 for a fast initial basis, 1 for a numerically stable one.
+	- BarHomogeneous: -1 (automatic), 0 (off) or 1 (on) for the homogeneous barrier algorithm.
+*/
+type BarrierConfig struct {
+	BarConvTol     float64
+	BarIterLimit   int
+	Crossover      Crossover
+	CrossoverBasis int
+	BarHomogeneous int
+}
+
+/*
+DefaultBarrierConfig
+Description:
+
+	Returns the barrier configuration with all parameters at Gurobi's default values.
+*/
+func DefaultBarrierConfig() BarrierConfig {
+	return BarrierConfig{
+		BarConvTol:     1e-8,
+		BarIterLimit:   1000,
+		Crossover:      CrossoverAuto,
+		CrossoverBasis: 0,
+		BarHomogeneous: -1,
+	}
+}
+
+/*
+Check
+Description:
+
+	Checks that every parameter of the configuration is within its valid range.
+*/
+func (config BarrierConfig) Check() error {
+	if config.BarConvTol < 0.0 || config.BarConvTol > 1.0 {
+		return fmt.Errorf("BarConvTol must be between 0 and 1; received %v", config.BarConvTol)
+	}
+
+	if config.BarIterLimit < 0 {
+		return fmt.Errorf("BarIterLimit must be nonnegative; received %v", config.BarIterLimit)
+	}
+
+	if err := config.Crossover.Check(); err != nil {
+		return err
+	}
+
+	if config.CrossoverBasis != 0 && config.CrossoverBasis != 1 {
+		return fmt.Errorf("CrossoverBasis must be 0 or 1; received %v", config.CrossoverBasis)
+	}
+
+	if config.BarHomogeneous < -1 || config.BarHomogeneous > 1 {
+		return fmt.Errorf("BarHomogeneous must be -1, 0 or 1; received %v", config.BarHomogeneous)
+	}
+
+	return nil
+}
+
+/*
+GetBarrierConfig
+Description:
+
+	Reads the current barrier configuration from the environment.
+*/
+func (env *Env) GetBarrierConfig() (BarrierConfig, error) {
+	var config BarrierConfig
+	var err error
+
+	if config.BarConvTol, err = env.GetDBLParam("BarConvTol"); err != nil {
+		return config, err
+	}
+	if config.BarIterLimit, err = env.GetIntParam("BarIterLimit"); err != nil {
+		return config, err
+	}
+	crossover, err := env.GetIntParam("Crossover")
+	if err != nil {
+		return config, err
+	}
+	config.Crossover = Crossover(crossover)
+	if config.CrossoverBasis, err = env.GetIntParam("CrossoverBasis"); err != nil {
+		return config, err
+	}
+	if config.BarHomogeneous, err = env.GetIntParam("BarHomogeneous"); err != nil {
+		return config, err
+	}
+
+	return config, nil
+}
+
+/*
+ApplyBarrierConfig
+Description:
+
+	Validates config and applies all of its parameters to the environment.
+	If any parameter cannot be set, the parameters which were already changed
+	are restored so that the environment is never left half-configured.
+*/
+func (env *Env) ApplyBarrierConfig(config BarrierConfig) error {
+	// Input Processing
+	if err := config.Check(); err != nil {
+		return err
+	}
+
+	previous, err := env.GetBarrierConfig()
+	if err != nil {
+		return err
+	}
+
+	// Algorithm
+	if err := env.setBarrierConfig(config); err != nil {
+		// Roll back to the previous configuration (ignoring secondary errors).
+		env.setBarrierConfig(previous)
+		return fmt.Errorf("there was an issue applying the barrier configuration (it was rolled back): %v", err)
+	}
+
+	return nil
+}
+
+// setBarrierConfig sets each parameter of config without validation or rollback.
+func (env *Env) setBarrierConfig(config BarrierConfig) error {
+	if err := env.SetDBLParam("BarConvTol", config.BarConvTol); err != nil {
+		return err
+	}
+	if err := env.SetIntParam("BarIterLimit", config.BarIterLimit); err != nil {
+		return err
+	}
+	if err := env.SetIntParam("Crossover", int(config.Crossover)); err != nil {
+		return err
+	}
+	if err := env.SetIntParam("CrossoverBasis", config.CrossoverBasis); err != nil {
+		return err
+	}
+	return env.SetIntParam("BarHomogeneous", config.BarHomogeneous)
+}
+
+/*
+ApplyBarrierConfig
+Description:
+
+	Applies config to the parameters of this model (see Env.ApplyBarrierConfig).
+*/
+func (model *Model) ApplyBarrierConfig(config BarrierConfig) error {
+	env, err := model.ModelEnv()
+	if err != nil {
+		return err
+	}
+	return env.ApplyBarrierConfig(config)
+}
+
+/*
+GetBarrierConfig
+Description:
+
+	Reads the current barrier configuration of this model.
+*/
+func (model *Model) GetBarrierConfig() (BarrierConfig, error) {
+	env, err := model.ModelEnv()
+	if err != nil {
+		return BarrierConfig{}, err
+	}
+	return env.GetBarrierConfig()
+}
@@ -0,0 +1,262 @@
+package gurobi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+/*
+binder.go
+Description:
+	Binds the fields of application structs to variables: every field with
+	a `grb` tag becomes a variable (or one variable per element for slices
+	and arrays), and the solution is written back into the fields after the
+	solve, so that application code never handles Var bookkeeping.
+Notes:
+	The tag is a comma-separated list of key=value pairs, e.g.
+	`grb:"lb=0,ub=10,type=I,name=x_{index}"`, with the keys
+	- lb, ub: The bounds (default 0 and INFINITY; "inf" and "-inf" are allowed).
+	- obj: The objective coefficient (default 0).
+	- type: The variable type as a single character (default B for bool
+	  fields, I for integer fields and C otherwise).
+	- name: The name of the variable, where {index} is replaced by the
+	  index of the element (default: the field name, followed by [index]).
+	Fields tagged `grb:"-"` and fields without a tag are ignored.
+*/
+
+/*
+Binding
+Description:
+
+	The variables which Model.Bind created for the fields of a target.
+*/
+type Binding struct {
+	Model  *Model
+	fields []boundField
+	// byAddr maps the address of a bound field (or element) to its variable.
+	byAddr map[uintptr]*Var
+}
+
+// boundField is a settable numeric or bool value and its variable.
+type boundField struct {
+	value reflect.Value
+	v     *Var
+}
+
+/*
+Bind
+Description:
+
+	Adds a variable for every tagged field of target, which must be a
+	pointer to a struct or a slice of structs (or of pointers to structs),
+	with a single call to AddVars. With a slice of structs, {index} in a
+	name is replaced by the index of the struct (and, for slice fields, by
+	"<struct index>,<element index>"). Slice fields must already have their
+	final length.
+*/
+func (model *Model) Bind(target interface{}) (*Binding, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	value := reflect.ValueOf(target)
+	structs := []reflect.Value{}
+	prefixes := []string{}
+	switch {
+	case value.Kind() == reflect.Ptr && !value.IsNil() && value.Elem().Kind() == reflect.Struct:
+		structs, prefixes = append(structs, value.Elem()), append(prefixes, "")
+	case value.Kind() == reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			elem := value.Index(i)
+			for elem.Kind() == reflect.Ptr && !elem.IsNil() {
+				elem = elem.Elem()
+			}
+			if elem.Kind() != reflect.Struct {
+				return nil, fmt.Errorf("element %v: expected a struct; received %v", i, elem.Type())
+			}
+			structs, prefixes = append(structs, elem), append(prefixes, fmt.Sprint(i))
+		}
+	default:
+		return nil, fmt.Errorf("expected a pointer to a struct or a slice of structs; received %T", target)
+	}
+
+	binding := &Binding{Model: model, byAddr: map[uintptr]*Var{}}
+	var vtypes []VarType
+	var objs, lbs, ubs []float64
+	var names []string
+	for k, s := range structs {
+		for j := 0; j < s.NumField(); j++ {
+			field := s.Type().Field(j)
+			tag, ok := field.Tag.Lookup("grb")
+			if !ok || tag == "-" {
+				continue
+			}
+			if field.PkgPath != "" {
+				return nil, fmt.Errorf("field %v: tagged fields must be exported", field.Name)
+			}
+
+			// The values to bind, with their indices within the field.
+			values, indices := []reflect.Value{s.Field(j)}, []string{""}
+			if kind := s.Field(j).Kind(); kind == reflect.Slice || kind == reflect.Array {
+				values, indices = []reflect.Value{}, []string{}
+				for e := 0; e < s.Field(j).Len(); e++ {
+					values, indices = append(values, s.Field(j).Index(e)), append(indices, fmt.Sprint(e))
+				}
+			}
+
+			for e, fv := range values {
+				index := strings.Trim(prefixes[k]+","+indices[e], ",")
+				vs, err := parseBindTag(tag, fv.Kind(), field.Name, index)
+				if err != nil {
+					return nil, fmt.Errorf("field %v: %w", field.Name, err)
+				}
+
+				binding.fields = append(binding.fields, boundField{value: fv})
+				vtypes, objs = append(vtypes, vs.Type), append(objs, vs.Obj)
+				lbs, ubs = append(lbs, vs.LB), append(ubs, vs.UB)
+				names = append(names, vs.Name)
+			}
+		}
+	}
+
+	if len(binding.fields) == 0 {
+		return nil, errors.New("the target has no fields with a grb tag")
+	}
+
+	// Algorithm
+	vars, err := model.AddVars(vtypes, objs, lbs, ubs, names, [][]*Constr{}, [][]float64{})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range binding.fields {
+		binding.fields[i].v = vars[i]
+		binding.byAddr[binding.fields[i].value.UnsafeAddr()] = vars[i]
+	}
+
+	return binding, nil
+}
+
+// parseBindTag parses the grb tag of a value of the given kind.
+func parseBindTag(tag string, kind reflect.Kind, fieldName string, index string) (VarSpec, error) {
+	vs := VarSpec{Type: Continuous, UB: INFINITY}
+	switch kind {
+	case reflect.Bool:
+		vs.Type, vs.UB = Binary, 1.0
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		vs.Type = Integer
+	case reflect.Float32, reflect.Float64:
+	default:
+		return vs, fmt.Errorf("can not bind a value of kind %v", kind)
+	}
+
+	vs.Name = fieldName
+	if index != "" {
+		vs.Name = fmt.Sprintf("%v[%v]", fieldName, index)
+	}
+
+	for _, pair := range strings.Split(tag, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return vs, fmt.Errorf("expected key=value in the tag; received %v", pair)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+
+		var err error
+		switch key {
+		case "lb":
+			vs.LB, err = parseMPSNumber(value)
+		case "ub":
+			vs.UB, err = parseMPSNumber(value)
+		case "obj":
+			vs.Obj, err = parseMPSNumber(value)
+		case "type":
+			if len(value) != 1 {
+				return vs, fmt.Errorf("%v is not a variable type", value)
+			}
+			vs.Type, err = ToVarType(int8(strings.ToUpper(value)[0]))
+		case "name":
+			vs.Name = strings.ReplaceAll(value, "{index}", index)
+		default:
+			return vs, fmt.Errorf("unknown tag key %v", key)
+		}
+		if err != nil {
+			return vs, err
+		}
+	}
+
+	isFloat := kind == reflect.Float32 || kind == reflect.Float64
+	if !isFloat && !vs.Type.IsIntegral() {
+		return vs, fmt.Errorf("a %v field needs an integral variable type; received %v", kind, vs.Type)
+	}
+	return vs, nil
+}
+
+/*
+Var
+Description:
+
+	Returns the variable of the bound field (or slice element) which ptr
+	points to, e.g. binding.Var(&plants[2].Output).
+*/
+func (binding *Binding) Var(ptr interface{}) (*Var, error) {
+	value := reflect.ValueOf(ptr)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return nil, fmt.Errorf("expected a pointer to a bound field; received %T", ptr)
+	}
+
+	v, ok := binding.byAddr[value.Pointer()]
+	if !ok {
+		return nil, errors.New("the pointer does not point to a bound field")
+	}
+	return v, nil
+}
+
+/*
+Vars
+Description:
+
+	Returns all bound variables, in the order in which they were added.
+*/
+func (binding *Binding) Vars() []*Var {
+	vars := make([]*Var, len(binding.fields))
+	for i, field := range binding.fields {
+		vars[i] = field.v
+	}
+	return vars
+}
+
+/*
+Load
+Description:
+
+	Writes the current solution (X) into the bound fields with a single
+	bulk query. Integer fields receive the rounded value and bool fields
+	are true when the value is above 0.5.
+*/
+func (binding *Binding) Load() error {
+	values, err := binding.Model.GetDoubleAttrVars(DBL_ATTR_X, binding.Vars())
+	if err != nil {
+		return err
+	}
+
+	for i, field := range binding.fields {
+		switch field.value.Kind() {
+		case reflect.Bool:
+			field.value.SetBool(values[i] > 0.5)
+		case reflect.Float32, reflect.Float64:
+			field.value.SetFloat(values[i])
+		default:
+			field.value.SetInt(int64(math.Round(values[i])))
+		}
+	}
+	return nil
+}
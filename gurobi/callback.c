@@ -0,0 +1,22 @@
+// callback.c
+// Description:
+//	Bridges the C callback signature that Gurobi expects to the exported Go function goCallback.
+
+#include <gurobi_passthrough.h>
+#include <stdint.h>
+#include "_cgo_export.h"
+
+static int GUROBI_STDCALL callbackTrampoline(GRBmodel *model, void *cbdata, int where, void *usrdata)
+{
+	return goCallback(model, cbdata, where, usrdata);
+}
+
+int setGoCallback(GRBmodel *model, uintptr_t handle)
+{
+	return GRBsetcallbackfunc(model, callbackTrampoline, (void *)handle);
+}
+
+int clearGoCallback(GRBmodel *model)
+{
+	return GRBsetcallbackfunc(model, NULL, NULL);
+}
@@ -0,0 +1,648 @@
+package gurobi
+
+/*
+#include <gurobi_passthrough.h>
+#include <stdint.h>
+
+int setGoCallback(GRBmodel *model, uintptr_t handle);
+int clearGoCallback(GRBmodel *model);
+*/
+import "C"
+import (
+	"fmt"
+	"runtime/cgo"
+	"sync/atomic"
+	"unsafe"
+)
+
+/*
+callback.go
+Description:
+	Allows a Go function to be called by Gurobi while an optimization is running.
+Notes:
+	Gurobi only allows a single callback function per model. The Go function
+	(along with any user data attached to it) is stored in a cgo.Handle which
+	is passed to Gurobi as the callback's usrdata, so no global state is
+	needed to find the function and data of a given model.
+	https://www.gurobi.com/documentation/current/refman/c_setcallbackfunc.html
+*/
+
+// Where describes the part of the optimization from which a callback was called.
+type Where int32
+
+const (
+	WherePolling  Where = C.GRB_CB_POLLING
+	WherePresolve Where = C.GRB_CB_PRESOLVE
+	WhereSimplex  Where = C.GRB_CB_SIMPLEX
+	WhereMIP      Where = C.GRB_CB_MIP
+	WhereMIPSol   Where = C.GRB_CB_MIPSOL
+	WhereMIPNode  Where = C.GRB_CB_MIPNODE
+	WhereMessage  Where = C.GRB_CB_MESSAGE
+	WhereBarrier  Where = C.GRB_CB_BARRIER
+	WhereMultiObj Where = C.GRB_CB_MULTIOBJ
+	WhereIIS      Where = C.GRB_CB_IIS
+)
+
+/*
+String
+Description:
+
+	Returns the name of the where code as it appears in Gurobi's documentation.
+*/
+func (w Where) String() string {
+	switch w {
+	case WherePolling:
+		return "POLLING"
+	case WherePresolve:
+		return "PRESOLVE"
+	case WhereSimplex:
+		return "SIMPLEX"
+	case WhereMIP:
+		return "MIP"
+	case WhereMIPSol:
+		return "MIPSOL"
+	case WhereMIPNode:
+		return "MIPNODE"
+	case WhereMessage:
+		return "MESSAGE"
+	case WhereBarrier:
+		return "BARRIER"
+	case WhereMultiObj:
+		return "MULTIOBJ"
+	case WhereIIS:
+		return "IIS"
+	default:
+		return fmt.Sprintf("Where(%v)", int32(w))
+	}
+}
+
+// CallbackFunc is a Go function which Gurobi calls periodically during optimization.
+// Returning an error stops the optimization; the error is then returned by Optimize.
+type CallbackFunc func(cb *CallbackContext) error
+
+/*
+CallbackContext
+Description:
+
+	The information that is available to a CallbackFunc during a single call.
+	A CallbackContext is only valid for the duration of that call.
+*/
+type CallbackContext struct {
+	Model   *Model
+	Where   Where
+	cbdata  unsafe.Pointer
+	numVars int32
+	sense   ObjSense
+	data    interface{}
+}
+
+// callbackState is the Go state attached to a model's callback through a cgo.Handle.
+type callbackState struct {
+	model   *Model
+	fn      CallbackFunc
+	err     error
+	numVars int32
+	sense   ObjSense
+	data    interface{}
+}
+
+/*
+SetCallback
+Description:
+
+	Registers fn as the callback function of the model, replacing any
+	previously registered callback. Passing nil removes the callback.
+*/
+func (model *Model) SetCallback(fn CallbackFunc) error {
+	return model.SetCallbackWithData(fn, nil)
+}
+
+/*
+SetCallbackWithData
+Description:
+
+	Registers fn as the callback function of the model along with data,
+	which fn can retrieve with CallbackContext.UserData (e.g., a logger or
+	the data structures of a separation routine). Passing a nil fn removes
+	the callback.
+*/
+func (model *Model) SetCallbackWithData(fn CallbackFunc, data interface{}) error {
+	err := model.Check()
+	if err != nil {
+		return err
+	}
+
+	if fn == nil {
+		return model.ClearCallback()
+	}
+
+	handle := cgo.NewHandle(&callbackState{model: model, fn: fn, data: data})
+	errCode := C.setGoCallback(model.AsGRBModel, C.uintptr_t(handle))
+	if errCode != 0 {
+		handle.Delete()
+		return model.MakeError(errCode)
+	}
+
+	model.releaseCallback()
+	model.callbackHandle = handle
+	return nil
+}
+
+/*
+ClearCallback
+Description:
+
+	Removes the callback function of the model (if there is one).
+*/
+func (model *Model) ClearCallback() error {
+	err := model.Check()
+	if err != nil {
+		return err
+	}
+
+	errCode := C.clearGoCallback(model.AsGRBModel)
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+
+	model.releaseCallback()
+	return nil
+}
+
+/*
+SetCallbackData
+Description:
+
+	Replaces the user data of the model's current callback without
+	registering the callback again.
+*/
+func (model *Model) SetCallbackData(data interface{}) error {
+	if model == nil {
+		return model.MakeUninitializedError()
+	}
+	if model.callbackHandle == 0 {
+		return fmt.Errorf("the model has no callback to attach data to")
+	}
+
+	model.callbackHandle.Value().(*callbackState).data = data
+	return nil
+}
+
+/*
+CallbackData
+Description:
+
+	Returns the user data of the model's current callback (or nil).
+*/
+func (model *Model) CallbackData() interface{} {
+	if model == nil || model.callbackHandle == 0 {
+		return nil
+	}
+	return model.callbackHandle.Value().(*callbackState).data
+}
+
+/*
+UserData
+Description:
+
+	Returns the data which was attached to the callback with
+	SetCallbackWithData or SetCallbackData (or nil).
+*/
+func (cb *CallbackContext) UserData() interface{} {
+	return cb.data
+}
+
+// releaseCallback deletes the handle of the current callback state (if any).
+func (model *Model) releaseCallback() {
+	if model.callbackHandle != 0 {
+		model.callbackHandle.Delete()
+		model.callbackHandle = 0
+	}
+}
+
+// prepareCallback records the model's dimensions for the callback before a solve,
+// because the model cannot be queried while the callback runs.
+func (model *Model) prepareCallback() error {
+	if model.callbackHandle == 0 {
+		return nil
+	}
+
+	numVars, err := model.intAttr(INT_ATTR_NUMVARS)
+	if err != nil {
+		return err
+	}
+	sense, err := model.intAttr(C.GRB_INT_ATTR_MODELSENSE)
+	if err != nil {
+		return err
+	}
+
+	state := model.callbackHandle.Value().(*callbackState)
+	state.numVars, state.sense = numVars, ObjSense(sense)
+	return nil
+}
+
+// takeCallbackError returns (and clears) the error returned by the most recent callback call.
+func (model *Model) takeCallbackError() error {
+	if model == nil || model.callbackHandle == 0 {
+		return nil
+	}
+
+	state := model.callbackHandle.Value().(*callbackState)
+	err := state.err
+	state.err = nil
+	return err
+}
+
+/*
+Terminate
+Description:
+
+	Asks Gurobi to stop the optimization of the model as soon as possible.
+	This may be called from within a callback or from another goroutine.
+*/
+func (model *Model) Terminate() {
+	if model == nil || model.isFreed() || model.AsGRBModel == nil {
+		return
+	}
+	C.GRBterminate(model.AsGRBModel)
+}
+
+/*
+StopOneMultiObj
+Description:
+
+	Stops the optimization pass of objective objIndex in a hierarchical
+	multi-objective MIP, without stopping the remaining passes.
+	This may not be called when Where is WhereMultiObj.
+*/
+func (cb *CallbackContext) StopOneMultiObj(objIndex int) error {
+	if err := cb.require("StopOneMultiObj"); err != nil {
+		return err
+	}
+
+	done := traceCall("GRBcbstoponemultiobj", objIndex)
+	errCode := C.GRBcbstoponemultiobj(cb.Model.AsGRBModel, cb.cbdata, C.int(objIndex))
+	done(errCode)
+	if errCode != 0 {
+		return cb.Model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+Message
+Description:
+
+	Returns the log message which Gurobi is printing. This is only available
+	when Where is WhereMessage.
+*/
+func (cb *CallbackContext) Message() (string, error) {
+	if err := cb.require("Message"); err != nil {
+		return "", err
+	}
+
+	var msg *C.char
+	done := traceCall("GRBcbget", cb.Where)
+	errCode := C.GRBcbget(cb.cbdata, C.int(cb.Where), C.GRB_CB_MSG_STRING, unsafe.Pointer(&msg))
+	done(errCode)
+	if errCode != 0 {
+		return "", cb.Model.MakeError(errCode)
+	}
+	return C.GoString(msg), nil
+}
+
+/*
+Runtime
+Description:
+
+	Returns the elapsed solver runtime (in seconds) at the time of the callback.
+*/
+func (cb *CallbackContext) Runtime() (float64, error) {
+	if err := cb.require("Runtime"); err != nil {
+		return 0, err
+	}
+	return cb.getDouble(C.GRB_CB_RUNTIME)
+}
+
+/*
+MIPSolObj
+Description:
+
+	Returns the objective value of the new incumbent solution.
+	This is only available when Where is WhereMIPSol.
+*/
+func (cb *CallbackContext) MIPSolObj() (float64, error) {
+	if err := cb.require("MIPSolObj"); err != nil {
+		return 0, err
+	}
+	return cb.getDouble(C.GRB_CB_MIPSOL_OBJ)
+}
+
+/*
+MIPProgress
+Description:
+
+	Returns the best objective (incumbent), the best bound and the number of
+	explored nodes. This is only available when Where is WhereMIP.
+*/
+func (cb *CallbackContext) MIPProgress() (incumbent float64, bound float64, nodes float64, err error) {
+	if err := cb.require("MIPProgress"); err != nil {
+		return 0, 0, 0, err
+	}
+
+	if incumbent, err = cb.getDouble(C.GRB_CB_MIP_OBJBST); err != nil {
+		return 0, 0, 0, err
+	}
+	if bound, err = cb.getDouble(C.GRB_CB_MIP_OBJBND); err != nil {
+		return 0, 0, 0, err
+	}
+	if nodes, err = cb.getDouble(C.GRB_CB_MIP_NODCNT); err != nil {
+		return 0, 0, 0, err
+	}
+	return incumbent, bound, nodes, nil
+}
+
+// getDouble retrieves a double valued piece of callback information.
+func (cb *CallbackContext) getDouble(what C.int) (float64, error) {
+	var value float64
+	done := traceCall("GRBcbget", cb.Where, what)
+	errCode := C.GRBcbget(cb.cbdata, C.int(cb.Where), what, unsafe.Pointer(&value))
+	done(errCode)
+	if errCode != 0 {
+		return 0, cb.Model.MakeError(errCode)
+	}
+	return value, nil
+}
+
+// callbackFunc returns the Go function currently registered as the model's callback (or nil).
+func (model *Model) callbackFunc() CallbackFunc {
+	if model.callbackHandle == 0 {
+		return nil
+	}
+	return model.callbackHandle.Value().(*callbackState).fn
+}
+
+//export goCallback
+func goCallback(model *C.GRBmodel, cbdata unsafe.Pointer, where C.int, usrdata unsafe.Pointer) (result C.int) {
+	state := cgo.Handle(uintptr(usrdata)).Value().(*callbackState)
+
+	// A panic must not unwind through the C stack, so it is turned into an error.
+	defer func() {
+		if r := recover(); r != nil {
+			state.err = fmt.Errorf("panic in gurobi callback: %v", r)
+			result = 1
+		}
+	}()
+
+	// The model must not be used (other than through cb) until the callback returns.
+	atomic.StoreInt32(&state.model.inCallback, 1)
+	defer atomic.StoreInt32(&state.model.inCallback, 0)
+
+	cb := &CallbackContext{Model: state.model, Where: Where(where), cbdata: cbdata, numVars: state.numVars, sense: state.sense, data: state.data}
+	if err := state.fn(cb); err != nil {
+		state.err = err
+		return 1
+	}
+	return 0
+}
+
+/*
+MIPSolution
+Description:
+
+	Returns the values of all variables in the new incumbent solution.
+	This is only available when Where is WhereMIPSol.
+*/
+func (cb *CallbackContext) MIPSolution() ([]float64, error) {
+	if err := cb.require("MIPSolution"); err != nil {
+		return nil, err
+	}
+
+	sol := make([]float64, cb.numVars)
+	if cb.numVars == 0 {
+		return sol, nil
+	}
+
+	done := traceCall("GRBcbget", cb.Where)
+	errCode := C.GRBcbget(cb.cbdata, C.int(cb.Where), C.GRB_CB_MIPSOL_SOL, unsafe.Pointer(&sol[0]))
+	done(errCode)
+	if errCode != 0 {
+		return nil, cb.Model.MakeError(errCode)
+	}
+	return sol, nil
+}
+
+/*
+SetLazyConstraints
+Description:
+
+	Enables (or disables) the LazyConstraints parameter, which must be set
+	for AddLazy to be used in the callback of the model.
+*/
+func (model *Model) SetLazyConstraints(enabled bool) error {
+	value := 0
+	if enabled {
+		value = 1
+	}
+	return model.SetIntParam("LazyConstraints", value)
+}
+
+/*
+LazyConstraints
+Description:
+
+	Returns true if the LazyConstraints parameter of the model is set.
+*/
+func (model *Model) LazyConstraints() (bool, error) {
+	value, err := model.GetIntParam("LazyConstraints")
+	if err != nil {
+		return false, err
+	}
+	return value != 0, nil
+}
+
+/*
+AddLazy
+Description:
+
+	Adds the lazy constraint sum(vals[i] * vars[i]) sense rhs from within a
+	WhereMIPSol or WhereMIPNode callback. Lazy constraints must be enabled
+	with SetLazyConstraints before optimizing.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_cblazy.html
+*/
+func (cb *CallbackContext) AddLazy(vars []*Var, vals []float64, sense Sense, rhs float64) error {
+	if err := cb.require("AddLazy"); err != nil {
+		return err
+	}
+
+	if err := sense.Check(); err != nil {
+		return err
+	}
+
+	ind, err := callbackTerms(vars, vals, "lazy constraint")
+	if err != nil {
+		return err
+	}
+
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBcblazy", sense, rhs)
+	errCode := C.GRBcblazy(cb.cbdata, C.int(len(ind)), args.ints(ind), args.doubles(vals), C.char(sense), C.double(rhs))
+	done(errCode)
+	if errCode != 0 {
+		return cb.Model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+AddCut
+Description:
+
+	Adds the user cut sum(vals[i] * vars[i]) sense rhs from within a
+	WhereMIPNode callback. A cut must be valid for every integer feasible
+	solution; it only tightens the relaxation. Set the PreCrush parameter to
+	1 before optimizing so that cuts can be translated to the presolved model.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_cbcut.html
+*/
+func (cb *CallbackContext) AddCut(vars []*Var, vals []float64, sense Sense, rhs float64) error {
+	if err := cb.require("AddCut"); err != nil {
+		return err
+	}
+
+	if err := sense.Check(); err != nil {
+		return err
+	}
+
+	ind, err := callbackTerms(vars, vals, "cut")
+	if err != nil {
+		return err
+	}
+
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBcbcut", sense, rhs)
+	errCode := C.GRBcbcut(cb.cbdata, C.int(len(ind)), args.ints(ind), args.doubles(vals), C.char(sense), C.double(rhs))
+	done(errCode)
+	if errCode != 0 {
+		return cb.Model.MakeError(errCode)
+	}
+	return nil
+}
+
+/*
+NodeRelaxation
+Description:
+
+	Returns the values of all variables in the relaxation of the current node.
+	This is only available when Where is WhereMIPNode and the relaxation was
+	solved to optimality (see WhatMIPNodeStatus).
+*/
+func (cb *CallbackContext) NodeRelaxation() ([]float64, error) {
+	if err := cb.require("NodeRelaxation"); err != nil {
+		return nil, err
+	}
+
+	status, err := cb.GetInt(WhatMIPNodeStatus)
+	if err != nil {
+		return nil, err
+	}
+	if Status(status) != StatusOptimal {
+		return nil, fmt.Errorf("the node relaxation is not available for a node with status %v", Status(status))
+	}
+
+	rel := make([]float64, cb.numVars)
+	if cb.numVars == 0 {
+		return rel, nil
+	}
+
+	done := traceCall("GRBcbget", cb.Where)
+	errCode := C.GRBcbget(cb.cbdata, C.int(cb.Where), C.GRB_CB_MIPNODE_REL, unsafe.Pointer(&rel[0]))
+	done(errCode)
+	if errCode != 0 {
+		return nil, cb.Model.MakeError(errCode)
+	}
+	return rel, nil
+}
+
+/*
+SetSolution
+Description:
+
+	Posts a (possibly partial) candidate solution from within a WhereMIP,
+	WhereMIPSol or WhereMIPNode callback; variables which are not in vars
+	are left undefined. In a WhereMIPNode callback Gurobi completes and
+	checks the solution immediately and returns its objective value (or
+	INFINITY if no feasible solution was found); otherwise the solution is
+	checked after the callback returns and INFINITY is returned.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_cbsolution.html
+*/
+func (cb *CallbackContext) SetSolution(vars []*Var, vals []float64) (float64, error) {
+	if err := cb.require("SetSolution"); err != nil {
+		return 0, err
+	}
+
+	ind, err := callbackTerms(vars, vals, "solution")
+	if err != nil {
+		return 0, err
+	}
+
+	sol := make([]float64, cb.numVars)
+	for i := range sol {
+		sol[i] = UNDEFINED
+	}
+	for i, j := range ind {
+		if j >= cb.numVars {
+			return 0, fmt.Errorf("the variable at position %v of the solution is invalid", i)
+		}
+		if err := checkFinite(fmt.Sprintf("the value at position %v", i), vals[i]); err != nil {
+			return 0, err
+		}
+		sol[j] = vals[i]
+	}
+
+	args := &cgoArgs{}
+	defer args.free()
+
+	var objval C.double
+	done := traceCall("GRBcbsolution", len(ind))
+	errCode := C.GRBcbsolution(cb.cbdata, args.doubles(sol), &objval)
+	done(errCode)
+	if errCode != 0 {
+		return 0, cb.Model.MakeError(errCode)
+	}
+	return float64(objval), nil
+}
+
+// callbackTerms validates the terms of a lazy constraint or cut and returns
+// the indices of its variables.
+func callbackTerms(vars []*Var, vals []float64, kind string) ([]int32, error) {
+	if len(vars) != len(vals) {
+		return nil, MismatchedLengthError{
+			Length1: len(vars),
+			Name1:   "vars",
+			Length2: len(vals),
+			Name2:   "vals",
+		}
+	}
+
+	ind := make([]int32, len(vars))
+	for i, v := range vars {
+		if v == nil || v.Index < 0 {
+			return nil, fmt.Errorf("the variable at position %v of the %v is invalid", i, kind)
+		}
+		ind[i] = v.Index
+	}
+	return ind, nil
+}
@@ -0,0 +1,162 @@
+package gurobi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+/*
+callbackguard.go
+Description:
+	Guardrails for code which runs inside a callback. Gurobi only allows the
+	GRBcb* functions (and GRBterminate) to be called while a callback is
+	running; calling any other function on the model (e.g., adding a
+	variable or querying an attribute) can crash the process. The model
+	therefore tracks whether one of its callbacks is running and refuses
+	such calls with ErrInCallback, and each CallbackContext method checks that
+	it is legal for the current where code.
+Notes:
+	The same holds for other goroutines while the model is being solved
+	(Optimize, ComputeIIS or Tune): Model methods are refused with
+	ErrSolving until the solve returns. The only calls which are safe
+	during a solve are Terminate, InCallback, Solving and the methods of a
+	Monitor (see monitor.go), which reads the progress reported to the
+	callback instead of querying the model.
+*/
+
+// ErrInCallback is returned by Model methods which are called while one of the model's callbacks is running.
+var ErrInCallback = errors.New("this operation is not allowed while a callback of the model is running")
+
+// ErrSolving is returned by Model methods which are called (from another goroutine) while the model is being solved.
+var ErrSolving = errors.New("this operation is not allowed while the model is being solved")
+
+/*
+CallbackWhereError
+Description:
+
+	Returned by a CallbackContext method which is not available for the
+	where code of the callback.
+*/
+type CallbackWhereError struct {
+	Operation string
+	Where     Where
+	Allowed   []Where
+}
+
+func (e CallbackWhereError) Error() string {
+	allowed := make([]string, len(e.Allowed))
+	for i, w := range e.Allowed {
+		allowed[i] = w.String()
+	}
+	return fmt.Sprintf("%v is only available in the %v callbacks, not %v", e.Operation, strings.Join(allowed, ", "), e.Where)
+}
+
+// allWheres lists every where code from which a callback can be called.
+var allWheres = []Where{
+	WherePolling, WherePresolve, WhereSimplex, WhereMIP, WhereMIPSol,
+	WhereMIPNode, WhereMessage, WhereBarrier, WhereMultiObj, WhereIIS,
+}
+
+// callbackOps maps each CallbackContext operation to the where codes in which it is legal.
+var callbackOps = map[string][]Where{
+	"Message":         {WhereMessage},
+	"Runtime":         exceptWheres(WherePolling),
+	"MIPSolObj":       {WhereMIPSol},
+	"MIPSolution":     {WhereMIPSol},
+	"MIPProgress":     {WhereMIP},
+	"AddLazy":         {WhereMIPSol, WhereMIPNode},
+	"AddCut":          {WhereMIPNode},
+	"NodeRelaxation":  {WhereMIPNode},
+	"SetSolution":     {WhereMIP, WhereMIPSol, WhereMIPNode},
+	"StopOneMultiObj": exceptWheres(WhereMultiObj),
+	"Terminate":       allWheres,
+}
+
+func exceptWheres(excluded Where) []Where {
+	wheres := []Where{}
+	for _, w := range allWheres {
+		if w != excluded {
+			wheres = append(wheres, w)
+		}
+	}
+	return wheres
+}
+
+/*
+CanCall
+Description:
+
+	Returns true if the CallbackContext method named op (e.g., "AddLazy")
+	may be called for the where code of this callback.
+*/
+func (cb *CallbackContext) CanCall(op string) bool {
+	for _, w := range callbackOps[op] {
+		if w == cb.Where {
+			return true
+		}
+	}
+	return false
+}
+
+// require returns a CallbackWhereError if op may not be called for the where code of this callback.
+func (cb *CallbackContext) require(op string) error {
+	if cb.CanCall(op) {
+		return nil
+	}
+	return CallbackWhereError{Operation: op, Where: cb.Where, Allowed: callbackOps[op]}
+}
+
+/*
+Terminate
+Description:
+
+	Asks Gurobi to stop the optimization as soon as possible. Unlike the
+	other Model methods, Model.Terminate may also be called from a callback.
+*/
+func (cb *CallbackContext) Terminate() {
+	cb.Model.Terminate()
+}
+
+/*
+InCallback
+Description:
+
+	Returns true while one of the model's callbacks is running.
+*/
+func (model *Model) InCallback() bool {
+	return model != nil && atomic.LoadInt32(&model.inCallback) != 0
+}
+
+/*
+Solving
+Description:
+
+	Returns true while the model is being solved (by Optimize, ComputeIIS
+	or Tune).
+*/
+func (model *Model) Solving() bool {
+	return model != nil && atomic.LoadInt32(&model.solving) != 0
+}
+
+// checkNotInCallback returns ErrInCallback (wrapped with the name of op) while a callback is running,
+// and ErrSolving while the model is being solved.
+func (model *Model) checkNotInCallback(op string) error {
+	if model.InCallback() {
+		return fmt.Errorf("%v: %w", op, ErrInCallback)
+	}
+	if model.Solving() {
+		return fmt.Errorf("%v: %w", op, ErrSolving)
+	}
+	return nil
+}
+
+// beginSolve marks the model as being solved by op until the returned function is called.
+// It returns ErrSolving if the model is already being solved.
+func (model *Model) beginSolve(op string) (func(), error) {
+	if !atomic.CompareAndSwapInt32(&model.solving, 0, 1) {
+		return nil, fmt.Errorf("%v: %w", op, ErrSolving)
+	}
+	return func() { atomic.StoreInt32(&model.solving, 0) }, nil
+}
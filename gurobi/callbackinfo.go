@@ -0,0 +1,202 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+/*
+callbackinfo.go
+Description:
+	Typed access to the information which GRBcbget provides in a callback
+	(e.g., the simplex iteration count or the number of open nodes), beyond
+	the dedicated CallbackContext methods like MIPProgress.
+Notes:
+	Every What code is only available for one where code (except Runtime
+	and Work), and is either an int or a double; GetInt and GetDouble check
+	both before calling GRBcbget.
+	https://www.gurobi.com/documentation/current/refman/cb_codes.html
+*/
+
+// What is a piece of information which can be queried in a callback.
+type What int32
+
+const (
+	WhatPreColDel      What = C.GRB_CB_PRE_COLDEL
+	WhatPreRowDel      What = C.GRB_CB_PRE_ROWDEL
+	WhatPreSenChg      What = C.GRB_CB_PRE_SENCHG
+	WhatPreBndChg      What = C.GRB_CB_PRE_BNDCHG
+	WhatPreCoeChg      What = C.GRB_CB_PRE_COECHG
+	WhatSpxItrCnt      What = C.GRB_CB_SPX_ITRCNT
+	WhatSpxObjVal      What = C.GRB_CB_SPX_OBJVAL
+	WhatSpxPrimInf     What = C.GRB_CB_SPX_PRIMINF
+	WhatSpxDualInf     What = C.GRB_CB_SPX_DUALINF
+	WhatSpxIsPert      What = C.GRB_CB_SPX_ISPERT
+	WhatMIPObjBst      What = C.GRB_CB_MIP_OBJBST
+	WhatMIPObjBnd      What = C.GRB_CB_MIP_OBJBND
+	WhatMIPNodCnt      What = C.GRB_CB_MIP_NODCNT
+	WhatMIPSolCnt      What = C.GRB_CB_MIP_SOLCNT
+	WhatMIPCutCnt      What = C.GRB_CB_MIP_CUTCNT
+	WhatMIPNodLft      What = C.GRB_CB_MIP_NODLFT
+	WhatMIPItrCnt      What = C.GRB_CB_MIP_ITRCNT
+	WhatMIPSolObj      What = C.GRB_CB_MIPSOL_OBJ
+	WhatMIPSolObjBst   What = C.GRB_CB_MIPSOL_OBJBST
+	WhatMIPSolObjBnd   What = C.GRB_CB_MIPSOL_OBJBND
+	WhatMIPSolNodCnt   What = C.GRB_CB_MIPSOL_NODCNT
+	WhatMIPSolSolCnt   What = C.GRB_CB_MIPSOL_SOLCNT
+	WhatMIPNodeStatus  What = C.GRB_CB_MIPNODE_STATUS
+	WhatMIPNodeObjBst  What = C.GRB_CB_MIPNODE_OBJBST
+	WhatMIPNodeObjBnd  What = C.GRB_CB_MIPNODE_OBJBND
+	WhatMIPNodeNodCnt  What = C.GRB_CB_MIPNODE_NODCNT
+	WhatMIPNodeSolCnt  What = C.GRB_CB_MIPNODE_SOLCNT
+	WhatRuntime        What = C.GRB_CB_RUNTIME
+	WhatWork           What = C.GRB_CB_WORK
+	WhatBarrierItrCnt  What = C.GRB_CB_BARRIER_ITRCNT
+	WhatBarrierPrimObj What = C.GRB_CB_BARRIER_PRIMOBJ
+	WhatBarrierDualObj What = C.GRB_CB_BARRIER_DUALOBJ
+	WhatBarrierPrimInf What = C.GRB_CB_BARRIER_PRIMINF
+	WhatBarrierDualInf What = C.GRB_CB_BARRIER_DUALINF
+	WhatBarrierCompl   What = C.GRB_CB_BARRIER_COMPL
+)
+
+// whatInfo describes a What code: its name, the where codes for which it
+// is available and whether it is an int (or a double).
+type whatInfo struct {
+	name   string
+	wheres []Where
+	isInt  bool
+}
+
+var whatInfos = map[What]whatInfo{
+	WhatPreColDel:      {"PRE_COLDEL", []Where{WherePresolve}, true},
+	WhatPreRowDel:      {"PRE_ROWDEL", []Where{WherePresolve}, true},
+	WhatPreSenChg:      {"PRE_SENCHG", []Where{WherePresolve}, true},
+	WhatPreBndChg:      {"PRE_BNDCHG", []Where{WherePresolve}, true},
+	WhatPreCoeChg:      {"PRE_COECHG", []Where{WherePresolve}, true},
+	WhatSpxItrCnt:      {"SPX_ITRCNT", []Where{WhereSimplex}, false},
+	WhatSpxObjVal:      {"SPX_OBJVAL", []Where{WhereSimplex}, false},
+	WhatSpxPrimInf:     {"SPX_PRIMINF", []Where{WhereSimplex}, false},
+	WhatSpxDualInf:     {"SPX_DUALINF", []Where{WhereSimplex}, false},
+	WhatSpxIsPert:      {"SPX_ISPERT", []Where{WhereSimplex}, true},
+	WhatMIPObjBst:      {"MIP_OBJBST", []Where{WhereMIP}, false},
+	WhatMIPObjBnd:      {"MIP_OBJBND", []Where{WhereMIP}, false},
+	WhatMIPNodCnt:      {"MIP_NODCNT", []Where{WhereMIP}, false},
+	WhatMIPSolCnt:      {"MIP_SOLCNT", []Where{WhereMIP}, true},
+	WhatMIPCutCnt:      {"MIP_CUTCNT", []Where{WhereMIP}, true},
+	WhatMIPNodLft:      {"MIP_NODLFT", []Where{WhereMIP}, false},
+	WhatMIPItrCnt:      {"MIP_ITRCNT", []Where{WhereMIP}, false},
+	WhatMIPSolObj:      {"MIPSOL_OBJ", []Where{WhereMIPSol}, false},
+	WhatMIPSolObjBst:   {"MIPSOL_OBJBST", []Where{WhereMIPSol}, false},
+	WhatMIPSolObjBnd:   {"MIPSOL_OBJBND", []Where{WhereMIPSol}, false},
+	WhatMIPSolNodCnt:   {"MIPSOL_NODCNT", []Where{WhereMIPSol}, false},
+	WhatMIPSolSolCnt:   {"MIPSOL_SOLCNT", []Where{WhereMIPSol}, true},
+	WhatMIPNodeStatus:  {"MIPNODE_STATUS", []Where{WhereMIPNode}, true},
+	WhatMIPNodeObjBst:  {"MIPNODE_OBJBST", []Where{WhereMIPNode}, false},
+	WhatMIPNodeObjBnd:  {"MIPNODE_OBJBND", []Where{WhereMIPNode}, false},
+	WhatMIPNodeNodCnt:  {"MIPNODE_NODCNT", []Where{WhereMIPNode}, false},
+	WhatMIPNodeSolCnt:  {"MIPNODE_SOLCNT", []Where{WhereMIPNode}, true},
+	WhatRuntime:        {"RUNTIME", exceptWheres(WherePolling), false},
+	WhatWork:           {"WORK", exceptWheres(WherePolling), false},
+	WhatBarrierItrCnt:  {"BARRIER_ITRCNT", []Where{WhereBarrier}, true},
+	WhatBarrierPrimObj: {"BARRIER_PRIMOBJ", []Where{WhereBarrier}, false},
+	WhatBarrierDualObj: {"BARRIER_DUALOBJ", []Where{WhereBarrier}, false},
+	WhatBarrierPrimInf: {"BARRIER_PRIMINF", []Where{WhereBarrier}, false},
+	WhatBarrierDualInf: {"BARRIER_DUALINF", []Where{WhereBarrier}, false},
+	WhatBarrierCompl:   {"BARRIER_COMPL", []Where{WhereBarrier}, false},
+}
+
+/*
+String
+Description:
+
+	Returns the name of the what code as it appears in Gurobi's
+	documentation (without the GRB_CB_ prefix).
+*/
+func (what What) String() string {
+	if info, ok := whatInfos[what]; ok {
+		return info.name
+	}
+	return fmt.Sprintf("What(%v)", int32(what))
+}
+
+/*
+IsInt
+Description:
+
+	Returns true if the information is an int (and false if it is a double
+	or unknown).
+*/
+func (what What) IsInt() bool {
+	return whatInfos[what].isInt
+}
+
+/*
+Available
+Description:
+
+	Returns true if what can be queried for the where code of this callback.
+*/
+func (cb *CallbackContext) Available(what What) bool {
+	for _, w := range whatInfos[what].wheres {
+		if w == cb.Where {
+			return true
+		}
+	}
+	return false
+}
+
+// checkWhat returns an error if what is unknown, is not of the requested
+// type or is not available for the where code of this callback.
+func (cb *CallbackContext) checkWhat(what What, isInt bool) error {
+	info, ok := whatInfos[what]
+	if !ok {
+		return fmt.Errorf("unknown callback information %v", what)
+	}
+	if info.isInt != isInt {
+		kind, method := "a double", "GetDouble"
+		if info.isInt {
+			kind, method = "an int", "GetInt"
+		}
+		return fmt.Errorf("%v is %v; use %v", what, kind, method)
+	}
+	if !cb.Available(what) {
+		return CallbackWhereError{Operation: what.String(), Where: cb.Where, Allowed: info.wheres}
+	}
+	return nil
+}
+
+/*
+GetInt
+Description:
+
+	Returns the int valued callback information what (e.g., WhatMIPSolCnt).
+*/
+func (cb *CallbackContext) GetInt(what What) (int, error) {
+	if err := cb.checkWhat(what, true); err != nil {
+		return 0, err
+	}
+
+	var value C.int
+	done := traceCall("GRBcbget", cb.Where, what)
+	errCode := C.GRBcbget(cb.cbdata, C.int(cb.Where), C.int(what), unsafe.Pointer(&value))
+	done(errCode)
+	if errCode != 0 {
+		return 0, cb.Model.MakeError(errCode)
+	}
+	return int(value), nil
+}
+
+/*
+GetDouble
+Description:
+
+	Returns the double valued callback information what (e.g., WhatSpxObjVal).
+*/
+func (cb *CallbackContext) GetDouble(what What) (float64, error) {
+	if err := cb.checkWhat(what, false); err != nil {
+		return 0, err
+	}
+	return cb.getDouble(C.int(what))
+}
@@ -0,0 +1,81 @@
+package gurobi
+
+import "fmt"
+
+/*
+cardinality.go
+Description:
+	Helpers for cardinality ("at most k of these variables") constraints.
+*/
+
+/*
+CardinalityFormulation
+Description:
+
+	Chooses how AddCardinality encodes its constraint.
+	  - CardinalityLinear adds sum(vars) <= k; it requires the variables to be binary.
+	  - CardinalitySOS1 introduces a binary z_i per variable together with the SOS1
+	    set {vars[i], z_i} (so z_i = 1 forces vars[i] = 0) and adds
+	    sum(z) >= len(vars) - k. It limits the number of nonzero variables of any
+	    type without needing big-M bounds.
+*/
+type CardinalityFormulation int
+
+const (
+	CardinalityLinear CardinalityFormulation = iota
+	CardinalitySOS1
+)
+
+/*
+AddCardinality
+Description:
+
+	Adds a constraint requiring at most k of vars to be nonzero, using the given
+	formulation. The returned constraint is the linear row counting the variables.
+*/
+func (model *Model) AddCardinality(vars []*Var, k int, formulation CardinalityFormulation, name string) (*Constr, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if k < 0 {
+		return nil, fmt.Errorf("the cardinality bound k must be nonnegative; received %v", k)
+	}
+
+	for i, v := range vars {
+		if v == nil || v.Index < 0 {
+			return nil, fmt.Errorf("the variable at position %v is invalid", i)
+		}
+	}
+
+	// Algorithm
+	switch formulation {
+	case CardinalityLinear:
+		vals := make([]float64, len(vars))
+		for i := range vals {
+			vals[i] = 1.0
+		}
+		return model.AddConstr(vars, vals, Le, float64(k), name)
+	case CardinalitySOS1:
+		zs := make([]*Var, len(vars))
+		vals := make([]float64, len(vars))
+		for i, v := range vars {
+			z, err := model.AddVar(Binary, 0.0, 0.0, 1.0, fmt.Sprintf("%v_zero%v", name, i), []*Constr{}, []float64{})
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := model.AddSOS([]*Var{v, z}, []float64{1.0, 2.0}, SOSType1); err != nil {
+				return nil, err
+			}
+
+			zs[i] = z
+			vals[i] = 1.0
+		}
+		return model.AddConstr(zs, vals, Ge, float64(len(vars)-k), name)
+	default:
+		return nil, fmt.Errorf("unrecognized cardinality formulation %v", formulation)
+	}
+}
@@ -0,0 +1,95 @@
+package gurobi
+
+/*
+#include <stdlib.h>
+#include <gurobi_passthrough.h>
+*/
+import "C"
+import (
+	"runtime"
+	"unsafe"
+)
+
+/*
+cgoargs.go
+Description:
+	A helper for passing slices and strings to a single call into the C
+	library. Numeric slices are passed as pointers to their first element
+	and are kept alive until the call has returned; strings are copied into
+	C memory which is freed afterwards (instead of being leaked by a bare
+	C.CString).
+Notes:
+	Typical use:
+
+		args := &cgoArgs{}
+		defer args.free()
+		C.GRBaddvars(..., args.doubles(objs), ..., args.strings(names))
+
+	Empty slices are passed as NULL, which is what the C API expects for
+	optional arrays.
+*/
+
+type cgoArgs struct {
+	// keep holds the Go slices which were passed to C.
+	keep []interface{}
+	// alloc holds the C memory which was allocated for strings.
+	alloc []unsafe.Pointer
+}
+
+// doubles returns a pointer to the first element of s (NULL if s is empty).
+func (args *cgoArgs) doubles(s []float64) *C.double {
+	if len(s) == 0 {
+		return nil
+	}
+	args.keep = append(args.keep, s)
+	return (*C.double)(unsafe.Pointer(&s[0]))
+}
+
+// ints returns a pointer to the first element of s (NULL if s is empty).
+func (args *cgoArgs) ints(s []int32) *C.int {
+	if len(s) == 0 {
+		return nil
+	}
+	args.keep = append(args.keep, s)
+	return (*C.int)(unsafe.Pointer(&s[0]))
+}
+
+// chars returns a pointer to the first element of s (NULL if s is empty).
+func (args *cgoArgs) chars(s []int8) *C.char {
+	if len(s) == 0 {
+		return nil
+	}
+	args.keep = append(args.keep, s)
+	return (*C.char)(unsafe.Pointer(&s[0]))
+}
+
+// str returns a copy of s in C memory.
+func (args *cgoArgs) str(s string) *C.char {
+	cs := C.CString(s)
+	args.alloc = append(args.alloc, unsafe.Pointer(cs))
+	return cs
+}
+
+// strings returns a C array with copies of s in C memory (NULL if s is empty).
+func (args *cgoArgs) strings(s []string) **C.char {
+	if len(s) == 0 {
+		return nil
+	}
+
+	array := (**C.char)(C.malloc(C.size_t(len(s)) * C.size_t(unsafe.Sizeof((*C.char)(nil)))))
+	args.alloc = append(args.alloc, unsafe.Pointer(array))
+	elems := unsafe.Slice(array, len(s))
+	for i := range s {
+		elems[i] = args.str(s[i])
+	}
+	return array
+}
+
+// free releases the C memory and keeps the Go slices alive up to this point.
+func (args *cgoArgs) free() {
+	runtime.KeepAlive(args.keep)
+	for _, p := range args.alloc {
+		C.free(p)
+	}
+	args.keep, args.alloc = nil, nil
+}
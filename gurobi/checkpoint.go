@@ -0,0 +1,194 @@
+package gurobi
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+/*
+checkpoint.go
+Description:
+	Checkpoints for long solves. A checkpoint directory contains the
+	incumbent as a MIP start (incumbent.mst), the simplex basis of an LP
+	(basis.bas), the model's parameters (params.prm) and a small JSON file
+	(checkpoint.json) with the cumulative runtime and the state of the solve.
+	ResumeFrom loads all of these into a freshly built (or read) model, so
+	that a solve which was interrupted by a deployment or a preempted
+	machine continues where it left off instead of starting over.
+*/
+
+const (
+	checkpointFile   = "checkpoint.json"
+	checkpointStart  = "incumbent.mst"
+	checkpointBasis  = "basis.bas"
+	checkpointParams = "params.prm"
+)
+
+/*
+Checkpoint
+Description:
+
+	The state of a solve when Checkpoint was called. CumulativeRuntime
+	includes the runtime of every solve since the first checkpoint which
+	the model was resumed from.
+*/
+type Checkpoint struct {
+	Time              time.Time `json:"time"`
+	CumulativeRuntime float64   `json:"cumulative_runtime"`
+	Status            Status    `json:"status"`
+	SolCount          int32     `json:"sol_count"`
+	ObjVal            float64   `json:"obj_val,omitempty"`
+	ObjBound          float64   `json:"obj_bound,omitempty"`
+	HasStart          bool      `json:"has_start"`
+	HasBasis          bool      `json:"has_basis"`
+}
+
+/*
+Checkpoint
+Description:
+
+	Writes a checkpoint of the model's current solve to dir (which is
+	created if needed). The incumbent is saved if there is one and the
+	basis is saved for continuous models for which it is available.
+	checkpoint.json is written last (through a rename), so a directory
+	with a checkpoint.json always holds a complete checkpoint.
+*/
+func (model *Model) Checkpoint(dir string) (*Checkpoint, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	// Algorithm
+	cp := &Checkpoint{Time: time.Now()}
+	if cp.CumulativeRuntime, err = model.CumulativeRuntime(); err != nil {
+		return nil, err
+	}
+
+	status, err := model.Status()
+	if err != nil {
+		return nil, err
+	}
+	cp.Status = status
+
+	if cp.SolCount, err = model.optionalIntAttr(INT_ATTR_SOLCOUNT); err != nil {
+		return nil, err
+	}
+	if cp.SolCount > 0 {
+		if cp.ObjVal, err = model.optionalDoubleAttr(DBL_ATTR_OBJVAL); err != nil {
+			return nil, err
+		}
+		if err := model.Write(filepath.Join(dir, checkpointStart)); err != nil {
+			return nil, err
+		}
+		cp.HasStart = true
+	}
+
+	isMIP, err := model.optionalIntAttr("IsMIP")
+	if err != nil {
+		return nil, err
+	}
+	if isMIP != 0 {
+		if cp.ObjBound, err = model.optionalDoubleAttr(DBL_ATTR_OBJBOUND); err != nil {
+			return nil, err
+		}
+	} else if cp.SolCount > 0 {
+		// The basis is not available after barrier without crossover, which is not an error here.
+		cp.HasBasis = model.Write(filepath.Join(dir, checkpointBasis)) == nil
+	}
+
+	env, err := model.ModelEnv()
+	if err != nil {
+		return nil, err
+	}
+	if err := env.WriteParams(filepath.Join(dir, checkpointParams)); err != nil {
+		return nil, err
+	}
+
+	contents, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	tmp := filepath.Join(dir, checkpointFile+".tmp")
+	if err := os.WriteFile(tmp, contents, 0o644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, checkpointFile)); err != nil {
+		return nil, err
+	}
+
+	return cp, nil
+}
+
+/*
+ResumeFrom
+Description:
+
+	Loads the checkpoint in dir into the model: its parameters, the
+	incumbent as a MIP start and the basis. The model must have the same
+	variables and constraints as the checkpointed one. The runtime of the
+	checkpoint is carried over into CumulativeRuntime.
+*/
+func (model *Model) ResumeFrom(dir string) (*Checkpoint, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := os.ReadFile(filepath.Join(dir, checkpointFile))
+	if err != nil {
+		return nil, fmt.Errorf("there is no complete checkpoint in %v: %w", dir, err)
+	}
+
+	cp := &Checkpoint{}
+	if err := json.Unmarshal(contents, cp); err != nil {
+		return nil, fmt.Errorf("the checkpoint in %v is corrupt: %w", dir, err)
+	}
+
+	// Algorithm
+	env, err := model.ModelEnv()
+	if err != nil {
+		return nil, err
+	}
+	if err := env.ReadParams(filepath.Join(dir, checkpointParams)); err != nil {
+		return nil, err
+	}
+
+	if cp.HasStart {
+		if err := model.Read(filepath.Join(dir, checkpointStart)); err != nil {
+			return nil, err
+		}
+	}
+	if cp.HasBasis {
+		if err := model.Read(filepath.Join(dir, checkpointBasis)); err != nil {
+			return nil, err
+		}
+	}
+
+	model.priorRuntime = cp.CumulativeRuntime
+	return cp, nil
+}
+
+/*
+CumulativeRuntime
+Description:
+
+	Returns the runtime of the most recent optimization plus the runtime
+	carried over from the checkpoint the model was resumed from (if any).
+*/
+func (model *Model) CumulativeRuntime() (float64, error) {
+	runtime, err := model.optionalDoubleAttr(DBL_ATTR_RUNTIME)
+	if err != nil {
+		return 0, err
+	}
+	return model.priorRuntime + runtime, nil
+}
@@ -0,0 +1,133 @@
+package gurobi
+
+import "math"
+
+/*
+compare.go
+Description:
+	Objective comparisons which respect the sense of the objective, for user
+	code which compares incumbents (e.g., in callbacks or across scenarios).
+Notes:
+	Values of magnitude INFINITY or more are treated as "no solution" (the
+	convention of Gurobi's ObjVal/ObjBound attributes and callback values).
+*/
+
+// objTol is the relative tolerance below which two objective values are considered equal.
+const objTol = 1e-9
+
+/*
+IsBetter
+Description:
+
+	Returns true if the objective value a is strictly better than b for the
+	sense, i.e., smaller when minimizing and larger when maximizing. Values
+	which differ by less than a relative tolerance of 1e-9 are not better.
+*/
+func IsBetter(a float64, b float64, sense ObjSense) bool {
+	tol := objTol * math.Max(1, math.Abs(b))
+	if sense == Maximize {
+		return a > b+tol
+	}
+	return a < b-tol
+}
+
+/*
+Best
+Description:
+
+	Returns the better of the objective values a and b for the sense.
+*/
+func Best(a float64, b float64, sense ObjSense) float64 {
+	if IsBetter(b, a, sense) {
+		return b
+	}
+	return a
+}
+
+/*
+WorstObjective
+Description:
+
+	Returns the objective value of "no solution" for the sense: INFINITY when
+	minimizing and -INFINITY when maximizing. Any solution is better.
+*/
+func WorstObjective(sense ObjSense) float64 {
+	if sense == Maximize {
+		return -INFINITY
+	}
+	return INFINITY
+}
+
+/*
+RelativeGap
+Description:
+
+	Returns the relative gap between the incumbent and the bound for the
+	sense, following the convention of Gurobi's MIPGap attribute:
+	(incumbent - bound) / |incumbent| when minimizing and
+	(bound - incumbent) / |incumbent| when maximizing. The gap is +Inf if
+	there is no incumbent (or it is 0 while the bound is not), 0 if both
+	values are equal, and never negative.
+*/
+func RelativeGap(incumbent float64, bound float64, sense ObjSense) float64 {
+	if math.Abs(incumbent) >= INFINITY {
+		return math.Inf(1)
+	}
+	if incumbent == bound {
+		return 0
+	}
+
+	diff := incumbent - bound
+	if sense == Maximize {
+		diff = -diff
+	}
+	if diff <= 0 {
+		return 0
+	}
+	if incumbent == 0 {
+		return math.Inf(1)
+	}
+	return diff / math.Abs(incumbent)
+}
+
+/*
+AbsoluteGap
+Description:
+
+	Returns the absolute gap between the incumbent and the bound for the
+	sense (never negative, +Inf if there is no incumbent or bound).
+*/
+func AbsoluteGap(incumbent float64, bound float64, sense ObjSense) float64 {
+	if math.Abs(incumbent) >= INFINITY || math.Abs(bound) >= INFINITY {
+		return math.Inf(1)
+	}
+
+	diff := incumbent - bound
+	if sense == Maximize {
+		diff = -diff
+	}
+	return math.Max(diff, 0)
+}
+
+/*
+ModelSense
+Description:
+
+	Returns the sense of the objective of the model being solved. Unlike
+	Model.GetModelSense, this may be called from the callback; the sense is
+	read when the optimization starts.
+*/
+func (cb *CallbackContext) ModelSense() ObjSense {
+	return cb.sense
+}
+
+/*
+IsBetter
+Description:
+
+	Returns true if the objective value a is strictly better than b for the
+	sense of the model being solved (see IsBetter).
+*/
+func (cb *CallbackContext) IsBetter(a float64, b float64) bool {
+	return IsBetter(a, b, cb.sense)
+}
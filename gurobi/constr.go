@@ -1,11 +1,144 @@
 package gurobi
 
+import "fmt"
+
 // Gurobi linear constraint object
 type Constr struct {
 	Model *Model
 	Index int32
 }
 
+/*
+GetAttr
+Description:
+
+	Returns the value of the linear constraint attribute attr (e.g., "RHS",
+	"Sense" or "Pi") as an int32, int8, float64 or string, depending on the
+	attribute's type.
+*/
+func (c *Constr) GetAttr(attr string) (interface{}, error) {
+	return c.Model.getAttrElement(attr, c.Index, attrElemConstr)
+}
+
+/*
+SetAttr
+Description:
+
+	Sets the linear constraint attribute attr to value. Numeric values are
+	converted to the attribute's type, so c.SetAttr("RHS", 3) sets the
+	right-hand side to 3.0.
+*/
+func (c *Constr) SetAttr(attr string, value interface{}) error {
+	return c.Model.setAttrElement(attr, c.Index, attrElemConstr, value)
+}
+
+/*
+TempConstr
+Description:
+
+	A linear constraint (LHS sense RHS) that has not yet been added to a model.
+	TempConstrs are created from a LinExpr with LessEq, GreaterEq and Equal and
+	can then be added with Model.AddTempConstr or used in indicator constraints.
+*/
+type TempConstr struct {
+	LHS   LinExpr
+	Sense Sense
+	RHS   float64
+}
+
+/*
+Check
+Description:
+
+	Checks that the sense of the constraint is valid and that the
+	expression's variables and coefficients line up.
+*/
+func (tc TempConstr) Check() error {
+	if err := tc.Sense.Check(); err != nil {
+		return err
+	}
+
+	if len(tc.LHS.Ind) != len(tc.LHS.Val) {
+		return MismatchedLengthError{
+			Length1: len(tc.LHS.Ind),
+			Name1:   "LHS.Ind",
+			Length2: len(tc.LHS.Val),
+			Name2:   "LHS.Val",
+		}
+	}
+
+	for i, v := range tc.LHS.Ind {
+		if v == nil || v.Index < 0 {
+			return fmt.Errorf("the variable at position %v of the constraint is invalid", i)
+		}
+	}
+
+	return nil
+}
+
+/*
+NormalizedRHS
+Description:
+
+	Returns the right-hand side after moving the constant of the left-hand
+	side to the right (i.e., RHS - LHS.Offset).
+*/
+func (tc TempConstr) NormalizedRHS() float64 {
+	return tc.RHS - tc.LHS.Offset
+}
+
+/*
+SetRHSs
+Description:
+
+	Sets the right-hand sides of constrs to values with a single call to
+	GRBsetdblattrlist, which is much faster than setting them one at a time.
+*/
+func (model *Model) SetRHSs(constrs []*Constr, values []float64) error {
+	if len(constrs) != len(values) {
+		return MismatchedLengthError{
+			Length1: len(constrs),
+			Name1:   "constrs",
+			Length2: len(values),
+			Name2:   "values",
+		}
+	}
+
+	return model.SetDoubleAttrConstrs(DBL_ATTR_RHS, constrs, values)
+}
+
+/*
+SetSenses
+Description:
+
+	Sets the senses of constrs to senses with a single call to
+	GRBsetcharattrlist. senses is kept as a slice of raw int8 values like
+	in AddConstrs; each entry must still be a valid Sense.
+*/
+func (model *Model) SetSenses(constrs []*Constr, senses []int8) error {
+	if len(constrs) != len(senses) {
+		return MismatchedLengthError{
+			Length1: len(constrs),
+			Name1:   "constrs",
+			Length2: len(senses),
+			Name2:   "senses",
+		}
+	}
+
+	ind := make([]int32, len(constrs))
+	for i, c := range constrs {
+		if c == nil || c.Index < 0 {
+			return fmt.Errorf("the constraint at position %v is invalid", i)
+		}
+		if err := Sense(senses[i]).Check(); err != nil {
+			return err
+		}
+		ind[i] = c.Index
+	}
+
+	return model.setCharAttrList(CHAR_ATTR_SENSE, ind, senses)
+}
+
 /*
 VectorConstraintToGurobiSparseFormat
 Description:
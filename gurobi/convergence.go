@@ -0,0 +1,138 @@
+package gurobi
+
+import "math"
+
+/*
+convergence.go
+Description:
+	Records the convergence (incumbent, bound and node count over time) of a
+	MIP solve through a callback, so that convergence plots can be produced
+	without parsing the log.
+*/
+
+/*
+ConvergencePoint
+Description:
+
+	The state of a MIP solve at Time seconds. Incumbent is INFINITY (or
+	-INFINITY when maximizing) until the first solution is found.
+*/
+type ConvergencePoint struct {
+	Time      float64
+	Incumbent float64
+	Bound     float64
+	Nodes     float64
+}
+
+/*
+Gap
+Description:
+
+	Returns the relative MIP gap |Incumbent - Bound| / |Incumbent| at this point,
+	using the same convention as Gurobi's MIPGap attribute. The gap is +Inf
+	while there is no incumbent, and 0 when both values are 0.
+*/
+func (cp ConvergencePoint) Gap() float64 {
+	if math.Abs(cp.Incumbent) >= INFINITY {
+		return math.Inf(1)
+	}
+	if cp.Incumbent == cp.Bound {
+		return 0
+	}
+	if cp.Incumbent == 0 {
+		return math.Inf(1)
+	}
+	return math.Abs(cp.Incumbent-cp.Bound) / math.Abs(cp.Incumbent)
+}
+
+/*
+ConvergenceHistory
+Description:
+
+	Collects ConvergencePoints from the MIP callback. A point is recorded
+	whenever the incumbent or the bound changes, and otherwise at most every
+	MinInterval seconds.
+*/
+type ConvergenceHistory struct {
+	Points      []ConvergencePoint
+	MinInterval float64
+}
+
+/*
+NewConvergenceHistory
+Description:
+
+	Creates a ConvergenceHistory which records unchanged progress at most once per second.
+*/
+func NewConvergenceHistory() *ConvergenceHistory {
+	return &ConvergenceHistory{MinInterval: 1.0}
+}
+
+/*
+Install
+Description:
+
+	Registers the history's callback on the model, replacing any previous callback.
+*/
+func (ch *ConvergenceHistory) Install(model *Model) error {
+	return model.SetCallback(ch.Callback())
+}
+
+/*
+Callback
+Description:
+
+	Returns the CallbackFunc which records the convergence points.
+*/
+func (ch *ConvergenceHistory) Callback() CallbackFunc {
+	return func(cb *CallbackContext) error {
+		if cb.Where != WhereMIP {
+			return nil
+		}
+
+		incumbent, bound, nodes, err := cb.MIPProgress()
+		if err != nil {
+			return err
+		}
+		runtime, err := cb.Runtime()
+		if err != nil {
+			return err
+		}
+
+		ch.Record(ConvergencePoint{Time: runtime, Incumbent: incumbent, Bound: bound, Nodes: nodes})
+		return nil
+	}
+}
+
+/*
+Record
+Description:
+
+	Adds p to the history if the incumbent or bound changed since the last
+	point, or if at least MinInterval seconds have passed.
+*/
+func (ch *ConvergenceHistory) Record(p ConvergencePoint) {
+	if n := len(ch.Points); n > 0 {
+		last := ch.Points[n-1]
+		unchanged := last.Incumbent == p.Incumbent && last.Bound == p.Bound
+		if unchanged && p.Time-last.Time < ch.MinInterval {
+			return
+		}
+	}
+	ch.Points = append(ch.Points, p)
+}
+
+/*
+TimeToGap
+Description:
+
+	Returns the first time at which the gap was at most gap, and false if it never was.
+*/
+func (ch *ConvergenceHistory) TimeToGap(gap float64) (float64, bool) {
+	for _, p := range ch.Points {
+		if p.Gap() <= gap {
+			return p.Time, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,293 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"errors"
+	"fmt"
+)
+
+/*
+costcurve.go
+Description:
+	Piecewise-linear cost curves (with an optional fixed charge) which can be
+	attached to a variable either as a PWL objective or as a cost variable
+	defined by a PWL general constraint.
+*/
+
+/*
+CostCurve
+Description:
+
+	A piecewise-linear cost over [Breakpoints[0], Breakpoints[n-1]].
+	- Breakpoints: Strictly increasing x values.
+	- Slopes: The marginal cost on each segment [Breakpoints[i], Breakpoints[i+1]];
+	  there is one slope less than there are breakpoints.
+	- FixedCharge: A cost incurred whenever x is above Breakpoints[0].
+	  The cost at Breakpoints[0] itself is 0.
+*/
+type CostCurve struct {
+	Breakpoints []float64
+	Slopes      []float64
+	FixedCharge float64
+}
+
+/*
+CostCurveForm
+Description:
+
+	Chooses how a CostCurve is expanded when it is attached to a variable.
+	- CostCurveObjective sets the curve as the PWL objective of the variable.
+	- CostCurveConstraint creates a cost variable defined by a PWL general
+	  constraint, which can then be used in the objective or in other constraints.
+*/
+type CostCurveForm int
+
+const (
+	CostCurveObjective CostCurveForm = iota
+	CostCurveConstraint
+)
+
+/*
+AttachedCostCurve
+Description:
+
+	The result of attaching a CostCurve to Var.
+	- Cost: The variable equal to the curve's cost (nil in the objective form).
+	- FixedChargeVar: The binary which is 1 when Var is above the first
+	  breakpoint (nil when the curve has no fixed charge).
+*/
+type AttachedCostCurve struct {
+	Curve          CostCurve
+	Var            *Var
+	Cost           *Var
+	FixedChargeVar *Var
+}
+
+/*
+Check
+Description:
+
+	Checks that the breakpoints are strictly increasing, that there is one
+	slope per segment and that the fixed charge is nonnegative.
+*/
+func (cc CostCurve) Check() error {
+	if len(cc.Breakpoints) < 2 {
+		return fmt.Errorf("a cost curve needs at least 2 breakpoints; received %v", len(cc.Breakpoints))
+	}
+
+	if len(cc.Slopes) != len(cc.Breakpoints)-1 {
+		return MismatchedLengthError{
+			Length1: len(cc.Slopes),
+			Name1:   "Slopes",
+			Length2: len(cc.Breakpoints) - 1,
+			Name2:   "segments",
+		}
+	}
+
+	for i := 1; i < len(cc.Breakpoints); i++ {
+		if cc.Breakpoints[i] <= cc.Breakpoints[i-1] {
+			return fmt.Errorf("the breakpoints must be strictly increasing; breakpoint %v (%v) is not above %v", i, cc.Breakpoints[i], cc.Breakpoints[i-1])
+		}
+	}
+
+	if cc.FixedCharge < 0 {
+		return fmt.Errorf("the fixed charge must be nonnegative; received %v", cc.FixedCharge)
+	}
+
+	return nil
+}
+
+/*
+Points
+Description:
+
+	Returns the (x, y) breakpoints of the variable part of the curve, with
+	y = 0 at the first breakpoint. The fixed charge is not included.
+*/
+func (cc CostCurve) Points() ([]float64, []float64) {
+	xpts := append([]float64{}, cc.Breakpoints...)
+	ypts := make([]float64, len(xpts))
+	for i := 1; i < len(xpts); i++ {
+		ypts[i] = ypts[i-1] + cc.Slopes[i-1]*(xpts[i]-xpts[i-1])
+	}
+	return xpts, ypts
+}
+
+/*
+Cost
+Description:
+
+	Evaluates the curve (including the fixed charge) at x. Values outside of
+	the breakpoints are extrapolated with the first or last slope.
+*/
+func (cc CostCurve) Cost(x float64) float64 {
+	xpts, ypts := cc.Points()
+	n := len(xpts)
+
+	var y float64
+	switch {
+	case x <= xpts[0]:
+		return cc.Slopes[0] * (x - xpts[0])
+	case x >= xpts[n-1]:
+		y = ypts[n-1] + cc.Slopes[n-2]*(x-xpts[n-1])
+	default:
+		for i := 1; i < n; i++ {
+			if x <= xpts[i] {
+				y = ypts[i-1] + cc.Slopes[i-1]*(x-xpts[i-1])
+				break
+			}
+		}
+	}
+
+	return y + cc.FixedCharge
+}
+
+/*
+SetPWLObj
+Description:
+
+	Sets a piecewise-linear objective on x, defined by the points (xpts[i], ypts[i]).
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_setpwlobj.html
+*/
+func (model *Model) SetPWLObj(x *Var, xpts []float64, ypts []float64) error {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return err
+	}
+
+	if x == nil || x.Index < 0 {
+		return errors.New("Invalid x")
+	}
+
+	if len(xpts) != len(ypts) {
+		return MismatchedLengthError{
+			Length1: len(xpts),
+			Name1:   "xpts",
+			Length2: len(ypts),
+			Name2:   "ypts",
+		}
+	}
+
+	if len(xpts) == 0 {
+		return fmt.Errorf("a PWL objective needs at least one point")
+	}
+
+	if model.dryRun != nil {
+		if err := model.dryRun.checkVars([]*Var{x}); err != nil {
+			return err
+		}
+		model.dryRun.record("SetPWLObj", "var %v, %v points", x.Index, len(xpts))
+		return nil
+	}
+
+	// Algorithm
+	done := traceCall("GRBsetpwlobj", x.Index)
+	errCode := C.GRBsetpwlobj(model.AsGRBModel, C.int(x.Index), C.int(len(xpts)), (*C.double)(&xpts[0]), (*C.double)(&ypts[0]))
+	done(errCode)
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	model.changes.attr("PWLObj")
+
+	return model.Update()
+}
+
+/*
+AttachCostCurve
+Description:
+
+	Attaches cc to x in the given form. The bounds of x are restricted to the
+	curve's breakpoints. When the curve has a fixed charge, a binary z is added
+	with x - x0 <= (xn - x0) z, so the charge is paid whenever x is above x0.
+*/
+func (model *Model) AttachCostCurve(x *Var, cc CostCurve, form CostCurveForm, name string) (*AttachedCostCurve, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if x == nil || x.Index < 0 {
+		return nil, errors.New("Invalid x")
+	}
+
+	if err := cc.Check(); err != nil {
+		return nil, err
+	}
+
+	xpts, ypts := cc.Points()
+	x0, xn := xpts[0], xpts[len(xpts)-1]
+
+	if err := x.SetDouble(DBL_ATTR_LB, x0); err != nil {
+		return nil, err
+	}
+	if err := x.SetDouble(DBL_ATTR_UB, xn); err != nil {
+		return nil, err
+	}
+
+	attached := &AttachedCostCurve{Curve: cc, Var: x}
+
+	// Fixed charge
+	if cc.FixedCharge > 0 {
+		obj := 0.0
+		if form == CostCurveObjective {
+			obj = cc.FixedCharge
+		}
+
+		z, err := model.AddVar(Binary, obj, 0.0, 1.0, name+"_fixed", []*Constr{}, []float64{})
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = model.AddConstr([]*Var{x, z}, []float64{1.0, -(xn - x0)}, Le, x0, name+"_link")
+		if err != nil {
+			return nil, err
+		}
+		attached.FixedChargeVar = z
+	}
+
+	// Variable cost
+	switch form {
+	case CostCurveObjective:
+		if err := model.SetPWLObj(x, xpts, ypts); err != nil {
+			return nil, err
+		}
+	case CostCurveConstraint:
+		y, err := model.AddVar(Continuous, 0.0, -INFINITY, INFINITY, name+"_cost", []*Constr{}, []float64{})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := model.AddGenConstrPWL(x, y, xpts, ypts, name+"_pwl"); err != nil {
+			return nil, err
+		}
+		attached.Cost = y
+
+		if attached.FixedChargeVar != nil {
+			// total = y + FixedCharge * z
+			total, err := model.AddVar(Continuous, 0.0, -INFINITY, INFINITY, name+"_total", []*Constr{}, []float64{})
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = model.AddConstr(
+				[]*Var{total, y, attached.FixedChargeVar},
+				[]float64{1.0, -1.0, -cc.FixedCharge},
+				Eq, 0.0, name+"_totaldef",
+			)
+			if err != nil {
+				return nil, err
+			}
+			attached.Cost = total
+		}
+	default:
+		return nil, fmt.Errorf("unrecognized cost curve form %v", form)
+	}
+
+	return attached, nil
+}
@@ -0,0 +1,81 @@
+package gurobi
+
+import "sort"
+
+/*
+deterministic.go
+Description:
+	A deterministic build mode. Two builds of the same data produce
+	byte-identical model files (e.g., for audits or for diffing the models
+	of two releases in regression tests), and the same solve path.
+Notes:
+	In deterministic mode, the terms of every linear constraint are sorted by
+	variable index and repeated variables are merged, so that the order in
+	which terms were collected (e.g., from a map) does not matter. The Seed
+	parameter is fixed as well. Expressions built with NewLinExprFromMap and
+	the lists returned by Tags and ByTag are sorted in any mode.
+*/
+
+/*
+SetDeterministic
+Description:
+
+	Turns on deterministic construction for the model and fixes the Seed
+	parameter to seed. Constraints added before this call are not changed.
+*/
+func (model *Model) SetDeterministic(seed int) error {
+	if model == nil {
+		return model.MakeUninitializedError()
+	}
+
+	if err := model.SetIntParam("Seed", seed); err != nil {
+		return err
+	}
+	model.deterministic = true
+	return nil
+}
+
+/*
+IsDeterministic
+Description:
+
+	Returns true if the model was put in deterministic mode with SetDeterministic.
+*/
+func (model *Model) IsDeterministic() bool {
+	return model != nil && model.deterministic
+}
+
+// canonicalTerms returns the terms sorted by variable index with the coefficients of
+// repeated variables summed, or the terms as given if the model is not deterministic.
+// Invalid terms are also returned as given, so that the caller reports them.
+func (model *Model) canonicalTerms(vars []*Var, vals []float64) ([]*Var, []float64) {
+	if !model.deterministic || len(vars) != len(vals) {
+		return vars, vals
+	}
+
+	coeffs := map[int32]float64{}
+	first := map[int32]*Var{}
+	for i, v := range vars {
+		if v == nil || v.Index < 0 {
+			return vars, vals
+		}
+		if _, ok := first[v.Index]; !ok {
+			first[v.Index] = v
+		}
+		coeffs[v.Index] += vals[i]
+	}
+
+	ind := make([]int32, 0, len(first))
+	for i := range first {
+		ind = append(ind, i)
+	}
+	sort.Slice(ind, func(a, b int) bool { return ind[a] < ind[b] })
+
+	outVars := make([]*Var, len(ind))
+	outVals := make([]float64, len(ind))
+	for k, i := range ind {
+		outVars[k] = first[i]
+		outVals[k] = coeffs[i]
+	}
+	return outVars, outVals
+}
@@ -0,0 +1,305 @@
+package gurobi
+
+import (
+	"fmt"
+	"sort"
+)
+
+/*
+dirty.go
+Description:
+	Tracks the changes which were made to a model since its last successful
+	call to Optimize (new variables and constraints, model attributes and
+	attributes of single variables or constraints) and re-solves modified
+	models with a warm start.
+Notes:
+	Only changes made through this package are tracked. Changing the model
+	directly through the C API (e.g., from a callback) is not visible here.
+*/
+
+// changeLog records the changes made to a model since its last optimization.
+type changeLog struct {
+	optimized     bool
+	newVars       int
+	newConstrs    int
+	newGenConstrs int
+	newSOS        int
+	removed       int
+	attrs         map[string]bool
+	elements      map[string]map[int32]bool
+	notify        func(ModelEvent)
+}
+
+func (cl *changeLog) attr(name string) {
+	if cl.attrs == nil {
+		cl.attrs = map[string]bool{}
+	}
+	cl.attrs[name] = true
+
+	if cl.notify != nil {
+		cl.notify(ModelEvent{Kind: EventAttrChanged, Attr: name})
+	}
+}
+
+func (cl *changeLog) element(name string, ind ...int32) {
+	if cl.elements == nil {
+		cl.elements = map[string]map[int32]bool{}
+	}
+	if cl.elements[name] == nil {
+		cl.elements[name] = map[int32]bool{}
+	}
+	for _, i := range ind {
+		cl.elements[name][i] = true
+	}
+
+	if cl.notify != nil {
+		cl.notify(ModelEvent{Kind: EventElementAttrChanged, Attr: name, Indices: append([]int32{}, ind...)})
+	}
+}
+
+func (cl *changeLog) elementRange(name string, start int32, length int32) {
+	ind := make([]int32, length)
+	for i := range ind {
+		ind[i] = start + int32(i)
+	}
+	cl.element(name, ind...)
+}
+
+// reset clears the log after a successful optimization.
+func (cl *changeLog) reset() {
+	*cl = changeLog{optimized: true, notify: cl.notify}
+}
+
+/*
+PendingChanges
+Description:
+
+	The changes made to a model since its last successful optimization.
+	Attrs lists the model attributes which were set (e.g., ModelSense or
+	"Q" for the quadratic objective) and Elements maps the name of a
+	variable or constraint attribute (e.g., LB or RHS) to the sorted indices
+	of the elements for which it was set.
+*/
+type PendingChanges struct {
+	Optimized     bool
+	NewVars       int
+	NewConstrs    int
+	NewGenConstrs int
+	NewSOS        int
+	Removed       int
+	Attrs         []string
+	Elements      map[string][]int32
+}
+
+/*
+IsStructural
+Description:
+
+	Returns true if variables or constraints were added to or removed from the model.
+*/
+func (pc PendingChanges) IsStructural() bool {
+	return pc.NewVars > 0 || pc.NewConstrs > 0 || pc.NewGenConstrs > 0 || pc.NewSOS > 0 || pc.Removed > 0
+}
+
+/*
+IsEmpty
+Description:
+
+	Returns true if nothing was changed.
+*/
+func (pc PendingChanges) IsEmpty() bool {
+	return !pc.IsStructural() && len(pc.Attrs) == 0 && len(pc.Elements) == 0
+}
+
+/*
+PendingChanges
+Description:
+
+	Returns the changes made to the model since its last successful optimization.
+*/
+func (model *Model) PendingChanges() PendingChanges {
+	cl := model.changes
+	pc := PendingChanges{
+		Optimized:     cl.optimized,
+		NewVars:       cl.newVars,
+		NewConstrs:    cl.newConstrs,
+		NewGenConstrs: cl.newGenConstrs,
+		NewSOS:        cl.newSOS,
+		Removed:       cl.removed,
+		Attrs:         []string{},
+		Elements:      map[string][]int32{},
+	}
+
+	for name := range cl.attrs {
+		pc.Attrs = append(pc.Attrs, name)
+	}
+	sort.Strings(pc.Attrs)
+
+	for name, set := range cl.elements {
+		pc.Elements[name] = sortedIndices(set)
+	}
+
+	return pc
+}
+
+/*
+IsDirty
+Description:
+
+	Returns true if the model was never optimized or has been changed since
+	its last successful optimization.
+*/
+func (model *Model) IsDirty() bool {
+	pc := model.PendingChanges()
+	return !pc.Optimized || !pc.IsEmpty()
+}
+
+/*
+WarmStart
+Description:
+
+	The kind of warm start that Reoptimize used.
+*/
+type WarmStart int
+
+const (
+	WarmStartNone     WarmStart = iota // cold start (or Gurobi's own warm start)
+	WarmStartBasis                     // the previous simplex basis (VBasis and CBasis)
+	WarmStartSolution                  // the previous MIP solution as a MIP start (Start)
+)
+
+func (ws WarmStart) String() string {
+	switch ws {
+	case WarmStartNone:
+		return "None"
+	case WarmStartBasis:
+		return "Basis"
+	case WarmStartSolution:
+		return "Solution"
+	default:
+		return fmt.Sprintf("WarmStart(%v)", int(ws))
+	}
+}
+
+// warmStart is the information which Reoptimize keeps from the previous solve.
+type warmStart struct {
+	x      []float64
+	vbasis []int32
+	cbasis []int32
+}
+
+/*
+Reoptimize
+Description:
+
+	Optimizes the model like Optimize, but for models which were already solved
+	with Reoptimize and changed since, it first loads a warm start chosen from
+	the kind of model and the pending changes:
+	  - for a MIP, the previous solution becomes the MIP start (new variables
+	    are left undefined), unless a Start was set explicitly;
+	  - for an LP whose shape did not change, the previous basis is loaded.
+	Returns the warm start which was used.
+*/
+func (model *Model) Reoptimize() (WarmStart, error) {
+	err := model.Check()
+	if err != nil {
+		return WarmStartNone, err
+	}
+
+	strategy := WarmStartNone
+	if model.warm != nil && model.IsDirty() {
+		strategy, err = model.applyWarmStart()
+		if err != nil {
+			return WarmStartNone, err
+		}
+	}
+
+	if err := model.Optimize(); err != nil {
+		return strategy, err
+	}
+
+	model.warm = model.captureWarmStart()
+	return strategy, nil
+}
+
+// applyWarmStart loads the stored warm start into the model.
+func (model *Model) applyWarmStart() (WarmStart, error) {
+	pc := model.PendingChanges()
+
+	numVars, err := model.NumVars()
+	if err != nil {
+		return WarmStartNone, err
+	}
+
+	if len(model.warm.x) > 0 {
+		if _, ok := pc.Elements[DBL_ATTR_START]; ok {
+			return WarmStartNone, nil
+		}
+
+		start := make([]float64, numVars)
+		for i := range start {
+			start[i] = UNDEFINED
+			if i < len(model.warm.x) {
+				start[i] = model.warm.x[i]
+			}
+		}
+		if err := model.setDoubleAttrArray(DBL_ATTR_START, 0, start); err != nil {
+			return WarmStartNone, err
+		}
+		return WarmStartSolution, nil
+	}
+
+	if len(model.warm.vbasis) > 0 && !pc.IsStructural() && int(numVars) == len(model.warm.vbasis) {
+		if err := model.setIntAttrArray("VBasis", 0, model.warm.vbasis); err != nil {
+			return WarmStartNone, err
+		}
+		if err := model.setIntAttrArray("CBasis", 0, model.warm.cbasis); err != nil {
+			return WarmStartNone, err
+		}
+		return WarmStartBasis, nil
+	}
+
+	return WarmStartNone, nil
+}
+
+// captureWarmStart reads the solution (MIP) or basis (LP) of the last solve.
+// It returns nil if neither is available.
+func (model *Model) captureWarmStart() *warmStart {
+	solCount, err := model.optionalIntAttr(INT_ATTR_SOLCOUNT)
+	if err != nil || solCount == 0 {
+		return nil
+	}
+
+	numVars, err := model.NumVars()
+	if err != nil {
+		return nil
+	}
+
+	isMIP, err := model.optionalIntAttr("IsMIP")
+	if err != nil {
+		return nil
+	}
+
+	if isMIP != 0 {
+		x, err := model.getDoubleAttrArray(DBL_ATTR_X, 0, numVars)
+		if err != nil {
+			return nil
+		}
+		return &warmStart{x: x}
+	}
+
+	numConstrs, err := model.NumConstrs()
+	if err != nil {
+		return nil
+	}
+
+	vbasis, err := model.getIntAttrArray("VBasis", 0, numVars)
+	if err != nil {
+		return nil
+	}
+	cbasis, err := model.getIntAttrArray("CBasis", 0, numConstrs)
+	if err != nil {
+		return nil
+	}
+	return &warmStart{vbasis: vbasis, cbasis: cbasis}
+}
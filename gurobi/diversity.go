@@ -0,0 +1,213 @@
+package gurobi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+/*
+diversity.go
+Description:
+	Distances between solutions (e.g., the solutions of the pool or of
+	several runs) and a greedy selection of a few structurally different
+	good solutions among many.
+Notes:
+	Solutions are dense value vectors, as returned by
+	GetDoubleAttrVars("X", vars), so a distance over a subset of the
+	variables (e.g., only the binary decisions) is obtained by reading only
+	those variables.
+*/
+
+// distanceTol is the difference up to which two values count as equal in the Hamming distance.
+const distanceTol = 1e-6
+
+// DistanceMetric is a distance between two solutions.
+type DistanceMetric int
+
+const (
+	// HammingMetric counts the positions in which two solutions differ.
+	HammingMetric DistanceMetric = iota
+	// L1Metric sums the absolute differences of two solutions.
+	L1Metric
+)
+
+func (metric DistanceMetric) String() string {
+	switch metric {
+	case HammingMetric:
+		return "Hamming"
+	case L1Metric:
+		return "L1"
+	}
+	return "Unknown"
+}
+
+/*
+SolutionHamming
+Description:
+
+	Returns the number of positions in which the solutions a and b differ
+	by more than 1e-6.
+*/
+func SolutionHamming(a []float64, b []float64) (int, error) {
+	if err := checkSameLength(a, b); err != nil {
+		return 0, err
+	}
+
+	distance := 0
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > distanceTol {
+			distance++
+		}
+	}
+	return distance, nil
+}
+
+/*
+SolutionL1
+Description:
+
+	Returns the sum of the absolute differences between the solutions a and b.
+*/
+func SolutionL1(a []float64, b []float64) (float64, error) {
+	if err := checkSameLength(a, b); err != nil {
+		return 0, err
+	}
+
+	distance := 0.0
+	for i := range a {
+		distance += math.Abs(a[i] - b[i])
+	}
+	return distance, nil
+}
+
+/*
+Distance
+Description:
+
+	Returns the distance between the solutions a and b in the metric.
+*/
+func (metric DistanceMetric) Distance(a []float64, b []float64) (float64, error) {
+	switch metric {
+	case HammingMetric:
+		distance, err := SolutionHamming(a, b)
+		return float64(distance), err
+	case L1Metric:
+		return SolutionL1(a, b)
+	}
+	return 0, fmt.Errorf("unknown distance metric %v", int(metric))
+}
+
+func checkSameLength(a []float64, b []float64) error {
+	if len(a) != len(b) {
+		return MismatchedLengthError{
+			Length1: len(a),
+			Name1:   "a",
+			Length2: len(b),
+			Name2:   "b",
+		}
+	}
+	return nil
+}
+
+/*
+DistanceMatrix
+Description:
+
+	Returns the distances between all pairs of solutions, where entry [i][j]
+	is the distance between solutions i and j.
+*/
+func DistanceMatrix(solutions [][]float64, metric DistanceMetric) ([][]float64, error) {
+	distances := make([][]float64, len(solutions))
+	for i := range solutions {
+		distances[i] = make([]float64, len(solutions))
+	}
+
+	for i := range solutions {
+		for j := i + 1; j < len(solutions); j++ {
+			distance, err := metric.Distance(solutions[i], solutions[j])
+			if err != nil {
+				return nil, fmt.Errorf("solutions %v and %v: %w", i, j, err)
+			}
+			distances[i][j], distances[j][i] = distance, distance
+		}
+	}
+	return distances, nil
+}
+
+/*
+Diversity
+Description:
+
+	Returns the smallest distance between two of the solutions, or 0 if
+	there are fewer than two solutions.
+*/
+func Diversity(solutions [][]float64, metric DistanceMetric) (float64, error) {
+	distances, err := DistanceMatrix(solutions, metric)
+	if err != nil || len(solutions) < 2 {
+		return 0, err
+	}
+
+	diversity := math.Inf(1)
+	for i := range distances {
+		for j := i + 1; j < len(distances); j++ {
+			diversity = math.Min(diversity, distances[i][j])
+		}
+	}
+	return diversity, nil
+}
+
+/*
+MaxDiverse
+Description:
+
+	Selects k of the solutions which are far apart from each other and
+	returns their indices in the order of selection. The first solution is
+	always selected (so the solutions should be sorted from best to worst,
+	as in the pool), and every further solution is the one whose distance
+	to the closest selected solution is largest. Ties go to the better
+	(earlier) solution. If k is at least the number of solutions, all of
+	them are selected.
+*/
+func MaxDiverse(solutions [][]float64, k int, metric DistanceMetric) ([]int, error) {
+	if k < 0 {
+		return nil, fmt.Errorf("the number of solutions to select must be nonnegative; received %v", k)
+	}
+	if k > len(solutions) {
+		k = len(solutions)
+	}
+	if k == 0 {
+		return []int{}, nil
+	}
+	if len(solutions) == 0 {
+		return nil, errors.New("there are no solutions to select from")
+	}
+
+	// closest[i] is the distance from solution i to the closest selected solution.
+	closest := make([]float64, len(solutions))
+	selected := make([]bool, len(solutions))
+	order := []int{}
+	next := 0
+	for len(order) < k {
+		selected[next] = true
+		order = append(order, next)
+
+		for i := range solutions {
+			distance, err := metric.Distance(solutions[next], solutions[i])
+			if err != nil {
+				return nil, fmt.Errorf("solutions %v and %v: %w", next, i, err)
+			}
+			if len(order) == 1 || distance < closest[i] {
+				closest[i] = distance
+			}
+		}
+
+		next = -1
+		for i := range solutions {
+			if !selected[i] && (next < 0 || closest[i] > closest[next]) {
+				next = i
+			}
+		}
+	}
+	return order, nil
+}
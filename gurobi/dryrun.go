@@ -0,0 +1,350 @@
+package gurobi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+/*
+dryrun.go
+Description:
+	A validation-only ("dry-run") mode for models. A dry-run model performs
+	the same input validation as a regular model and records every Add or Set
+	call together with model statistics, but never calls into the Gurobi C
+	library. This allows model construction code to be exercised (e.g., in
+	unit tests or CI) on machines where Gurobi is not installed.
+Notes:
+	The building API is supported: AddVar(s), AddConstr(s), AddTempConstr,
+	general and SOS constraints, objectives, the model sense, attribute
+	setters and parameters. Methods which need a solver (Optimize, Write,
+	most attribute getters, ...) return ErrDryRun.
+*/
+
+// ErrDryRun is returned by operations which are not available on a dry-run model.
+var ErrDryRun = errors.New("gurobi: not available on a dry-run model")
+
+/*
+DryRunCall
+Description:
+
+	A single recorded call on a dry-run model.
+*/
+type DryRunCall struct {
+	Method string
+	Detail string
+}
+
+/*
+DryRun
+Description:
+
+	The calls and statistics recorded by a dry-run model.
+*/
+type DryRun struct {
+	Name          string
+	Calls         []DryRunCall
+	NumVars       int
+	NumConstrs    int
+	NumGenConstrs int
+	NumSOS        int
+	NumNZs        int
+	NumQNZs       int
+	NumObj        int
+	VarTypes      map[VarType]int
+	Senses        map[Sense]int
+	ModelSense    ObjSense
+	Params        map[string]string
+	Attrs         map[string]string
+}
+
+/*
+NewDryRunModel
+Description:
+
+	Creates a dry-run model with the given name. No environment is needed.
+*/
+func NewDryRunModel(modelname string) *Model {
+	return &Model{
+		dryRun: &DryRun{
+			Name:       modelname,
+			VarTypes:   map[VarType]int{},
+			Senses:     map[Sense]int{},
+			ModelSense: Minimize,
+			Params:     map[string]string{},
+			Attrs:      map[string]string{},
+		},
+		names: newNameIndex(),
+	}
+}
+
+/*
+DryRun
+Description:
+
+	Returns the recorded calls and statistics of a dry-run model, and nil for a regular model.
+*/
+func (model *Model) DryRun() *DryRun {
+	if model == nil {
+		return nil
+	}
+	return model.dryRun
+}
+
+/*
+IsDryRun
+Description:
+
+	Returns true if the model is a dry-run model.
+*/
+func (model *Model) IsDryRun() bool {
+	return model != nil && model.dryRun != nil
+}
+
+/*
+Summary
+Description:
+
+	Returns a short, human readable summary of the recorded model.
+*/
+func (dr *DryRun) Summary() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "dry-run model %q: %v vars, %v constrs, %v nonzeros", dr.Name, dr.NumVars, dr.NumConstrs, dr.NumNZs)
+	if dr.NumQNZs > 0 {
+		fmt.Fprintf(&sb, ", %v quadratic objective terms", dr.NumQNZs)
+	}
+	if dr.NumGenConstrs > 0 {
+		fmt.Fprintf(&sb, ", %v general constrs", dr.NumGenConstrs)
+	}
+	if dr.NumSOS > 0 {
+		fmt.Fprintf(&sb, ", %v SOS constrs", dr.NumSOS)
+	}
+
+	types := []string{}
+	for vt, count := range dr.VarTypes {
+		types = append(types, fmt.Sprintf("%v %v", count, vt))
+	}
+	sort.Strings(types)
+	if len(types) > 0 {
+		fmt.Fprintf(&sb, " (%v)", strings.Join(types, ", "))
+	}
+	return sb.String()
+}
+
+func (dr *DryRun) record(method string, format string, args ...interface{}) {
+	dr.Calls = append(dr.Calls, DryRunCall{Method: method, Detail: fmt.Sprintf(format, args...)})
+}
+
+// addVars validates and records new variables, together with their
+// (optional) coefficients in existing constraints.
+func (dr *DryRun) addVars(method string, vtypes []VarType, objs []float64, lbs []float64, ubs []float64, constrs [][]*Constr, columns [][]float64) error {
+	for i := range vtypes {
+		if err := checkFinite(fmt.Sprintf("the objective coefficient of variable %v", i), objs[i]); err != nil {
+			return err
+		}
+		if math.IsNaN(lbs[i]) || math.IsNaN(ubs[i]) {
+			return fmt.Errorf("the bounds of variable %v must not be NaN", i)
+		}
+		if lbs[i] > ubs[i] {
+			return fmt.Errorf("the lower bound of variable %v (%v) is larger than its upper bound (%v)", i, lbs[i], ubs[i])
+		}
+	}
+
+	numnz := 0
+	for i := range constrs {
+		if len(constrs[i]) != len(columns[i]) {
+			return MismatchedLengthError{
+				Length1: len(constrs[i]),
+				Name1:   fmt.Sprintf("constrs[%v]", i),
+				Length2: len(columns[i]),
+				Name2:   fmt.Sprintf("columns[%v]", i),
+			}
+		}
+		for j, c := range constrs[i] {
+			if c == nil || c.Index < 0 || int(c.Index) >= dr.NumConstrs {
+				return fmt.Errorf("the constraint at position %v of column %v does not belong to the model", j, i)
+			}
+			if err := checkFinite(fmt.Sprintf("the coefficient at position %v of column %v", j, i), columns[i][j]); err != nil {
+				return err
+			}
+		}
+		numnz += len(constrs[i])
+	}
+
+	for _, vt := range vtypes {
+		dr.VarTypes[vt]++
+	}
+	dr.NumVars += len(vtypes)
+	dr.NumNZs += numnz
+	dr.record(method, "%v vars, %v nonzeros", len(vtypes), numnz)
+	return nil
+}
+
+// addConstr validates and records a new linear constraint.
+func (dr *DryRun) addConstr(method string, vars []*Var, vals []float64, sense Sense, rhs float64) error {
+	if err := dr.checkTerms(vars, vals); err != nil {
+		return err
+	}
+	if err := checkFinite("the right-hand side", rhs); err != nil {
+		return err
+	}
+
+	dr.NumConstrs++
+	dr.NumNZs += len(vars)
+	dr.Senses[sense]++
+	dr.record(method, "%v nonzeros, sense %v, rhs %v", len(vars), sense, rhs)
+	return nil
+}
+
+// addGenConstr validates and records a new general constraint.
+func (dr *DryRun) addGenConstr(method string, vars ...*Var) error {
+	if err := dr.checkVars(vars); err != nil {
+		return err
+	}
+
+	dr.NumGenConstrs++
+	dr.record(method, "%v vars", len(vars))
+	return nil
+}
+
+// addSOS validates and records a new SOS constraint.
+func (dr *DryRun) addSOS(vars []*Var, sosType SOSType) error {
+	if err := dr.checkVars(vars); err != nil {
+		return err
+	}
+
+	dr.NumSOS++
+	dr.record("AddSOS", "%v vars, type %v", len(vars), int32(sosType))
+	return nil
+}
+
+// addQPTerms validates and records quadratic objective terms.
+func (dr *DryRun) addQPTerms(qrow []*Var, qcol []*Var, qval []float64) error {
+	if err := dr.checkTerms(qrow, qval); err != nil {
+		return err
+	}
+	if err := dr.checkVars(qcol); err != nil {
+		return err
+	}
+
+	dr.NumQNZs += len(qval)
+	dr.record("addQPTerms", "%v terms", len(qval))
+	return nil
+}
+
+// setAttr records an attribute change.
+func (dr *DryRun) setAttr(attr string, value interface{}) error {
+	if f, ok := value.(float64); ok {
+		if math.IsNaN(f) {
+			return fmt.Errorf("the value of attribute %v must not be NaN", attr)
+		}
+	}
+
+	if attr == INT_ATTR_MODELSENSE {
+		sense, _ := value.(int32)
+		if err := ObjSense(sense).Check(); err != nil {
+			return err
+		}
+		dr.ModelSense = ObjSense(sense)
+	}
+
+	dr.Attrs[attr] = fmt.Sprint(value)
+	dr.record("Set"+attr, "%v", value)
+	return nil
+}
+
+// setParam validates the parameter name and type and records its value.
+func (dr *DryRun) setParam(paramName string, paramType ParamType, value interface{}) error {
+	canonical, knownType, ok := LookupParam(paramName)
+	if !ok {
+		return fmt.Errorf("unknown parameter %q", paramName)
+	}
+	if knownType != paramType {
+		return fmt.Errorf("the parameter %v is a %v parameter, not a %v parameter", canonical, knownType, paramType)
+	}
+
+	dr.Params[canonical] = fmt.Sprint(value)
+	dr.record("Set"+canonical, "%v", value)
+	return nil
+}
+
+// intAttr answers the integer attributes which are known to a dry-run model.
+func (dr *DryRun) intAttr(attr string) (int32, error) {
+	switch attr {
+	case "NumVars":
+		return int32(dr.NumVars), nil
+	case "NumConstrs":
+		return int32(dr.NumConstrs), nil
+	case "NumNZs":
+		return int32(dr.NumNZs), nil
+	case "NumGenConstrs":
+		return int32(dr.NumGenConstrs), nil
+	case "NumSOS":
+		return int32(dr.NumSOS), nil
+	case "NumBinVars":
+		return int32(dr.VarTypes[Binary]), nil
+	case "NumIntVars":
+		return int32(dr.VarTypes[Binary] + dr.VarTypes[Integer] + dr.VarTypes[SemiInt]), nil
+	case "NumQNZs":
+		return int32(dr.NumQNZs), nil
+	case INT_ATTR_NUMOBJ:
+		return int32(dr.NumObj), nil
+	case "NumQConstrs", "IsQCP":
+		return 0, nil
+	case "IsQP":
+		return boolToInt32(dr.NumQNZs > 0), nil
+	case "IsMIP":
+		numDiscrete := dr.VarTypes[Binary] + dr.VarTypes[Integer] + dr.VarTypes[SemiInt] + dr.VarTypes[SemiCont]
+		return boolToInt32(numDiscrete+dr.NumSOS+dr.NumGenConstrs > 0), nil
+	case INT_ATTR_MODELSENSE:
+		return int32(dr.ModelSense), nil
+	default:
+		return 0, fmt.Errorf("attribute %v: %w", attr, ErrDryRun)
+	}
+}
+
+func (dr *DryRun) checkTerms(vars []*Var, vals []float64) error {
+	if len(vars) != len(vals) {
+		return MismatchedLengthError{
+			Length1: len(vars),
+			Name1:   "vars",
+			Length2: len(vals),
+			Name2:   "vals",
+		}
+	}
+	for i, val := range vals {
+		if err := checkFinite(fmt.Sprintf("the coefficient at position %v", i), val); err != nil {
+			return err
+		}
+	}
+	return dr.checkVars(vars)
+}
+
+// checkVars checks that every variable has been added to the model.
+func (dr *DryRun) checkVars(vars []*Var) error {
+	for i, v := range vars {
+		if v == nil || v.Index < 0 || int(v.Index) >= dr.NumVars {
+			return fmt.Errorf("the variable at position %v does not belong to the model", i)
+		}
+	}
+	return nil
+}
+
+func checkFinite(what string, x float64) error {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return fmt.Errorf("%v must be finite; received %v", what, x)
+	}
+	return nil
+}
+
+// setAttrElement records a change of an attribute of a single variable or constraint.
+func (dr *DryRun) setAttrElement(attr string, ind int32, value interface{}) error {
+	if f, ok := value.(float64); ok && math.IsNaN(f) {
+		return fmt.Errorf("the value of attribute %v of element %v must not be NaN", attr, ind)
+	}
+
+	dr.record("Set"+attr, "element %v: %v", ind, value)
+	return nil
+}
@@ -0,0 +1,98 @@
+package gurobi
+
+import (
+	"fmt"
+	"math"
+)
+
+/*
+emphasis.go
+Description:
+	Ergonomic controls for the balance between finding good solutions and
+	proving optimality in MIP solves. Each emphasis sets a combination of
+	the MIPFocus, Heuristics, RINS and PumpPasses parameters, so that users
+	don't need to know how these parameters interact.
+Notes:
+	The parameters are described on Gurobi's website at:
+	https://www.gurobi.com/documentation/current/refman/mip_models.html
+*/
+
+// mipEmphasis is the combination of parameters set by one of the Emphasize methods.
+type mipEmphasis struct {
+	mipFocus   int
+	heuristics float64
+	rins       int
+	pumpPasses int
+}
+
+var (
+	// Find feasible solutions quickly: spend more time in heuristics, run
+	// RINS every 10 nodes and run the feasibility pump at the root.
+	feasibilityEmphasis = mipEmphasis{mipFocus: 1, heuristics: 0.2, rins: 10, pumpPasses: 20}
+
+	// Move the bound: spend (almost) no time in heuristics.
+	boundEmphasis = mipEmphasis{mipFocus: 3, heuristics: 0.01, rins: 0, pumpPasses: 0}
+
+	// Gurobi's defaults.
+	balanceEmphasis = mipEmphasis{mipFocus: 0, heuristics: 0.05, rins: -1, pumpPasses: -1}
+)
+
+/*
+EmphasizeFeasibility
+Description:
+
+	Focuses the MIP solve on finding good feasible solutions quickly, e.g.,
+	when a good solution is more important than a proof of optimality.
+*/
+func (model *Model) EmphasizeFeasibility() error {
+	return model.applyEmphasis(feasibilityEmphasis)
+}
+
+/*
+EmphasizeBound
+Description:
+
+	Focuses the MIP solve on improving the best bound, e.g., when a good
+	solution is already known and optimality has to be proven.
+*/
+func (model *Model) EmphasizeBound() error {
+	return model.applyEmphasis(boundEmphasis)
+}
+
+/*
+EmphasizeBalance
+Description:
+
+	Restores Gurobi's default balance between finding solutions and moving
+	the bound, undoing EmphasizeFeasibility and EmphasizeBound.
+*/
+func (model *Model) EmphasizeBalance() error {
+	return model.applyEmphasis(balanceEmphasis)
+}
+
+/*
+SetHeuristicsFraction
+Description:
+
+	Sets the fraction of the MIP solve (between 0 and 1) which is spent in
+	heuristics (the Heuristics parameter). The default is 0.05.
+*/
+func (model *Model) SetHeuristicsFraction(f float64) error {
+	if math.IsNaN(f) || f < 0 || f > 1 {
+		return fmt.Errorf("the heuristics fraction must be between 0 and 1; received %v", f)
+	}
+	return model.SetDBLParam("Heuristics", f)
+}
+
+func (model *Model) applyEmphasis(emphasis mipEmphasis) error {
+	if err := model.SetIntParam("MIPFocus", emphasis.mipFocus); err != nil {
+		return err
+	}
+	if err := model.SetHeuristicsFraction(emphasis.heuristics); err != nil {
+		return err
+	}
+	if err := model.SetIntParam("RINS", emphasis.rins); err != nil {
+		return err
+	}
+	return model.SetIntParam("PumpPasses", emphasis.pumpPasses)
+}
@@ -4,32 +4,67 @@ package gurobi
 import "C"
 import (
 	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
 )
 
+// DefaultOSEnvPrefix is the prefix of the OS environment variables read by LoadFromOSEnv.
+const DefaultOSEnvPrefix = "GRBGO_"
+
 type Env struct {
 	env *C.GRBenv
+	// freed is set (atomically) to 1 when the environment is freed.
+	freed int32
 }
 
 // NewEnv create a new environment.
 func NewEnv(logfilename string) (*Env, error) {
 	var env *C.GRBenv = nil
-	errcode := int(C.GRBloadenv(&env, C.CString(logfilename)))
+	registry.loadMu.Lock()
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBloadenv", logfilename)
+	errcode := int(C.GRBloadenv(&env, args.str(logfilename)))
+	done(C.int(errcode))
+	registry.loadMu.Unlock()
 	if errcode != 0 {
 		errMsg, err := C.GRBgeterrormsg(env)
 		if err != nil {
 			return nil, fmt.Errorf("cannot create environment. Error code: %v. Error when getting corresponding error message: %v", errcode, err)
 		}
-		return nil, fmt.Errorf("cannot create environment. Error code: %d. Error message: %v", errcode, (errMsg))
+		return nil, fmt.Errorf(
+			"cannot create environment. Error code: %d. Error message: %w",
+			errcode,
+			Error{ErrorCode: int32(errcode), Message: C.GoString(errMsg)},
+		)
 	}
 
-	return &Env{env}, nil
+	registry.addEnv(env)
+	return &Env{env: env}, nil
 }
 
-// free environment.
+/*
+Free
+Description:
+
+	Frees the environment, after freeing all of its models which have not
+	been freed yet. Freeing an environment which was not created with
+	NewEnv (e.g., Model.Env) or which was already freed does nothing.
+	Afterwards, Check returns ErrFreed.
+*/
 func (env *Env) Free() {
-	if env != nil {
-		C.GRBfreeenv(env.env)
+	if env == nil || env.isFreed() {
+		return
 	}
+	registry.freeEnv(env)
+}
+
+// isFreed returns true once the environment (or the model which owns it) was freed.
+func (env *Env) isFreed() bool {
+	return atomic.LoadInt32(&env.freed) != 0
 }
 
 /*
@@ -46,13 +81,18 @@ func (env *Env) SetTimeLimit(limitIn float64) error {
 	// Input Checking
 	err := env.Check()
 	if err != nil {
-		return env.MakeUninitializedError()
+		return err
 	}
 
 	// Algorithm
-	errCode := int(C.GRBsetdblparam(env.env, C.CString(paramName), C.double(limitIn)))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBsetdblparam", paramName, limitIn)
+	errCode := C.GRBsetdblparam(env.env, args.str(paramName), C.double(limitIn))
+	done(errCode)
 	if errCode != 0 {
-		return fmt.Errorf("there was an error running GRBsetdblparam(): Error code %v", errCode)
+		return env.MakeError(errCode)
 	}
 
 	// If everything was successful, then return nil.
@@ -74,14 +114,19 @@ func (env *Env) GetTimeLimit() (float64, error) {
 	// Input Checking
 	err := env.Check()
 	if err != nil {
-		return -1.0, env.MakeUninitializedError()
+		return -1.0, err
 	}
 
 	// Algorithm
 	var limitOut C.double
-	errCode := int(C.GRBgetdblparam(env.env, C.CString(paramName), &limitOut))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBgetdblparam", paramName)
+	errCode := C.GRBgetdblparam(env.env, args.str(paramName), &limitOut)
+	done(errCode)
 	if errCode != 0 {
-		return -1, fmt.Errorf("there was an error running GRBsetdblparam(): Error code %v", errCode)
+		return -1, env.MakeError(errCode)
 	}
 
 	// If everything was successful, then return nil.
@@ -97,21 +142,55 @@ Description:
 	Sets the parameter of the solver that has name paramName with value val.
 */
 func (env *Env) SetIntParam(paramName string, val int) error {
-	// Check that the env object is not nil.
-	if env == nil {
-		return fmt.Errorf("env is nil")
+	// Check that the env object is usable.
+	if err := env.Check(); err != nil {
+		return err
 	}
 
 	// Set Attribute
-	errCode := int(C.GRBsetintparam(env.env, C.CString(paramName), C.int(val)))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBsetintparam", paramName, val)
+	errCode := C.GRBsetintparam(env.env, args.str(paramName), C.int(val))
+	done(errCode)
 	if errCode != 0 {
-		return fmt.Errorf("there was an error running GRBsetintparam(), errCode %v", errCode)
+		return env.MakeError(errCode)
 	}
 
 	// If everything was successful, then return nil.
 	return nil
 }
 
+/*
+GetIntParam
+Description:
+
+	Mirrors the functionality of the GRBgetintparam() function from the C api.
+	Gets the value of the integer parameter with name paramName.
+*/
+func (env *Env) GetIntParam(paramName string) (int, error) {
+	// Check that the env object is usable.
+	if err := env.Check(); err != nil {
+		return -1, err
+	}
+
+	// Get Attribute
+	var valOut C.int
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBgetintparam", paramName)
+	errCode := C.GRBgetintparam(env.env, args.str(paramName), &valOut)
+	done(errCode)
+	if errCode != 0 {
+		return -1, env.MakeError(errCode)
+	}
+
+	// If everything was successful, then return the value.
+	return int(valOut), nil
+}
+
 /*
 SetDBLParam
 Description:
@@ -125,15 +204,20 @@ func (env *Env) SetDBLParam(paramName string, val float64) error {
 		return fmt.Errorf("The input attribute name (%v) is not considered a valid attribute.", paramName)
 	}
 
-	// Check that the env object is not nil.
-	if env == nil {
-		return fmt.Errorf("env is nil")
+	// Check that the env object is usable.
+	if err := env.Check(); err != nil {
+		return err
 	}
 
 	// Set Attribute
-	errcode := int(C.GRBsetdblparam(env.env, C.CString(paramName), C.double(val)))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBsetdblparam", paramName, val)
+	errcode := C.GRBsetdblparam(env.env, args.str(paramName), C.double(val))
+	done(errcode)
 	if errcode != 0 {
-		return fmt.Errorf("There was an error running GRBsetdblparam(), errcode %v", errcode)
+		return env.MakeError(errcode)
 	}
 
 	// If everything was successful, then return nil.
@@ -155,15 +239,20 @@ func (env *Env) GetDBLParam(paramName string) (float64, error) {
 	}
 
 	// Check environment input
-	if env == nil {
-		return -1, fmt.Errorf("env is nil")
+	if err := env.Check(); err != nil {
+		return -1, err
 	}
 
 	// Use GRBgetdblparam
 	var valOut C.double
-	errcode := int(C.GRBgetdblparam(env.env, C.CString(paramName), &valOut))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBgetdblparam", paramName)
+	errcode := C.GRBgetdblparam(env.env, args.str(paramName), &valOut)
+	done(errcode)
 	if errcode != 0 {
-		return -1, fmt.Errorf("There was an error running GRBgetdblparam(). Errorcode %v", errcode)
+		return -1, env.MakeError(errcode)
 	}
 
 	// If everything was successful, then return nil.
@@ -171,46 +260,182 @@ func (env *Env) GetDBLParam(paramName string) (float64, error) {
 }
 
 func IsValidDBLParam(paramName string) bool {
-	// All param names
-	var scalarDoubleAttributes = []string{
-		"TimeLimit",
-		"Cutoff",
-		"BestObjStop",
-		"MIPGap",
-		"Heuristics",
+	// Check that the parameter is actually a scalar double parameter in the registry.
+	name, paramType, ok := LookupParam(paramName)
+	return ok && name == paramName && paramType == DBLParam
+}
+
+/*
+SetStringParam
+Description:
+*/
+func (env *Env) SetStringParam(param string, newvalue string) error {
+	err := env.Check()
+	if err != nil {
+		return err
 	}
 
-	// Check that attribute is actually a scalar double attribute.
-	paramNameIsValid := false
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBsetstrparam", param, newvalue)
+	errCode := C.GRBsetstrparam(env.env, args.str(param), args.str(newvalue))
+	done(errCode)
+	if errCode != 0 {
+		return env.MakeError(errCode)
+	}
+
+	return nil
+}
+
+/*
+LoadFromOSEnv
+Description:
+
+	Reads every OS environment variable whose name starts with prefix
+	(GRBGO_ by default), interprets the rest of its name as the name of a
+	Gurobi parameter (e.g., GRBGO_THREADS -> Threads, GRBGO_TIMELIMIT -> TimeLimit),
+	validates it against the parameter registry and applies it to env.
+	Returns the canonical names of all parameters that were applied.
+*/
+func (env *Env) LoadFromOSEnv(prefix string) ([]string, error) {
+	// Input Processing
+	err := env.Check()
+	if err != nil {
+		return nil, err
+	}
 
-	for _, validName := range scalarDoubleAttributes {
-		if validName == paramName {
-			paramNameIsValid = true
-			break
+	if prefix == "" {
+		prefix = DefaultOSEnvPrefix
+	}
+
+	// Algorithm
+	applied := []string{}
+	for _, keyValue := range os.Environ() {
+		key, value, _ := strings.Cut(keyValue, "=")
+		if !strings.HasPrefix(key, prefix) {
+			continue
 		}
+
+		paramName, _, ok := LookupParam(strings.TrimPrefix(key, prefix))
+		if !ok {
+			return applied, fmt.Errorf("the environment variable %v does not correspond to a known gurobi parameter", key)
+		}
+
+		if err := env.SetParam(paramName, value); err != nil {
+			return applied, fmt.Errorf("there was an issue applying the environment variable %v: %v", key, err)
+		}
+		applied = append(applied, paramName)
 	}
 
-	return paramNameIsValid
+	sort.Strings(applied)
+	return applied, nil
 }
 
 /*
-SetStringParam
+WriteParams
 Description:
+
+	Writes all of the environment's parameters that differ from their default
+	values to a .prm file at filename.
 */
-func (env *Env) SetStringParam(param string, newvalue string) error {
+func (env *Env) WriteParams(filename string) error {
 	err := env.Check()
 	if err != nil {
-		return env.MakeUninitializedError()
+		return err
+	}
+
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBwriteparams", filename)
+	errCode := C.GRBwriteparams(env.env, args.str(filename))
+	done(errCode)
+	if errCode != 0 {
+		return env.MakeError(errCode)
+	}
+
+	return nil
+}
+
+/*
+ReadParams
+Description:
+
+	Reads the parameters in the .prm file at filename into the environment.
+*/
+func (env *Env) ReadParams(filename string) error {
+	err := env.Check()
+	if err != nil {
+		return err
 	}
 
-	errCode := int(C.GRBsetstrparam(env.env, C.CString(param), C.CString(newvalue)))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBreadparams", filename)
+	errCode := C.GRBreadparams(env.env, args.str(filename))
+	done(errCode)
 	if errCode != 0 {
-		return fmt.Errorf("There was an error running GRBsetstrparam(): Error code %v", errCode)
+		return env.MakeError(errCode)
 	}
 
 	return nil
 }
 
+/*
+ChangedParams
+Description:
+
+	Returns every parameter of the environment whose value differs from its
+	default, keyed by parameter name. This is computed by writing the
+	parameters to a temporary .prm file and reading it back.
+*/
+func (env *Env) ChangedParams() (map[string]string, error) {
+	f, err := os.CreateTemp("", "gurobi-*.prm")
+	if err != nil {
+		return nil, err
+	}
+	filename := f.Name()
+	f.Close()
+	defer os.Remove(filename)
+
+	if err := env.WriteParams(filename); err != nil {
+		return nil, err
+	}
+
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseParamsFile(string(contents)), nil
+}
+
+/*
+ParseParamsFile
+Description:
+
+	Parses the contents of a .prm file (one "Name Value" pair per line,
+	with comments starting with #) into a map from parameter name to value.
+*/
+func ParseParamsFile(contents string) map[string]string {
+	params := map[string]string{}
+	for _, line := range strings.Split(contents, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		params[fields[0]] = strings.Join(fields[1:], " ")
+	}
+	return params
+}
+
 /*
 Check
 Description:
@@ -222,6 +447,10 @@ func (env *Env) Check() error {
 		return env.MakeUninitializedError()
 	}
 
+	if env.isFreed() {
+		return ErrFreed
+	}
+
 	// Gurobi env (the sole member of gurobi.Env is not yet defined.
 	if env.env == nil {
 		return env.MakeUninitializedError()
@@ -230,3 +459,15 @@ func (env *Env) Check() error {
 	// If all checks passed, return nil
 	return nil
 }
+
+/*
+Version
+Description:
+
+	Returns the version of the Gurobi library which is linked in.
+*/
+func Version() (major int, minor int, technical int) {
+	var cMajor, cMinor, cTechnical C.int
+	C.GRBversion(&cMajor, &cMinor, &cTechnical)
+	return int(cMajor), int(cMinor), int(cTechnical)
+}
@@ -7,6 +7,65 @@ import (
 	"fmt"
 )
 
+/*
+Error Codes
+Description:
+	The error codes that the Gurobi C API can return.
+	https://www.gurobi.com/documentation/current/refman/error_codes.html
+*/
+
+const (
+	ERROR_OUT_OF_MEMORY            = 10001
+	ERROR_NULL_ARGUMENT            = 10002
+	ERROR_INVALID_ARGUMENT         = 10003
+	ERROR_UNKNOWN_ATTRIBUTE        = 10004
+	ERROR_DATA_NOT_AVAILABLE       = 10005
+	ERROR_INDEX_OUT_OF_RANGE       = 10006
+	ERROR_UNKNOWN_PARAMETER        = 10007
+	ERROR_VALUE_OUT_OF_RANGE       = 10008
+	ERROR_NO_LICENSE               = 10009
+	ERROR_SIZE_LIMIT_EXCEEDED      = 10010
+	ERROR_CALLBACK                 = 10011
+	ERROR_FILE_READ                = 10012
+	ERROR_FILE_WRITE               = 10013
+	ERROR_NUMERIC                  = 10014
+	ERROR_IIS_NOT_INFEASIBLE       = 10015
+	ERROR_NOT_FOR_MIP              = 10016
+	ERROR_OPTIMIZATION_IN_PROGRESS = 10017
+	ERROR_DUPLICATES               = 10018
+	ERROR_NODEFILE                 = 10019
+	ERROR_Q_NOT_PSD                = 10020
+	ERROR_QCP_EQUALITY_CONSTRAINT  = 10021
+	ERROR_NETWORK                  = 10022
+	ERROR_JOB_REJECTED             = 10023
+	ERROR_NOT_SUPPORTED            = 10024
+)
+
+/*
+Sentinel Errors
+Description:
+	Each gurobi Error is classified into one of the following categories so that
+	applications can branch on the class of a failure with errors.Is(), e.g.
+
+		if errors.Is(err, gurobi.ErrNetwork) { // retry }
+*/
+
+var (
+	ErrOutOfMemory        = errors.New("gurobi: out of memory")
+	ErrInvalidInput       = errors.New("gurobi: invalid input")
+	ErrDataNotAvailable   = errors.New("gurobi: data not available")
+	ErrNoLicense          = errors.New("gurobi: no valid license")
+	ErrSizeLimitExceeded  = errors.New("gurobi: problem size exceeds license limit")
+	ErrCallback           = errors.New("gurobi: callback failure")
+	ErrFile               = errors.New("gurobi: file read/write failure")
+	ErrNumeric            = errors.New("gurobi: numerical difficulty")
+	ErrModelType          = errors.New("gurobi: operation not supported for this model type")
+	ErrOptimizationActive = errors.New("gurobi: optimization in progress")
+	ErrNetwork            = errors.New("gurobi: network failure")
+	ErrJobRejected        = errors.New("gurobi: job rejected by server")
+	ErrNotSupported       = errors.New("gurobi: not supported")
+)
+
 /*
 Error Objects
 */
@@ -24,6 +83,14 @@ type MismatchedLengthError struct {
 	Name2   string // Name of the object with Length2
 }
 
+type InvalidVarTypeError struct {
+	VType int8
+}
+
+type InvalidSenseError struct {
+	Sense int8
+}
+
 /*
 Error Methods
 */
@@ -32,6 +99,72 @@ func (err Error) Error() string {
 	return err.Message
 }
 
+/*
+Category
+Description:
+
+	Returns the sentinel error which describes the class of this error
+	(e.g., ErrNoLicense or ErrNetwork). Returns nil if the error code is not recognized.
+*/
+func (err Error) Category() error {
+	switch err.ErrorCode {
+	case ERROR_OUT_OF_MEMORY:
+		return ErrOutOfMemory
+	case ERROR_NULL_ARGUMENT, ERROR_INVALID_ARGUMENT, ERROR_UNKNOWN_ATTRIBUTE,
+		ERROR_INDEX_OUT_OF_RANGE, ERROR_UNKNOWN_PARAMETER, ERROR_VALUE_OUT_OF_RANGE,
+		ERROR_DUPLICATES:
+		return ErrInvalidInput
+	case ERROR_DATA_NOT_AVAILABLE:
+		return ErrDataNotAvailable
+	case ERROR_NO_LICENSE:
+		return ErrNoLicense
+	case ERROR_SIZE_LIMIT_EXCEEDED:
+		return ErrSizeLimitExceeded
+	case ERROR_CALLBACK:
+		return ErrCallback
+	case ERROR_FILE_READ, ERROR_FILE_WRITE, ERROR_NODEFILE:
+		return ErrFile
+	case ERROR_NUMERIC:
+		return ErrNumeric
+	case ERROR_IIS_NOT_INFEASIBLE, ERROR_NOT_FOR_MIP, ERROR_Q_NOT_PSD, ERROR_QCP_EQUALITY_CONSTRAINT:
+		return ErrModelType
+	case ERROR_OPTIMIZATION_IN_PROGRESS:
+		return ErrOptimizationActive
+	case ERROR_NETWORK:
+		return ErrNetwork
+	case ERROR_JOB_REJECTED:
+		return ErrJobRejected
+	case ERROR_NOT_SUPPORTED:
+		return ErrNotSupported
+	default:
+		return nil
+	}
+}
+
+/*
+Is
+Description:
+
+	Allows errors.Is() to match a gurobi Error against the sentinel error of its category.
+*/
+func (err Error) Is(target error) bool {
+	category := err.Category()
+	return category != nil && category == target
+}
+
+/*
+IsRetryable
+Description:
+
+	Returns true if the error is of a class that is typically transient
+	(network failures, including those of a token server, and rejected
+	jobs), so that the operation which produced it may succeed if it is
+	retried. A missing or invalid license (ErrNoLicense) is not retryable.
+*/
+func IsRetryable(err error) bool {
+	return errors.Is(err, ErrNetwork) || errors.Is(err, ErrJobRejected)
+}
+
 func (err MismatchedLengthError) Error() string {
 	// Assemble string
 	return fmt.Sprintf(
@@ -43,6 +176,22 @@ func (err MismatchedLengthError) Error() string {
 	)
 }
 
+func (err InvalidVarTypeError) Error() string {
+	return fmt.Sprintf(
+		"the variable type %v (%q) is not a valid gurobi variable type!",
+		err.VType,
+		rune(err.VType),
+	)
+}
+
+func (err InvalidSenseError) Error() string {
+	return fmt.Sprintf(
+		"the constraint sense %v (%q) is not valid; expected one of '<', '>' or '='!",
+		err.Sense,
+		rune(err.Sense),
+	)
+}
+
 /*
 Other Error-Related methods
 */
@@ -60,7 +209,12 @@ func (env *Env) MakeError(errcode C.int) error {
 	return nil
 }
 
+// MakeUninitializedError returns a fixed error for when the environment is
+// not initialized, or ErrFreed if it was already freed.
 func (env *Env) MakeUninitializedError() error {
+	if env != nil && env.isFreed() {
+		return ErrFreed
+	}
 	return fmt.Errorf("The gurobi environment was not yet initialized!")
 }
 
@@ -1,5 +1,7 @@
 package gurobi
 
+import "sort"
+
 // Linear expression of variables
 type LinExpr struct {
 	Ind    []*Var
@@ -18,6 +20,78 @@ func (expr *LinExpr) AddConstant(c float64) *LinExpr {
 	return expr
 }
 
+/*
+NewLinExprFromMap
+Description:
+
+	Creates the expression sum(coeffs[v] * v) + offset. The terms are sorted
+	by variable index so that the result does not depend on the map's
+	iteration order. Distinct pointers to the same variable are merged.
+*/
+func NewLinExprFromMap(coeffs map[*Var]float64, offset float64) *LinExpr {
+	terms := (&LinExpr{}).addTerms(coeffs).ToMap()
+
+	vars := make([]*Var, 0, len(terms))
+	for v := range terms {
+		vars = append(vars, v)
+	}
+	sort.Slice(vars, func(a, b int) bool { return vars[a].Index < vars[b].Index })
+
+	expr := &LinExpr{Offset: offset}
+	for _, v := range vars {
+		expr.AddTerm(v, terms[v])
+	}
+	return expr
+}
+
+func (expr *LinExpr) addTerms(coeffs map[*Var]float64) *LinExpr {
+	for v, c := range coeffs {
+		expr.AddTerm(v, c)
+	}
+	return expr
+}
+
+/*
+ToMap
+Description:
+
+	Returns the coefficient of each variable of the expression, summing the
+	coefficients of variables which appear more than once. The offset is
+	not included.
+*/
+func (expr *LinExpr) ToMap() map[*Var]float64 {
+	type key struct {
+		model *Model
+		index int32
+	}
+
+	first := map[key]*Var{}
+	coeffs := map[*Var]float64{}
+	for i, v := range expr.Ind {
+		k := key{v.Model, v.Index}
+		if _, ok := first[k]; !ok {
+			first[k] = v
+		}
+		coeffs[first[k]] += expr.Val[i]
+	}
+	return coeffs
+}
+
+// LessEq creates the constraint expr <= rhs.
+func (expr *LinExpr) LessEq(rhs float64) TempConstr {
+	return TempConstr{LHS: *expr, Sense: Le, RHS: rhs}
+}
+
+// GreaterEq creates the constraint expr >= rhs.
+func (expr *LinExpr) GreaterEq(rhs float64) TempConstr {
+	return TempConstr{LHS: *expr, Sense: Ge, RHS: rhs}
+}
+
+// Equal creates the constraint expr == rhs.
+func (expr *LinExpr) Equal(rhs float64) TempConstr {
+	return TempConstr{LHS: *expr, Sense: Eq, RHS: rhs}
+}
+
 // Linear expression of variables
 type QuadExpr struct {
 	lind   []*Var
@@ -0,0 +1,421 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"errors"
+	"fmt"
+)
+
+/*
+genconstr.go
+Description:
+	Functions for adding general constraints (PWL, indicator, min/max, abs, ...)
+	to a model.
+Notes:
+	https://www.gurobi.com/documentation/current/refman/constraints.html#subsubsection:GeneralConstraints
+*/
+
+// Gurobi general constraint object
+type GenConstr struct {
+	Model *Model
+	Index int32
+}
+
+// appendGenConstr records a newly added general constraint in the model and returns it.
+func (model *Model) appendGenConstr() *GenConstr {
+	model.GenConstrs = append(model.GenConstrs, GenConstr{model, int32(len(model.GenConstrs))})
+	return &model.GenConstrs[len(model.GenConstrs)-1]
+}
+
+/*
+AddGenConstrPWL
+Description:
+
+	Adds the piecewise-linear constraint y = f(x), where f is defined by the
+	breakpoints (xpts[i], ypts[i]). The values in xpts must be non-decreasing.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_addgenconstrpwl.html
+*/
+func (model *Model) AddGenConstrPWL(x *Var, y *Var, xpts []float64, ypts []float64, name string) (*GenConstr, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(xpts) != len(ypts) {
+		return nil, MismatchedLengthError{
+			Length1: len(xpts),
+			Name1:   "xpts",
+			Length2: len(ypts),
+			Name2:   "ypts",
+		}
+	}
+
+	if len(xpts) < 2 {
+		return nil, fmt.Errorf("a piecewise-linear constraint needs at least 2 breakpoints; received %v", len(xpts))
+	}
+
+	for i := 1; i < len(xpts); i++ {
+		if xpts[i] < xpts[i-1] {
+			return nil, fmt.Errorf("the breakpoints in xpts must be non-decreasing; xpts[%v] = %v < xpts[%v] = %v", i, xpts[i], i-1, xpts[i-1])
+		}
+	}
+
+	if x == nil || y == nil || x.Index < 0 || y.Index < 0 {
+		return nil, errors.New("Invalid vars")
+	}
+
+	if model.dryRun != nil {
+		if err := model.dryRun.addGenConstr("AddGenConstrPWL", x, y); err != nil {
+			return nil, err
+		}
+		return model.appendGenConstr(), nil
+	}
+
+	// Algorithm
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBaddgenconstrPWL", name, x.Index, y.Index)
+	errCode := C.GRBaddgenconstrPWL(
+		model.AsGRBModel, args.str(name),
+		C.int(x.Index), C.int(y.Index),
+		C.int(len(xpts)), (*C.double)(&xpts[0]), (*C.double)(&ypts[0]),
+	)
+	done(errCode)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	model.changes.newGenConstrs++
+	model.emitItems(EventGenConstrsAdded, len(model.GenConstrs), 1)
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	return model.appendGenConstr(), nil
+}
+
+/*
+AddGenConstrIndicator
+Description:
+
+	Adds the indicator constraint (binVar == binVal) -> (vars * vals sense rhs),
+	i.e., the linear constraint must hold whenever the binary variable binVar
+	takes the value binVal (0 or 1).
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_addgenconstrindicator.html
+*/
+func (model *Model) AddGenConstrIndicator(binVar *Var, binVal int, vars []*Var, vals []float64, sense Sense, rhs float64, name string) (*GenConstr, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if binVal != 0 && binVal != 1 {
+		return nil, fmt.Errorf("the indicator value must be 0 or 1; received %v", binVal)
+	}
+
+	if binVar == nil || binVar.Index < 0 {
+		return nil, errors.New("Invalid binVar")
+	}
+
+	if err := sense.Check(); err != nil {
+		return nil, err
+	}
+
+	if len(vars) != len(vals) {
+		return nil, MismatchedLengthError{
+			Length1: len(vars),
+			Name1:   "vars",
+			Length2: len(vals),
+			Name2:   "vals",
+		}
+	}
+
+	ind := make([]int32, len(vars))
+	for i, v := range vars {
+		if v.Index < 0 {
+			return nil, errors.New("Invalid vars")
+		}
+		ind[i] = v.Index
+	}
+
+	pind := (*C.int)(nil)
+	pval := (*C.double)(nil)
+	if len(ind) > 0 {
+		pind = (*C.int)(&ind[0])
+		pval = (*C.double)(&vals[0])
+	}
+
+	if model.dryRun != nil {
+		if err := model.dryRun.addGenConstr("AddGenConstrIndicator", append([]*Var{binVar}, vars...)...); err != nil {
+			return nil, err
+		}
+		return model.appendGenConstr(), nil
+	}
+
+	// Algorithm
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBaddgenconstrIndicator", name, binVar.Index, binVal, sense, rhs)
+	errCode := C.GRBaddgenconstrIndicator(
+		model.AsGRBModel, args.str(name),
+		C.int(binVar.Index), C.int(binVal),
+		C.int(len(ind)), pind, pval,
+		C.char(sense), C.double(rhs),
+	)
+	done(errCode)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	model.changes.newGenConstrs++
+	model.emitItems(EventGenConstrsAdded, len(model.GenConstrs), 1)
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	return model.appendGenConstr(), nil
+}
+
+/*
+AddGenConstrAbs
+Description:
+
+	Adds the constraint resVar = |argVar|.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_addgenconstrabs.html
+*/
+func (model *Model) AddGenConstrAbs(resVar *Var, argVar *Var, name string) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if resVar == nil || argVar == nil || resVar.Index < 0 || argVar.Index < 0 {
+		return nil, errors.New("Invalid vars")
+	}
+
+	if model.dryRun != nil {
+		if err := model.dryRun.addGenConstr("AddGenConstrAbs", resVar, argVar); err != nil {
+			return nil, err
+		}
+		return model.appendGenConstr(), nil
+	}
+
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBaddgenconstrAbs", name, resVar.Index, argVar.Index)
+	errCode := C.GRBaddgenconstrAbs(model.AsGRBModel, args.str(name), C.int(resVar.Index), C.int(argVar.Index))
+	done(errCode)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	model.changes.newGenConstrs++
+	model.emitItems(EventGenConstrsAdded, len(model.GenConstrs), 1)
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	return model.appendGenConstr(), nil
+}
+
+/*
+AddGenConstrMax
+Description:
+
+	Adds the constraint resVar = max(vars[0], ..., vars[n-1], constant).
+	Use -INFINITY as the constant to leave it out of the maximum.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_addgenconstrmax.html
+*/
+func (model *Model) AddGenConstrMax(resVar *Var, vars []*Var, constant float64, name string) (*GenConstr, error) {
+	return model.addGenConstrMinMax(true, resVar, vars, constant, name)
+}
+
+/*
+AddGenConstrMin
+Description:
+
+	Adds the constraint resVar = min(vars[0], ..., vars[n-1], constant).
+	Use INFINITY as the constant to leave it out of the minimum.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_addgenconstrmin.html
+*/
+func (model *Model) AddGenConstrMin(resVar *Var, vars []*Var, constant float64, name string) (*GenConstr, error) {
+	return model.addGenConstrMinMax(false, resVar, vars, constant, name)
+}
+
+// addGenConstrMinMax adds either a max (isMax) or a min general constraint.
+func (model *Model) addGenConstrMinMax(isMax bool, resVar *Var, vars []*Var, constant float64, name string) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if resVar == nil || resVar.Index < 0 {
+		return nil, errors.New("Invalid resVar")
+	}
+
+	ind := make([]int32, len(vars))
+	for i, v := range vars {
+		if v == nil || v.Index < 0 {
+			return nil, errors.New("Invalid vars")
+		}
+		ind[i] = v.Index
+	}
+
+	pind := (*C.int)(nil)
+	if len(ind) > 0 {
+		pind = (*C.int)(&ind[0])
+	}
+
+	if model.dryRun != nil {
+		method := "AddGenConstrMax"
+		if !isMax {
+			method = "AddGenConstrMin"
+		}
+		if err := model.dryRun.addGenConstr(method, append([]*Var{resVar}, vars...)...); err != nil {
+			return nil, err
+		}
+		return model.appendGenConstr(), nil
+	}
+
+	args := &cgoArgs{}
+	defer args.free()
+
+	var errCode C.int
+	if isMax {
+		done := traceCall("GRBaddgenconstrMax", name, resVar.Index, constant)
+		errCode = C.GRBaddgenconstrMax(model.AsGRBModel, args.str(name), C.int(resVar.Index), C.int(len(ind)), pind, C.double(constant))
+		done(errCode)
+	} else {
+		done := traceCall("GRBaddgenconstrMin", name, resVar.Index, constant)
+		errCode = C.GRBaddgenconstrMin(model.AsGRBModel, args.str(name), C.int(resVar.Index), C.int(len(ind)), pind, C.double(constant))
+		done(errCode)
+	}
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	model.changes.newGenConstrs++
+	model.emitItems(EventGenConstrsAdded, len(model.GenConstrs), 1)
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	return model.appendGenConstr(), nil
+}
+
+/*
+AddGenConstrAnd
+Description:
+
+	Adds the constraint resVar = AND(vars[0], ..., vars[n-1]). All variables
+	must be binary.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_addgenconstrand.html
+*/
+func (model *Model) AddGenConstrAnd(resVar *Var, vars []*Var, name string) (*GenConstr, error) {
+	return model.addGenConstrAndOr(true, resVar, vars, name)
+}
+
+/*
+AddGenConstrOr
+Description:
+
+	Adds the constraint resVar = OR(vars[0], ..., vars[n-1]). All variables
+	must be binary.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_addgenconstror.html
+*/
+func (model *Model) AddGenConstrOr(resVar *Var, vars []*Var, name string) (*GenConstr, error) {
+	return model.addGenConstrAndOr(false, resVar, vars, name)
+}
+
+// addGenConstrAndOr adds either an and (isAnd) or an or general constraint.
+func (model *Model) addGenConstrAndOr(isAnd bool, resVar *Var, vars []*Var, name string) (*GenConstr, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if resVar == nil || resVar.Index < 0 {
+		return nil, errors.New("Invalid resVar")
+	}
+
+	ind := make([]int32, len(vars))
+	for i, v := range vars {
+		if v == nil || v.Index < 0 {
+			return nil, errors.New("Invalid vars")
+		}
+		ind[i] = v.Index
+	}
+
+	pind := (*C.int)(nil)
+	if len(ind) > 0 {
+		pind = (*C.int)(&ind[0])
+	}
+
+	if model.dryRun != nil {
+		method := "AddGenConstrAnd"
+		if !isAnd {
+			method = "AddGenConstrOr"
+		}
+		if err := model.dryRun.addGenConstr(method, append([]*Var{resVar}, vars...)...); err != nil {
+			return nil, err
+		}
+		return model.appendGenConstr(), nil
+	}
+
+	args := &cgoArgs{}
+	defer args.free()
+
+	var errCode C.int
+	if isAnd {
+		done := traceCall("GRBaddgenconstrAnd", name, resVar.Index)
+		errCode = C.GRBaddgenconstrAnd(model.AsGRBModel, args.str(name), C.int(resVar.Index), C.int(len(ind)), pind)
+		done(errCode)
+	} else {
+		done := traceCall("GRBaddgenconstrOr", name, resVar.Index)
+		errCode = C.GRBaddgenconstrOr(model.AsGRBModel, args.str(name), C.int(resVar.Index), C.int(len(ind)), pind)
+		done(errCode)
+	}
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	model.changes.newGenConstrs++
+	model.emitItems(EventGenConstrsAdded, len(model.GenConstrs), 1)
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	return model.appendGenConstr(), nil
+}
@@ -0,0 +1,370 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import "fmt"
+
+/*
+genconstrdata.go
+Description:
+	Getters which return the structure of the general constraints of a model
+	(e.g., of a model read from a file), so that they can be audited,
+	reported or converted.
+Notes:
+	Each GRBgetgenconstr* function is called twice: once to get the number
+	of variables (or points) and once to fill the arrays.
+	https://www.gurobi.com/documentation/current/refman/c_getgenconstrmax.html
+*/
+
+// GenConstrType is the type of a general constraint (the GenConstrType attribute).
+type GenConstrType int32
+
+const (
+	GenConstrMax       GenConstrType = C.GRB_GENCONSTR_MAX
+	GenConstrMin       GenConstrType = C.GRB_GENCONSTR_MIN
+	GenConstrAbs       GenConstrType = C.GRB_GENCONSTR_ABS
+	GenConstrAnd       GenConstrType = C.GRB_GENCONSTR_AND
+	GenConstrOr        GenConstrType = C.GRB_GENCONSTR_OR
+	GenConstrNorm      GenConstrType = C.GRB_GENCONSTR_NORM
+	GenConstrIndicator GenConstrType = C.GRB_GENCONSTR_INDICATOR
+	GenConstrPWL       GenConstrType = C.GRB_GENCONSTR_PWL
+)
+
+func (gt GenConstrType) String() string {
+	switch gt {
+	case GenConstrMax:
+		return "Max"
+	case GenConstrMin:
+		return "Min"
+	case GenConstrAbs:
+		return "Abs"
+	case GenConstrAnd:
+		return "And"
+	case GenConstrOr:
+		return "Or"
+	case GenConstrNorm:
+		return "Norm"
+	case GenConstrIndicator:
+		return "Indicator"
+	case GenConstrPWL:
+		return "PWL"
+	default:
+		return fmt.Sprintf("GenConstrType(%v)", int32(gt))
+	}
+}
+
+/*
+IndicatorData
+Description:
+
+	The indicator constraint (BinVar == BinVal) -> (Vars * Vals Sense RHS).
+*/
+type IndicatorData struct {
+	BinVar *Var
+	BinVal int
+	Vars   []*Var
+	Vals   []float64
+	Sense  Sense
+	RHS    float64
+}
+
+/*
+MinMaxData
+Description:
+
+	The constraint ResVar = max(Vars..., Constant) (or min, if IsMax is false).
+*/
+type MinMaxData struct {
+	IsMax    bool
+	ResVar   *Var
+	Vars     []*Var
+	Constant float64
+}
+
+/*
+AbsData
+Description:
+
+	The constraint ResVar = |ArgVar|.
+*/
+type AbsData struct {
+	ResVar *Var
+	ArgVar *Var
+}
+
+/*
+AndOrData
+Description:
+
+	The constraint ResVar = and(Vars...) (or or(Vars...), if IsAnd is false).
+*/
+type AndOrData struct {
+	IsAnd  bool
+	ResVar *Var
+	Vars   []*Var
+}
+
+/*
+PWLData
+Description:
+
+	The piecewise-linear constraint Y = f(X) with the breakpoints (XPts[i], YPts[i]).
+*/
+type PWLData struct {
+	X    *Var
+	Y    *Var
+	XPts []float64
+	YPts []float64
+}
+
+/*
+Type
+Description:
+
+	Returns the type of the general constraint.
+*/
+func (gc *GenConstr) Type() (GenConstrType, error) {
+	gtype, err := gc.Model.getIntAttrElement("GenConstrType", gc.Index)
+	return GenConstrType(gtype), err
+}
+
+/*
+Data
+Description:
+
+	Returns the structure of the general constraint as an *IndicatorData,
+	*MinMaxData, *AbsData, *AndOrData or *PWLData, depending on its type.
+*/
+func (gc *GenConstr) Data() (interface{}, error) {
+	gtype, err := gc.Type()
+	if err != nil {
+		return nil, err
+	}
+
+	switch gtype {
+	case GenConstrIndicator:
+		return gc.Indicator()
+	case GenConstrMax, GenConstrMin:
+		return gc.MinMax()
+	case GenConstrAbs:
+		return gc.Abs()
+	case GenConstrAnd, GenConstrOr:
+		return gc.AndOr()
+	case GenConstrPWL:
+		return gc.PWL()
+	default:
+		return nil, fmt.Errorf("the structure of %v constraints is not supported", gtype)
+	}
+}
+
+/*
+Indicator
+Description:
+
+	Returns the structure of an indicator constraint.
+*/
+func (gc *GenConstr) Indicator() (*IndicatorData, error) {
+	if err := gc.expectType(GenConstrIndicator); err != nil {
+		return nil, err
+	}
+
+	var binVar, binVal, nvars int32
+	var sense C.char
+	var rhs float64
+	done := traceCall("GRBgetgenconstrIndicator", gc.Index)
+	errCode := C.GRBgetgenconstrIndicator(gc.Model.AsGRBModel, C.int(gc.Index), (*C.int)(&binVar), (*C.int)(&binVal), (*C.int)(&nvars), nil, nil, &sense, (*C.double)(&rhs))
+	done(errCode)
+	if errCode != 0 {
+		return nil, gc.Model.MakeError(errCode)
+	}
+
+	ind := make([]int32, nvars)
+	vals := make([]float64, nvars)
+	if nvars > 0 {
+		done := traceCall("GRBgetgenconstrIndicator", gc.Index, nvars)
+		errCode := C.GRBgetgenconstrIndicator(gc.Model.AsGRBModel, C.int(gc.Index), nil, nil, (*C.int)(&nvars), (*C.int)(&ind[0]), (*C.double)(&vals[0]), nil, nil)
+		done(errCode)
+		if errCode != 0 {
+			return nil, gc.Model.MakeError(errCode)
+		}
+	}
+
+	return &IndicatorData{
+		BinVar: &Var{gc.Model, binVar},
+		BinVal: int(binVal),
+		Vars:   gc.Model.varsAt(ind),
+		Vals:   vals,
+		Sense:  Sense(sense),
+		RHS:    rhs,
+	}, nil
+}
+
+/*
+MinMax
+Description:
+
+	Returns the structure of a max or min constraint.
+*/
+func (gc *GenConstr) MinMax() (*MinMaxData, error) {
+	if err := gc.expectType(GenConstrMax, GenConstrMin); err != nil {
+		return nil, err
+	}
+	gtype, _ := gc.Type()
+	isMax := gtype == GenConstrMax
+
+	getter := func(ind *C.int, nvars *C.int, resVar *C.int, constant *C.double) C.int {
+		if isMax {
+			return C.GRBgetgenconstrMax(gc.Model.AsGRBModel, C.int(gc.Index), resVar, nvars, ind, constant)
+		}
+		return C.GRBgetgenconstrMin(gc.Model.AsGRBModel, C.int(gc.Index), resVar, nvars, ind, constant)
+	}
+
+	var resVar, nvars int32
+	var constant float64
+	done := traceCall("GRBgetgenconstr"+gtype.String(), gc.Index)
+	errCode := getter(nil, (*C.int)(&nvars), (*C.int)(&resVar), (*C.double)(&constant))
+	done(errCode)
+	if errCode != 0 {
+		return nil, gc.Model.MakeError(errCode)
+	}
+
+	ind := make([]int32, nvars)
+	if nvars > 0 {
+		done := traceCall("GRBgetgenconstr"+gtype.String(), gc.Index, nvars)
+		errCode := getter((*C.int)(&ind[0]), (*C.int)(&nvars), nil, nil)
+		done(errCode)
+		if errCode != 0 {
+			return nil, gc.Model.MakeError(errCode)
+		}
+	}
+
+	return &MinMaxData{
+		IsMax:    isMax,
+		ResVar:   &Var{gc.Model, resVar},
+		Vars:     gc.Model.varsAt(ind),
+		Constant: constant,
+	}, nil
+}
+
+/*
+Abs
+Description:
+
+	Returns the structure of an absolute value constraint.
+*/
+func (gc *GenConstr) Abs() (*AbsData, error) {
+	if err := gc.expectType(GenConstrAbs); err != nil {
+		return nil, err
+	}
+
+	var resVar, argVar int32
+	done := traceCall("GRBgetgenconstrAbs", gc.Index)
+	errCode := C.GRBgetgenconstrAbs(gc.Model.AsGRBModel, C.int(gc.Index), (*C.int)(&resVar), (*C.int)(&argVar))
+	done(errCode)
+	if errCode != 0 {
+		return nil, gc.Model.MakeError(errCode)
+	}
+
+	return &AbsData{ResVar: &Var{gc.Model, resVar}, ArgVar: &Var{gc.Model, argVar}}, nil
+}
+
+/*
+AndOr
+Description:
+
+	Returns the structure of an and or or constraint.
+*/
+func (gc *GenConstr) AndOr() (*AndOrData, error) {
+	if err := gc.expectType(GenConstrAnd, GenConstrOr); err != nil {
+		return nil, err
+	}
+	gtype, _ := gc.Type()
+	isAnd := gtype == GenConstrAnd
+
+	getter := func(ind *C.int, nvars *C.int, resVar *C.int) C.int {
+		if isAnd {
+			return C.GRBgetgenconstrAnd(gc.Model.AsGRBModel, C.int(gc.Index), resVar, nvars, ind)
+		}
+		return C.GRBgetgenconstrOr(gc.Model.AsGRBModel, C.int(gc.Index), resVar, nvars, ind)
+	}
+
+	var resVar, nvars int32
+	done := traceCall("GRBgetgenconstr"+gtype.String(), gc.Index)
+	errCode := getter(nil, (*C.int)(&nvars), (*C.int)(&resVar))
+	done(errCode)
+	if errCode != 0 {
+		return nil, gc.Model.MakeError(errCode)
+	}
+
+	ind := make([]int32, nvars)
+	if nvars > 0 {
+		done := traceCall("GRBgetgenconstr"+gtype.String(), gc.Index, nvars)
+		errCode := getter((*C.int)(&ind[0]), (*C.int)(&nvars), nil)
+		done(errCode)
+		if errCode != 0 {
+			return nil, gc.Model.MakeError(errCode)
+		}
+	}
+
+	return &AndOrData{IsAnd: isAnd, ResVar: &Var{gc.Model, resVar}, Vars: gc.Model.varsAt(ind)}, nil
+}
+
+/*
+PWL
+Description:
+
+	Returns the structure of a piecewise-linear constraint.
+*/
+func (gc *GenConstr) PWL() (*PWLData, error) {
+	if err := gc.expectType(GenConstrPWL); err != nil {
+		return nil, err
+	}
+
+	var x, y, npts int32
+	done := traceCall("GRBgetgenconstrPWL", gc.Index)
+	errCode := C.GRBgetgenconstrPWL(gc.Model.AsGRBModel, C.int(gc.Index), (*C.int)(&x), (*C.int)(&y), (*C.int)(&npts), nil, nil)
+	done(errCode)
+	if errCode != 0 {
+		return nil, gc.Model.MakeError(errCode)
+	}
+
+	xpts := make([]float64, npts)
+	ypts := make([]float64, npts)
+	if npts > 0 {
+		done := traceCall("GRBgetgenconstrPWL", gc.Index, npts)
+		errCode := C.GRBgetgenconstrPWL(gc.Model.AsGRBModel, C.int(gc.Index), nil, nil, (*C.int)(&npts), (*C.double)(&xpts[0]), (*C.double)(&ypts[0]))
+		done(errCode)
+		if errCode != 0 {
+			return nil, gc.Model.MakeError(errCode)
+		}
+	}
+
+	return &PWLData{X: &Var{gc.Model, x}, Y: &Var{gc.Model, y}, XPts: xpts, YPts: ypts}, nil
+}
+
+// expectType returns an error unless the general constraint has one of the given types.
+func (gc *GenConstr) expectType(types ...GenConstrType) error {
+	if gc == nil || gc.Index < 0 {
+		return fmt.Errorf("the general constraint is invalid")
+	}
+
+	gtype, err := gc.Type()
+	if err != nil {
+		return err
+	}
+	for _, t := range types {
+		if gtype == t {
+			return nil
+		}
+	}
+	return fmt.Errorf("general constraint %v is a %v constraint, not %v", gc.Index, gtype, types[0])
+}
+
+// varsAt returns the variables of the model with the given indices.
+func (model *Model) varsAt(ind []int32) []*Var {
+	vars := make([]*Var, len(ind))
+	for i, j := range ind {
+		vars[i] = &Var{model, j}
+	}
+	return vars
+}
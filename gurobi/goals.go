@@ -0,0 +1,126 @@
+package gurobi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"text/tabwriter"
+)
+
+/*
+goals.go
+Description:
+	A goal-programming view of the soft constraints of a solved model: how
+	much each goal was missed, in which direction, and how much of the total
+	penalty it accounts for, which is what is needed to tune the penalty
+	weights.
+*/
+
+/*
+GoalReport
+Description:
+
+	The deviations of all soft constraints in the current solution.
+	- Goals: One entry per soft constraint, in the order they were added.
+	- Duals: The dual value (Pi) of each soft constraint. For an LP, a goal
+	  which is met with |Pi| < Weight keeps being met if its weight is
+	  lowered to |Pi|. The duals are NaN for models without duals (e.g., MIPs).
+	- TotalPenalty: The sum of the penalties of all goals.
+	- ObjVal: The objective value, which includes the penalties.
+*/
+type GoalReport struct {
+	Goals        []SoftConstrReport
+	Duals        []float64
+	TotalPenalty float64
+	ObjVal       float64
+}
+
+/*
+GoalReport
+Description:
+
+	Returns the goal report of the current solution.
+*/
+func (model *Model) GoalReport() (*GoalReport, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(model.softConstrs) == 0 {
+		return nil, errors.New("the model has no soft constraints")
+	}
+
+	// Algorithm
+	report := &GoalReport{Duals: make([]float64, len(model.softConstrs))}
+	if report.Goals, report.TotalPenalty, err = model.SoftConstrReport(); err != nil {
+		return nil, err
+	}
+	if report.ObjVal, err = model.ObjVal(); err != nil {
+		return nil, err
+	}
+
+	for i, sc := range model.softConstrs {
+		report.Duals[i], err = model.getDoubleAttrElement("Pi", sc.Constr.Index)
+		if errors.Is(err, ErrDataNotAvailable) {
+			report.Duals[i] = math.NaN()
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+/*
+Share
+Description:
+
+	Returns the fraction of the total penalty which goal i accounts for
+	(0 if no goal is violated).
+*/
+func (gr *GoalReport) Share(i int) float64 {
+	if gr.TotalPenalty == 0 {
+		return 0
+	}
+	return gr.Goals[i].Penalty / gr.TotalPenalty
+}
+
+/*
+Violated
+Description:
+
+	Returns the goals which are violated by more than tol.
+*/
+func (gr *GoalReport) Violated(tol float64) []SoftConstrReport {
+	violated := []SoftConstrReport{}
+	for _, goal := range gr.Goals {
+		if goal.Violation > tol {
+			violated = append(violated, goal)
+		}
+	}
+	return violated
+}
+
+/*
+Write
+Description:
+
+	Writes the report as a table with one row per goal, followed by the
+	total penalty and the objective value.
+*/
+func (gr *GoalReport) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "GOAL\tWEIGHT\tOVER\tUNDER\tPENALTY\tSHARE\tDUAL")
+	for i, goal := range gr.Goals {
+		fmt.Fprintf(
+			tw, "%v\t%.6g\t%.6g\t%.6g\t%.6g\t%.1f%%\t%.6g\n",
+			goal.Name, goal.Weight, goal.Over, goal.Under, goal.Penalty, 100*gr.Share(i), gr.Duals[i],
+		)
+	}
+	fmt.Fprintf(tw, "TOTAL\t\t\t\t%.6g\t\t\n", gr.TotalPenalty)
+	fmt.Fprintf(tw, "OBJECTIVE\t\t\t\t%.6g\t\t\n", gr.ObjVal)
+	return tw.Flush()
+}
@@ -10,22 +10,52 @@ const DBL_ATTR_X = C.GRB_DBL_ATTR_X
 const DBL_ATTR_LB = C.GRB_DBL_ATTR_LB
 const DBL_ATTR_UB = C.GRB_DBL_ATTR_UB
 const DBL_ATTR_START = C.GRB_DBL_ATTR_START
+const DBL_ATTR_RHS = C.GRB_DBL_ATTR_RHS
+const DBL_ATTR_OBJCON = C.GRB_DBL_ATTR_OBJCON
+const CHAR_ATTR_SENSE = C.GRB_CHAR_ATTR_SENSE
 const INT_ATTR_SOLCOUNT = C.GRB_INT_ATTR_SOLCOUNT
 const INT_ATTR_NUMVARS = C.GRB_INT_ATTR_NUMVARS
 const INT_ATTR_NUMCONSTRS = C.GRB_INT_ATTR_NUMCONSTRS
+const INT_ATTR_NUMSOS = C.GRB_INT_ATTR_NUMSOS
+const INT_ATTR_MODELSENSE = C.GRB_INT_ATTR_MODELSENSE
+const DBL_ATTR_OBJBOUND = C.GRB_DBL_ATTR_OBJBOUND
+const DBL_ATTR_MIPGAP = C.GRB_DBL_ATTR_MIPGAP
+const DBL_ATTR_RUNTIME = C.GRB_DBL_ATTR_RUNTIME
+const DBL_ATTR_NODECOUNT = C.GRB_DBL_ATTR_NODECOUNT
+const DBL_ATTR_ITERCOUNT = C.GRB_DBL_ATTR_ITERCOUNT
+const INT_ATTR_BARITERCOUNT = C.GRB_INT_ATTR_BARITERCOUNT
 
+const LOADED = C.GRB_LOADED
 const OPTIMAL = C.GRB_OPTIMAL
 const INF_OR_UNBD = C.GRB_INF_OR_UNBD
 const TIME_LIMIT = C.GRB_TIME_LIMIT
 const UNBOUNDED = C.GRB_UNBOUNDED
 const INTERRUPTED = C.GRB_INTERRUPTED
 const INFEASIBLE = C.GRB_INFEASIBLE
+const CUTOFF = C.GRB_CUTOFF
+const ITERATION_LIMIT = C.GRB_ITERATION_LIMIT
+const NODE_LIMIT = C.GRB_NODE_LIMIT
+const SOLUTION_LIMIT = C.GRB_SOLUTION_LIMIT
+const NUMERIC = C.GRB_NUMERIC
+const SUBOPTIMAL = C.GRB_SUBOPTIMAL
+const INPROGRESS = C.GRB_INPROGRESS
+const USER_OBJ_LIMIT = C.GRB_USER_OBJ_LIMIT
+
+// The following status codes were introduced in Gurobi 9.5 and 10.0, so they are
+// defined by value to keep compatibility with older installations.
+const WORK_LIMIT = 16
+const MEM_LIMIT = 17
 
 const BINARY = C.GRB_BINARY
 const INTEGER = C.GRB_INTEGER
 const CONTINUOUS = C.GRB_CONTINUOUS
+const SEMICONT = C.GRB_SEMICONT
+const SEMIINT = C.GRB_SEMIINT
 
 const INFINITY = 1e100
 
+// UNDEFINED marks a missing value, e.g. in a MIP start (GRB_UNDEFINED).
+const UNDEFINED = 1e101
+
 const MAXIMIZE = C.GRB_MAXIMIZE
 const MINIMIZE = C.GRB_MINIMIZE
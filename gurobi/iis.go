@@ -0,0 +1,426 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/*
+iis.go
+Description:
+	Functions for computing Irreducible Inconsistent Subsystems (IIS) of
+	infeasible models.
+Notes:
+	https://www.gurobi.com/documentation/current/refman/c_computeiis.html
+*/
+
+/*
+IIS
+Description:
+
+	An irreducible inconsistent subsystem: the indices of the linear
+	constraints, variable lower bounds and variable upper bounds (and of the
+	general, SOS and quadratic constraints) which together are infeasible.
+	If Minimal is true, any proper subset of the members is feasible; it is
+	false if the computation was stopped early (e.g., by a time limit).
+*/
+type IIS struct {
+	Constrs     []int32
+	LowerBounds []int32
+	UpperBounds []int32
+	GenConstrs  []int32
+	SOS         []int32
+	QConstrs    []int32
+	Minimal     bool
+}
+
+/*
+Size
+Description:
+
+	Returns the total number of members of the IIS.
+*/
+func (iis *IIS) Size() int {
+	return len(iis.Constrs) + len(iis.LowerBounds) + len(iis.UpperBounds) +
+		len(iis.GenConstrs) + len(iis.SOS) + len(iis.QConstrs)
+}
+
+/*
+Equal
+Description:
+
+	Returns true if both IISes have exactly the same members.
+*/
+func (iis *IIS) Equal(other *IIS) bool {
+	return equalIndices(iis.Constrs, other.Constrs) &&
+		equalIndices(iis.LowerBounds, other.LowerBounds) &&
+		equalIndices(iis.UpperBounds, other.UpperBounds) &&
+		equalIndices(iis.GenConstrs, other.GenConstrs) &&
+		equalIndices(iis.SOS, other.SOS) &&
+		equalIndices(iis.QConstrs, other.QConstrs)
+}
+
+func equalIndices(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+ComputeIIS
+Description:
+
+	Computes an IIS of the (infeasible) model and returns its members.
+	The model's IIS attributes (IISConstr, IISLB, IISUB, ...) are also
+	populated. Use IISMembers to list the members with their names.
+*/
+func (model *Model) ComputeIIS() (*IIS, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	endSolve, err := model.beginSolve("ComputeIIS")
+	if err != nil {
+		return nil, err
+	}
+	done := traceCall("GRBcomputeIIS")
+	errCode := C.GRBcomputeIIS(model.AsGRBModel)
+	done(errCode)
+	endSolve()
+	model.attrCache.clear()
+	if cbErr := model.takeCallbackError(); cbErr != nil {
+		return nil, cbErr
+	}
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.readIIS()
+}
+
+/*
+WriteIIS
+Description:
+
+	Writes the members of the model's IIS to path in the ILP format, which
+	must have the extension .ilp (optionally compressed, e.g. .ilp.gz). The
+	IIS is computed first if the model does not have one yet.
+*/
+func (model *Model) WriteIIS(path string) error {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return err
+	}
+
+	if !isILPFile(path) {
+		return fmt.Errorf("an IIS can only be written to a .ilp file; received %v", path)
+	}
+
+	// Algorithm
+	_, err = model.GetIntAttr("IISMinimal")
+	if errors.Is(err, ErrDataNotAvailable) {
+		if _, err := model.ComputeIIS(); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	return model.Write(path)
+}
+
+func isILPFile(path string) bool {
+	return hasFileExt(path, ".ilp")
+}
+
+// hasFileExt returns true if path has the extension ext, optionally followed by a compression extension.
+func hasFileExt(path string, ext string) bool {
+	for _, compression := range []string{"", ".gz", ".bz2", ".zip", ".7z"} {
+		if strings.HasSuffix(path, ext+compression) {
+			return true
+		}
+	}
+	return false
+}
+
+// readIIS collects the members of the most recently computed IIS.
+func (model *Model) readIIS() (*IIS, error) {
+	numVars, err := model.NumVars()
+	if err != nil {
+		return nil, err
+	}
+
+	numConstrs, err := model.NumConstrs()
+	if err != nil {
+		return nil, err
+	}
+
+	numGenConstrs, err := model.NumGenConstrs()
+	if err != nil {
+		return nil, err
+	}
+
+	numSOS, err := model.NumSOS()
+	if err != nil {
+		return nil, err
+	}
+
+	numQConstrs, err := model.NumQConstrs()
+	if err != nil {
+		return nil, err
+	}
+
+	minimal, err := model.GetIntAttr("IISMinimal")
+	if err != nil {
+		return nil, err
+	}
+
+	iis := &IIS{Minimal: minimal != 0}
+	if iis.Constrs, err = model.iisMembers("IISConstr", numConstrs); err != nil {
+		return nil, err
+	}
+	if iis.LowerBounds, err = model.iisMembers("IISLB", numVars); err != nil {
+		return nil, err
+	}
+	if iis.UpperBounds, err = model.iisMembers("IISUB", numVars); err != nil {
+		return nil, err
+	}
+	if iis.GenConstrs, err = model.iisMembers("IISGenConstr", numGenConstrs); err != nil {
+		return nil, err
+	}
+	if iis.SOS, err = model.iisMembers("IISSOS", numSOS); err != nil {
+		return nil, err
+	}
+	if iis.QConstrs, err = model.iisMembers("IISQConstr", numQConstrs); err != nil {
+		return nil, err
+	}
+	return iis, nil
+}
+
+// iisMembers returns the indices for which the integer array attribute attr is nonzero.
+func (model *Model) iisMembers(attr string, length int32) ([]int32, error) {
+	members := []int32{}
+	if length == 0 {
+		return members, nil
+	}
+
+	args := &cgoArgs{}
+	defer args.free()
+
+	flags := make([]int32, length)
+	done := traceCall("GRBgetintattrarray", attr, length)
+	errCode := C.GRBgetintattrarray(model.AsGRBModel, args.str(attr), 0, C.int(length), args.ints(flags))
+	done(errCode)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	for i, flag := range flags {
+		if flag != 0 {
+			members = append(members, int32(i))
+		}
+	}
+	return members, nil
+}
+
+/*
+ComputeIISes
+Description:
+
+	Returns up to max distinct IISes of the model. It works on a copy of the
+	model: after each IIS is computed, one of its members (a constraint if
+	there is one, otherwise a bound) is relaxed, and the search is repeated
+	until the copy becomes feasible, an IIS repeats or max IISes were found.
+	The IISes are returned in the order they were found and all indices
+	refer to the original model.
+*/
+func (model *Model) ComputeIISes(max int) ([]*IIS, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if max < 1 {
+		return nil, fmt.Errorf("max must be at least 1; received %v", max)
+	}
+
+	work, err := model.Copy()
+	if err != nil {
+		return nil, err
+	}
+	defer work.Free()
+
+	// Algorithm
+	found := []*IIS{}
+	for len(found) < max {
+		iis, err := work.ComputeIIS()
+		var gErr Error
+		if errors.As(err, &gErr) && gErr.ErrorCode == ERROR_IIS_NOT_INFEASIBLE {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		// Only linear constraints and bounds can be relaxed.
+		if len(iis.Constrs)+len(iis.LowerBounds)+len(iis.UpperBounds) == 0 {
+			if iis.Size() > 0 {
+				found = append(found, iis)
+			}
+			break
+		}
+
+		repeated := false
+		for _, prev := range found {
+			if prev.Equal(iis) {
+				repeated = true
+				break
+			}
+		}
+		if repeated {
+			break
+		}
+		found = append(found, iis)
+
+		if err := work.relaxIISMember(iis); err != nil {
+			return nil, err
+		}
+	}
+
+	return found, nil
+}
+
+/*
+IISMemberKind
+Description:
+
+	The kind of a member of an IIS.
+*/
+type IISMemberKind int
+
+const (
+	IISMemberConstr IISMemberKind = iota
+	IISMemberLowerBound
+	IISMemberUpperBound
+	IISMemberGenConstr
+	IISMemberSOS
+	IISMemberQConstr
+)
+
+func (kind IISMemberKind) String() string {
+	switch kind {
+	case IISMemberConstr:
+		return "Constr"
+	case IISMemberLowerBound:
+		return "LowerBound"
+	case IISMemberUpperBound:
+		return "UpperBound"
+	case IISMemberGenConstr:
+		return "GenConstr"
+	case IISMemberSOS:
+		return "SOS"
+	case IISMemberQConstr:
+		return "QConstr"
+	default:
+		return fmt.Sprintf("IISMemberKind(%v)", int(kind))
+	}
+}
+
+/*
+IISMember
+Description:
+
+	A single member of an IIS. Index is the index of the constraint (or of
+	the variable, for a bound), and Name its name (the name of the variable
+	for a bound; SOS constraints have no name).
+*/
+type IISMember struct {
+	Kind  IISMemberKind
+	Index int32
+	Name  string
+}
+
+func (member IISMember) String() string {
+	if member.Name == "" {
+		return fmt.Sprintf("%v %v", member.Kind, member.Index)
+	}
+	return fmt.Sprintf("%v %v", member.Kind, member.Name)
+}
+
+/*
+IISMembers
+Description:
+
+	Lists the members of iis (computed on this model) with their kinds and
+	names, e.g., to print the conflicting constraints and bounds of an
+	infeasible model.
+*/
+func (model *Model) IISMembers(iis *IIS) ([]IISMember, error) {
+	if iis == nil {
+		return nil, errors.New("the IIS is nil")
+	}
+
+	groups := []struct {
+		kind    IISMemberKind
+		indices []int32
+		attr    string
+	}{
+		{IISMemberConstr, iis.Constrs, "ConstrName"},
+		{IISMemberLowerBound, iis.LowerBounds, "VarName"},
+		{IISMemberUpperBound, iis.UpperBounds, "VarName"},
+		{IISMemberGenConstr, iis.GenConstrs, "GenConstrName"},
+		{IISMemberSOS, iis.SOS, ""},
+		{IISMemberQConstr, iis.QConstrs, "QCName"},
+	}
+
+	members := make([]IISMember, 0, iis.Size())
+	for _, group := range groups {
+		for _, ind := range group.indices {
+			member := IISMember{Kind: group.kind, Index: ind}
+			if group.attr != "" {
+				name, err := model.getStringAttrElement(group.attr, ind)
+				if err != nil {
+					return nil, err
+				}
+				member.Name = name
+			}
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+// relaxIISMember removes one member of iis from the model by making it non-binding.
+func (model *Model) relaxIISMember(iis *IIS) error {
+	switch {
+	case len(iis.Constrs) > 0:
+		idx := iis.Constrs[0]
+		if err := model.setCharAttrElement("Sense", idx, int8(Le)); err != nil {
+			return err
+		}
+		if err := model.setDoubleAttrElement("RHS", idx, INFINITY); err != nil {
+			return err
+		}
+	case len(iis.LowerBounds) > 0:
+		if err := model.setDoubleAttrElement(DBL_ATTR_LB, iis.LowerBounds[0], -INFINITY); err != nil {
+			return err
+		}
+	default:
+		if err := model.setDoubleAttrElement(DBL_ATTR_UB, iis.UpperBounds[0], INFINITY); err != nil {
+			return err
+		}
+	}
+
+	return model.Update()
+}
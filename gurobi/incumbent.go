@@ -0,0 +1,154 @@
+package gurobi
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/*
+incumbent.go
+Description:
+	Functions for keeping the trajectory of improving (incumbent) solutions
+	found during a MIP solve, either through Gurobi's SolFiles parameter or
+	through an incumbent-history directory managed by a callback.
+*/
+
+/*
+SetSolFiles
+Description:
+
+	Sets the SolFiles parameter so that Gurobi writes every new incumbent to
+	the numbered file <prefix>_<n>.sol. An empty prefix disables this.
+*/
+func (model *Model) SetSolFiles(prefix string) error {
+	return model.SetStringParam("SolFiles", prefix)
+}
+
+/*
+IncumbentRecord
+Description:
+
+	Describes one incumbent solution written by an IncumbentHistory.
+*/
+type IncumbentRecord struct {
+	Number    int
+	Objective float64
+	Runtime   float64
+	Path      string
+}
+
+/*
+IncumbentHistory
+Description:
+
+	Writes each new incumbent to Dir as incumbent_<n>.sol (in Gurobi's .sol
+	format) and keeps a record of the objective and runtime at which it was
+	found. Install it with Install, or call the CallbackFunc returned by
+	Callback from your own callback.
+*/
+type IncumbentHistory struct {
+	Dir     string
+	Records []IncumbentRecord
+
+	names []string
+}
+
+/*
+NewIncumbentHistory
+Description:
+
+	Creates an IncumbentHistory which writes into dir, creating dir if needed.
+*/
+func NewIncumbentHistory(dir string) (*IncumbentHistory, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &IncumbentHistory{Dir: dir}, nil
+}
+
+/*
+Install
+Description:
+
+	Caches the variable names of the model (which cannot be queried during
+	optimization) and registers the history's callback, replacing any previous callback.
+*/
+func (ih *IncumbentHistory) Install(model *Model) error {
+	if err := ih.Prepare(model); err != nil {
+		return err
+	}
+	return model.SetCallback(ih.Callback())
+}
+
+/*
+Prepare
+Description:
+
+	Caches the variable names of the model. Call this before optimizing when
+	using Callback from your own callback function.
+*/
+func (ih *IncumbentHistory) Prepare(model *Model) error {
+	names, err := model.VarNames()
+	if err != nil {
+		return err
+	}
+
+	ih.names = names
+	return nil
+}
+
+/*
+Callback
+Description:
+
+	Returns the CallbackFunc which records each new incumbent.
+*/
+func (ih *IncumbentHistory) Callback() CallbackFunc {
+	return func(cb *CallbackContext) error {
+		if cb.Where != WhereMIPSol {
+			return nil
+		}
+
+		sol, err := cb.MIPSolution()
+		if err != nil {
+			return err
+		}
+		obj, err := cb.MIPSolObj()
+		if err != nil {
+			return err
+		}
+		runtime, err := cb.Runtime()
+		if err != nil {
+			return err
+		}
+
+		record := IncumbentRecord{
+			Number:    len(ih.Records),
+			Objective: obj,
+			Runtime:   runtime,
+			Path:      filepath.Join(ih.Dir, fmt.Sprintf("incumbent_%v.sol", len(ih.Records))),
+		}
+		if err := os.WriteFile(record.Path, []byte(ih.format(obj, sol)), 0o644); err != nil {
+			return err
+		}
+
+		ih.Records = append(ih.Records, record)
+		return nil
+	}
+}
+
+// format writes the solution in Gurobi's .sol format.
+func (ih *IncumbentHistory) format(obj float64, sol []float64) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Objective value = %v\n", obj)
+	for i, x := range sol {
+		name := fmt.Sprintf("C%v", i)
+		if i < len(ih.names) && ih.names[i] != "" {
+			name = ih.names[i]
+		}
+		fmt.Fprintf(&sb, "%v %v\n", name, x)
+	}
+	return sb.String()
+}
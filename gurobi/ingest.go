@@ -0,0 +1,305 @@
+package gurobi
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+/*
+ingest.go
+Description:
+	Builds the variables and constraints of a ModelSpec from tabular data
+	(the rows of a CSV file or a slice of structs) according to a small
+	declarative RowSpec, so that the code between data loading and model
+	building is reduced to describing which column means what.
+Notes:
+	Every row becomes one variable. A constraint either spans all rows or,
+	with GroupBy, is repeated for every distinct value of a column (e.g.,
+	one "assign exactly one plant" constraint per customer).
+*/
+
+/*
+Row
+Description:
+
+	One record of tabular data, mapping column names to their (unparsed) values.
+*/
+type Row map[string]string
+
+/*
+RowConstr
+Description:
+
+	A constraint of a RowSpec: sum_rows Coef(row) * x_row (Sense) RHS.
+	- Name: The name of the constraint. With GroupBy, each constraint is
+	  named Name[value].
+	- Coef: The column which holds the coefficient of each row's variable.
+	  If empty, every coefficient is 1. Rows with an empty or zero
+	  coefficient are left out.
+	- GroupBy: If not empty, one constraint is added per distinct value of
+	  this column, over the rows with that value.
+*/
+type RowConstr struct {
+	Name    string
+	Coef    string
+	GroupBy string
+	Sense   Sense
+	RHS     float64
+}
+
+/*
+RowSpec
+Description:
+
+	Describes how rows map to variables. Each field other than Constrs is
+	the name of a column; empty fields (and empty cells) fall back to the
+	defaults below. A column which is named but missing from a row is an
+	error, so that typos do not silently fall back to the defaults.
+	- Name: The name of the variable (default: <table>[i]).
+	- Type: The type of the variable as a single character, e.g. "C" or "B"
+	  (default: Continuous).
+	- LB: The lower bound (default: 0).
+	- UB: The upper bound (default: INFINITY).
+	- Obj: The objective coefficient (default: 0).
+*/
+type RowSpec struct {
+	Name    string
+	Type    string
+	LB      string
+	UB      string
+	Obj     string
+	Constrs []RowConstr
+}
+
+/*
+ReadRowsCSV
+Description:
+
+	Reads a CSV file whose first line holds the column names.
+*/
+func ReadRowsCSV(r io.Reader) ([]Row, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("the CSV input has no header")
+	}
+
+	header := records[0]
+	rows := make([]Row, len(records)-1)
+	for i, record := range records[1:] {
+		rows[i] = make(Row, len(header))
+		for j, column := range header {
+			rows[i][strings.TrimSpace(column)] = strings.TrimSpace(record[j])
+		}
+	}
+	return rows, nil
+}
+
+/*
+RowsFromStructs
+Description:
+
+	Converts a slice of structs (or of pointers to structs) into rows. The
+	column of an exported field is its `csv` tag or, without one, its name;
+	fields tagged `csv:"-"` are skipped. Values are formatted with fmt.Sprint.
+*/
+func RowsFromStructs(slice interface{}) ([]Row, error) {
+	value := reflect.ValueOf(slice)
+	if value.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("expected a slice of structs; received %T", slice)
+	}
+
+	rows := make([]Row, value.Len())
+	for i := range rows {
+		elem := value.Index(i)
+		for elem.Kind() == reflect.Ptr && !elem.IsNil() {
+			elem = elem.Elem()
+		}
+		if elem.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("element %v: expected a struct; received %v", i, elem.Type())
+		}
+
+		rows[i] = Row{}
+		for j := 0; j < elem.NumField(); j++ {
+			field := elem.Type().Field(j)
+			if field.PkgPath != "" {
+				continue
+			}
+			column := field.Tag.Get("csv")
+			if column == "-" {
+				continue
+			}
+			if column == "" {
+				column = field.Name
+			}
+			rows[i][column] = fmt.Sprint(elem.Field(j).Interface())
+		}
+	}
+	return rows, nil
+}
+
+/*
+AddRows
+Description:
+
+	Adds one variable per row and the constraints of rowSpec to the
+	ModelSpec, and returns the indices of the new variables (in row order).
+	table names the variables when rowSpec.Name is empty. Nothing is added
+	if a row can not be parsed.
+*/
+func (spec *ModelSpec) AddRows(rows []Row, rowSpec RowSpec, table string) ([]int32, error) {
+	// Input Processing
+	vars := make([]VarSpec, len(rows))
+	for i, row := range rows {
+		v, err := rowSpec.varSpec(row)
+		if err != nil {
+			return nil, fmt.Errorf("row %v: %w", i, err)
+		}
+		if v.Name == "" {
+			v.Name = fmt.Sprintf("%v[%v]", table, i)
+		}
+		vars[i] = v
+	}
+
+	constrs := []ConstrSpec{}
+	offset := int32(len(spec.Vars))
+	for _, rc := range rowSpec.Constrs {
+		cs, err := rc.constrSpecs(rows, offset)
+		if err != nil {
+			return nil, fmt.Errorf("constraint %v: %w", rc.Name, err)
+		}
+		constrs = append(constrs, cs...)
+	}
+
+	// Algorithm
+	indices := make([]int32, len(vars))
+	for i, v := range vars {
+		indices[i] = spec.AddVar(v.Type, v.Obj, v.LB, v.UB, v.Name)
+	}
+	spec.Constrs = append(spec.Constrs, constrs...)
+
+	return indices, nil
+}
+
+// cell returns the value of the column, or "" if no column is given.
+func (row Row) cell(column string) (string, error) {
+	if column == "" {
+		return "", nil
+	}
+	value, ok := row[column]
+	if !ok {
+		return "", fmt.Errorf("missing column %v", column)
+	}
+	return value, nil
+}
+
+// varSpec parses the variable of a row.
+func (rowSpec RowSpec) varSpec(row Row) (VarSpec, error) {
+	v := VarSpec{Type: Continuous, UB: INFINITY}
+
+	name, err := row.cell(rowSpec.Name)
+	if err != nil {
+		return v, err
+	}
+	v.Name = name
+
+	s, err := row.cell(rowSpec.Type)
+	if err != nil {
+		return v, err
+	}
+	if s != "" {
+		vtype, err := ToVarType(int8(strings.ToUpper(s)[0]))
+		if err != nil || len(s) != 1 {
+			return v, fmt.Errorf("%v is not a variable type", s)
+		}
+		v.Type = vtype
+	}
+
+	for _, field := range []struct {
+		column string
+		value  *float64
+	}{
+		{rowSpec.LB, &v.LB},
+		{rowSpec.UB, &v.UB},
+		{rowSpec.Obj, &v.Obj},
+	} {
+		s, err := row.cell(field.column)
+		if err != nil {
+			return v, err
+		}
+		if s != "" {
+			value, err := parseMPSNumber(s)
+			if err != nil {
+				return v, fmt.Errorf("column %v: %w", field.column, err)
+			}
+			*field.value = value
+		}
+	}
+
+	return v, nil
+}
+
+// constrSpecs returns the constraints of rc over the rows, whose variables start at offset.
+func (rc RowConstr) constrSpecs(rows []Row, offset int32) ([]ConstrSpec, error) {
+	if err := rc.Sense.Check(); err != nil {
+		return nil, err
+	}
+	if err := checkFinite("the right-hand side", rc.RHS); err != nil {
+		return nil, err
+	}
+
+	// The constraint of each group, in the order in which the groups appear.
+	byGroup := map[string]*ConstrSpec{}
+	groups := []string{}
+	for i, row := range rows {
+		coef := 1.0
+		s, err := row.cell(rc.Coef)
+		if err != nil {
+			return nil, fmt.Errorf("row %v: %w", i, err)
+		}
+		if rc.Coef != "" {
+			coef = 0.0
+		}
+		if s != "" {
+			if coef, err = parseMPSNumber(s); err != nil {
+				return nil, fmt.Errorf("row %v: %w", i, err)
+			}
+		}
+
+		group, err := row.cell(rc.GroupBy)
+		if err != nil {
+			return nil, fmt.Errorf("row %v: %w", i, err)
+		}
+		cs, ok := byGroup[group]
+		if !ok {
+			cs = &ConstrSpec{Name: rc.Name, Ind: []int32{}, Val: []float64{}, Sense: rc.Sense, RHS: rc.RHS}
+			if rc.GroupBy != "" {
+				cs.Name = fmt.Sprintf("%v[%v]", rc.Name, group)
+			}
+			byGroup[group] = cs
+			groups = append(groups, group)
+		}
+
+		if coef != 0 {
+			cs.Ind = append(cs.Ind, offset+int32(i))
+			cs.Val = append(cs.Val, coef)
+		}
+	}
+
+	if rc.GroupBy == "" && len(groups) == 0 {
+		groups = append(groups, "")
+		byGroup[""] = &ConstrSpec{Name: rc.Name, Ind: []int32{}, Val: []float64{}, Sense: rc.Sense, RHS: rc.RHS}
+	}
+
+	constrs := make([]ConstrSpec, len(groups))
+	for k, group := range groups {
+		constrs[k] = *byGroup[group]
+	}
+	return constrs, nil
+}
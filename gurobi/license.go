@@ -0,0 +1,252 @@
+package gurobi
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+/*
+license.go
+Description:
+	Helpers for locating and inspecting the Gurobi license file (gurobi.lic),
+	including Web License Service (WLS) credentials, so that operators can be
+	warned before a license expires.
+Notes:
+	The search order for the license file is described on Gurobi's website at:
+	https://support.gurobi.com/hc/en-us/articles/360013417211
+*/
+
+// LicenseFileEnvVar is the environment variable Gurobi uses to override the location of gurobi.lic.
+const LicenseFileEnvVar = "GRB_LICENSE_FILE"
+
+// DefaultLicenseExpiryWarning is the window before expiration in which CheckExpiry reports a warning.
+const DefaultLicenseExpiryWarning = 30 * 24 * time.Hour
+
+/*
+LicenseInfo
+Description:
+
+	The information contained in a gurobi.lic file.
+	Fields contains every key of the file (secrets are redacted).
+*/
+type LicenseInfo struct {
+	Path        string
+	Type        string
+	Version     string
+	LicenseID   string
+	WLSAccessID string
+	Expiration  time.Time // The zero time if the license does not expire.
+	Fields      map[string]string
+}
+
+/*
+LicenseExpiryWarning
+Description:
+
+	Returned by CheckExpiry when the license will expire within the warning window.
+*/
+type LicenseExpiryWarning struct {
+	Expiration time.Time
+	Remaining  time.Duration
+}
+
+/*
+LicenseExpiredError
+Description:
+
+	Returned by CheckExpiry when the license has already expired.
+*/
+type LicenseExpiredError struct {
+	Expiration time.Time
+}
+
+func (w LicenseExpiryWarning) Error() string {
+	return fmt.Sprintf(
+		"the gurobi license expires on %v (in %.0f days)",
+		w.Expiration.Format("2006-01-02"),
+		w.Remaining.Hours()/24,
+	)
+}
+
+func (err LicenseExpiredError) Error() string {
+	return fmt.Sprintf("the gurobi license expired on %v", err.Expiration.Format("2006-01-02"))
+}
+
+/*
+LicenseSearchPaths
+Description:
+
+	Returns the locations where Gurobi looks for a license file, in order:
+	the GRB_LICENSE_FILE environment variable, the user's home directory and
+	the default installation directory of the operating system.
+*/
+func LicenseSearchPaths() []string {
+	paths := []string{}
+	if envPath := os.Getenv(LicenseFileEnvVar); envPath != "" {
+		paths = append(paths, envPath)
+	}
+
+	if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, filepath.Join(home, "gurobi.lic"))
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		paths = append(paths, "/Library/gurobi/gurobi.lic")
+	case "windows":
+		paths = append(paths, `C:\gurobi\gurobi.lic`)
+	default:
+		paths = append(paths, "/opt/gurobi/gurobi.lic")
+	}
+
+	return paths
+}
+
+/*
+FindLicenseFile
+Description:
+
+	Returns the first license file in LicenseSearchPaths() which exists.
+*/
+func FindLicenseFile() (string, error) {
+	searchPaths := LicenseSearchPaths()
+	for _, path := range searchPaths {
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, nil
+		}
+	}
+
+	return "", fmt.Errorf("no gurobi license file was found in any of %v", searchPaths)
+}
+
+/*
+ReadLicenseFile
+Description:
+
+	Opens and parses the license file at path.
+*/
+func ReadLicenseFile(path string) (LicenseInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return LicenseInfo{}, err
+	}
+	defer f.Close()
+
+	info, err := ParseLicense(f)
+	if err != nil {
+		return info, fmt.Errorf("there was an issue parsing the license file %v: %v", path, err)
+	}
+	info.Path = path
+
+	return info, nil
+}
+
+/*
+ParseLicense
+Description:
+
+	Parses the KEY=VALUE lines of a license file. Blank lines and
+	comments (lines starting with #) are ignored.
+*/
+func ParseLicense(r io.Reader) (LicenseInfo, error) {
+	info := LicenseInfo{Fields: map[string]string{}}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		key = strings.ToUpper(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "TYPE":
+			info.Type = value
+		case "VERSION":
+			info.Version = value
+		case "LICENSEID":
+			info.LicenseID = value
+		case "WLSACCESSID":
+			info.WLSAccessID = value
+		case "WLSSECRET":
+			value = "<redacted>"
+		case "EXPIRATION":
+			if value != "" && value != "0" {
+				expiration, err := time.Parse("2006-01-02", value)
+				if err != nil {
+					return info, fmt.Errorf("the expiration date %q could not be parsed: %v", value, err)
+				}
+				info.Expiration = expiration
+			}
+		}
+		info.Fields[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return info, err
+	}
+
+	if info.Type == "" && info.IsWLS() {
+		info.Type = "WLS"
+	}
+
+	return info, nil
+}
+
+/*
+IsWLS
+Description:
+
+	Returns true if the license contains Web License Service credentials.
+*/
+func (info LicenseInfo) IsWLS() bool {
+	return info.WLSAccessID != ""
+}
+
+/*
+Expires
+Description:
+
+	Returns true if the license has an expiration date.
+*/
+func (info LicenseInfo) Expires() bool {
+	return !info.Expiration.IsZero()
+}
+
+/*
+CheckExpiry
+Description:
+
+	Compares the license's expiration date with now. Returns a LicenseExpiredError
+	if the license has expired, a LicenseExpiryWarning if it expires within
+	warnWithin, and nil otherwise (or if the license never expires).
+*/
+func (info LicenseInfo) CheckExpiry(now time.Time, warnWithin time.Duration) error {
+	if !info.Expires() {
+		return nil
+	}
+
+	// A license is valid through the whole day of its expiration date.
+	end := info.Expiration.Add(24 * time.Hour)
+	remaining := end.Sub(now)
+	if remaining <= 0 {
+		return LicenseExpiredError{Expiration: info.Expiration}
+	}
+
+	if remaining <= warnWithin {
+		return LicenseExpiryWarning{Expiration: info.Expiration, Remaining: remaining}
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package gurobi
+
+import "fmt"
+
+/*
+listener.go
+Description:
+	Listeners which are notified of every change made to a model through
+	this package (added and removed variables and constraints, and changed
+	attributes), so that external mirrors (e.g., a UI, an audit log or a
+	remote replica) can track the evolution of the model.
+Notes:
+	The events are the ones recorded for PendingChanges (see dirty.go) and
+	share its limits: changes made through the C API directly, and changes
+	to dry-run models, are not reported. Events are delivered synchronously,
+	when the change is made, before the model is updated.
+*/
+
+// ModelEventKind is the kind of a change to a model.
+type ModelEventKind int
+
+const (
+	EventVarsAdded ModelEventKind = iota
+	EventConstrsAdded
+	EventGenConstrsAdded
+	EventSOSAdded
+	EventVarsRemoved
+	EventConstrsRemoved
+	EventGenConstrsRemoved
+	EventAttrChanged
+	EventElementAttrChanged
+)
+
+func (kind ModelEventKind) String() string {
+	switch kind {
+	case EventVarsAdded:
+		return "VarsAdded"
+	case EventConstrsAdded:
+		return "ConstrsAdded"
+	case EventGenConstrsAdded:
+		return "GenConstrsAdded"
+	case EventSOSAdded:
+		return "SOSAdded"
+	case EventVarsRemoved:
+		return "VarsRemoved"
+	case EventConstrsRemoved:
+		return "ConstrsRemoved"
+	case EventGenConstrsRemoved:
+		return "GenConstrsRemoved"
+	case EventAttrChanged:
+		return "AttrChanged"
+	case EventElementAttrChanged:
+		return "ElementAttrChanged"
+	default:
+		return fmt.Sprintf("ModelEventKind(%v)", int(kind))
+	}
+}
+
+/*
+ModelEvent
+Description:
+
+	A change to a model.
+	- Indices: The indices of the added or removed items (removed items
+	  are numbered as before the removal), or of the variables or
+	  constraints whose attribute Attr was set.
+	- Attr: The changed attribute (for EventAttrChanged and
+	  EventElementAttrChanged), e.g., "ModelSense", "LB" or "Q" for the
+	  quadratic objective.
+*/
+type ModelEvent struct {
+	Kind    ModelEventKind
+	Attr    string
+	Indices []int32
+}
+
+// ModelListener is notified of the changes made to a model.
+type ModelListener interface {
+	ModelChanged(event ModelEvent)
+}
+
+// ModelListenerFunc adapts a function to the ModelListener interface.
+type ModelListenerFunc func(event ModelEvent)
+
+func (f ModelListenerFunc) ModelChanged(event ModelEvent) {
+	f(event)
+}
+
+type listenerEntry struct {
+	id       int
+	listener ModelListener
+}
+
+/*
+AddListener
+Description:
+
+	Registers l to be notified of every later change to the model. Returns
+	a function which unregisters it. Listeners are notified in the order in
+	which they were added.
+*/
+func (model *Model) AddListener(l ModelListener) func() {
+	if l == nil {
+		return func() {}
+	}
+
+	model.nextListener++
+	id := model.nextListener
+	model.listeners = append(model.listeners, listenerEntry{id, l})
+	model.changes.notify = model.emit
+
+	return func() {
+		for i, entry := range model.listeners {
+			if entry.id == id {
+				model.listeners = append(model.listeners[:i:i], model.listeners[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// emit notifies the listeners of the model of event.
+func (model *Model) emit(event ModelEvent) {
+	for _, entry := range model.listeners {
+		entry.listener.ModelChanged(event)
+	}
+}
+
+// emitItems notifies the listeners that the n items starting at start were added (or removed).
+func (model *Model) emitItems(kind ModelEventKind, start int, n int) {
+	if len(model.listeners) == 0 || n == 0 {
+		return
+	}
+	ind := make([]int32, n)
+	for i := range ind {
+		ind[i] = int32(start + i)
+	}
+	model.emit(ModelEvent{Kind: kind, Indices: ind})
+}
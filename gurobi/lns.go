@@ -0,0 +1,271 @@
+package gurobi
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+/*
+lns.go
+Description:
+	A large neighborhood search (LNS) driver for improving the incumbent of
+	a MIP. Each iteration fixes a subset of the variables (the neighborhood's
+	complement) at their values in the incumbent, solves the restricted MIP
+	with a short time limit starting from the incumbent, and accepts the
+	result if it improves the objective. The bounds of the fixed variables
+	are restored after every iteration.
+*/
+
+/*
+NeighborhoodFunc
+Description:
+
+	Returns the variables to fix at their incumbent values in the given
+	iteration. rng is seeded by LNSConfig.Seed, so that runs can be repeated.
+*/
+type NeighborhoodFunc func(iteration int, incumbent []float64, rng *rand.Rand) []*Var
+
+/*
+LNSConfig
+Description:
+
+	Configures a large neighborhood search.
+	- Iterations: The number of restricted MIPs which are solved.
+	- SubTimeLimit: The time limit of each restricted MIP.
+	- FixFraction: The fraction of the integer variables which the default
+	  neighborhood fixes (chosen at random in each iteration).
+	- Neighborhood: Selects the variables to fix; the default is
+	  RandomNeighborhood(model, FixFraction).
+	- Seed: The seed of the random number generator passed to Neighborhood.
+*/
+type LNSConfig struct {
+	Iterations   int
+	SubTimeLimit time.Duration
+	FixFraction  float64
+	Neighborhood NeighborhoodFunc
+	Seed         int64
+}
+
+/*
+DefaultLNSConfig
+Description:
+
+	Returns a configuration with 20 iterations of 5 seconds which fix 70% of
+	the integer variables.
+*/
+func DefaultLNSConfig() LNSConfig {
+	return LNSConfig{
+		Iterations:   20,
+		SubTimeLimit: 5 * time.Second,
+		FixFraction:  0.7,
+	}
+}
+
+/*
+Check
+Description:
+
+	Checks that the configuration describes a search which can be run.
+*/
+func (config LNSConfig) Check() error {
+	if config.Iterations < 1 {
+		return fmt.Errorf("the number of LNS iterations must be at least 1; received %v", config.Iterations)
+	}
+	if config.SubTimeLimit <= 0 {
+		return fmt.Errorf("the time limit of the LNS subproblems must be positive; received %v", config.SubTimeLimit)
+	}
+	if config.Neighborhood == nil && (math.IsNaN(config.FixFraction) || config.FixFraction <= 0 || config.FixFraction >= 1) {
+		return fmt.Errorf("the fraction of fixed variables must be between 0 and 1 (exclusive); received %v", config.FixFraction)
+	}
+	return nil
+}
+
+/*
+LNSResult
+Description:
+
+	The outcome of a large neighborhood search. Objectives contains the
+	incumbent objective after each iteration.
+*/
+type LNSResult struct {
+	Objective    float64
+	Solution     []float64
+	Improvements int
+	Objectives   []float64
+}
+
+/*
+RandomNeighborhood
+Description:
+
+	Returns a NeighborhoodFunc which fixes a random fraction of the model's
+	integer (binary, integer, semi-integer) variables, or of all variables
+	if the model has no integer variables.
+*/
+func RandomNeighborhood(model *Model, fraction float64) NeighborhoodFunc {
+	return func(iteration int, incumbent []float64, rng *rand.Rand) []*Var {
+		candidates := []*Var{}
+		vtypes, err := model.getCharAttrArray("VType", 0, int32(len(incumbent)))
+		for i := range incumbent {
+			if err != nil || VarType(vtypes[i]) != Continuous {
+				candidates = append(candidates, &Var{model, int32(i)})
+			}
+		}
+		if len(candidates) == 0 {
+			for i := range incumbent {
+				candidates = append(candidates, &Var{model, int32(i)})
+			}
+		}
+
+		rng.Shuffle(len(candidates), func(a, b int) { candidates[a], candidates[b] = candidates[b], candidates[a] })
+		return candidates[:int(fraction*float64(len(candidates)))]
+	}
+}
+
+/*
+LNS
+Description:
+
+	Improves the model's current incumbent with a large neighborhood search.
+	The model must have a solution (e.g., from a previous Optimize). The best
+	solution found is loaded as the model's MIP start (Start) at the end.
+*/
+func (model *Model) LNS(config LNSConfig) (*LNSResult, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := config.Check(); err != nil {
+		return nil, err
+	}
+
+	solCount, err := model.optionalIntAttr(INT_ATTR_SOLCOUNT)
+	if err != nil {
+		return nil, err
+	}
+	if solCount == 0 {
+		return nil, fmt.Errorf("LNS needs an incumbent; optimize the model first")
+	}
+
+	numVars, err := model.NumVars()
+	if err != nil {
+		return nil, err
+	}
+	sense, err := model.GetModelSense()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LNSResult{}
+	if result.Solution, err = model.getDoubleAttrArray(DBL_ATTR_X, 0, numVars); err != nil {
+		return nil, err
+	}
+	if result.Objective, err = model.GetDoubleAttr(DBL_ATTR_OBJVAL); err != nil {
+		return nil, err
+	}
+	lbs, err := model.getDoubleAttrArray(DBL_ATTR_LB, 0, numVars)
+	if err != nil {
+		return nil, err
+	}
+	ubs, err := model.getDoubleAttrArray(DBL_ATTR_UB, 0, numVars)
+	if err != nil {
+		return nil, err
+	}
+	vtypes, err := model.getCharAttrArray("VType", 0, numVars)
+	if err != nil {
+		return nil, err
+	}
+
+	neighborhood := config.Neighborhood
+	if neighborhood == nil {
+		neighborhood = RandomNeighborhood(model, config.FixFraction)
+	}
+	rng := rand.New(rand.NewSource(config.Seed))
+
+	// Algorithm
+	for iteration := 0; iteration < config.Iterations; iteration++ {
+		fixed := neighborhood(iteration, result.Solution, rng)
+
+		ind := make([]int32, len(fixed))
+		values := make([]float64, len(fixed))
+		for k, v := range fixed {
+			if v == nil || v.Index < 0 || v.Index >= numVars {
+				return nil, fmt.Errorf("the neighborhood of iteration %v contains an invalid variable", iteration)
+			}
+			ind[k] = v.Index
+			values[k] = result.Solution[v.Index]
+			if VarType(vtypes[v.Index]) != Continuous {
+				values[k] = math.Round(values[k])
+			}
+		}
+
+		x, obj, err := model.solveNeighborhood(ind, values, result.Solution, config.SubTimeLimit)
+		if restoreErr := model.restoreBounds(ind, lbs, ubs); restoreErr != nil && err == nil {
+			err = restoreErr
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if x != nil && IsBetter(obj, result.Objective, sense) {
+			result.Solution, result.Objective = x, obj
+			result.Improvements++
+		}
+		result.Objectives = append(result.Objectives, result.Objective)
+	}
+
+	if err := model.setDoubleAttrArray(DBL_ATTR_START, 0, result.Solution); err != nil {
+		return nil, err
+	}
+	return result, model.Update()
+}
+
+// solveNeighborhood fixes the variables ind to values and solves the restricted
+// model from start. It returns nil if no solution was found.
+func (model *Model) solveNeighborhood(ind []int32, values []float64, start []float64, limit time.Duration) ([]float64, float64, error) {
+	if err := model.setDoubleAttrList(DBL_ATTR_LB, ind, values); err != nil {
+		return nil, 0, err
+	}
+	if err := model.setDoubleAttrList(DBL_ATTR_UB, ind, values); err != nil {
+		return nil, 0, err
+	}
+	if err := model.setDoubleAttrArray(DBL_ATTR_START, 0, start); err != nil {
+		return nil, 0, err
+	}
+
+	if err := model.withTimeLimit(limit, model.Optimize); err != nil {
+		return nil, 0, err
+	}
+
+	solCount, err := model.optionalIntAttr(INT_ATTR_SOLCOUNT)
+	if err != nil || solCount == 0 {
+		return nil, 0, err
+	}
+	obj, err := model.GetDoubleAttr(DBL_ATTR_OBJVAL)
+	if err != nil {
+		return nil, 0, err
+	}
+	x, err := model.getDoubleAttrArray(DBL_ATTR_X, 0, int32(len(start)))
+	if err != nil {
+		return nil, 0, err
+	}
+	return x, obj, nil
+}
+
+// restoreBounds sets the bounds of the variables ind back to lbs and ubs.
+func (model *Model) restoreBounds(ind []int32, lbs []float64, ubs []float64) error {
+	lb := make([]float64, len(ind))
+	ub := make([]float64, len(ind))
+	for k, i := range ind {
+		lb[k], ub[k] = lbs[i], ubs[i]
+	}
+
+	if err := model.setDoubleAttrList(DBL_ATTR_LB, ind, lb); err != nil {
+		return err
+	}
+	return model.setDoubleAttrList(DBL_ATTR_UB, ind, ub)
+}
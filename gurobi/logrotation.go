@@ -0,0 +1,174 @@
+package gurobi
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+/*
+logrotation.go
+Description:
+	Keeps the logs of long-running services manageable by switching the
+	LogFile parameter around every Optimize call: either each solve gets its
+	own timestamped log file, or a single log file is rotated once it grows
+	beyond a given size.
+Notes:
+	LogFile is reset to "" after every solve, so that Gurobi closes the file
+	and it can be moved or removed while the model is idle. Output to the
+	console (LogToConsole) is not affected.
+*/
+
+/*
+LogRotation
+Description:
+
+	Configures the log files of a model.
+	- Dir: The directory of the per-solve log files.
+	- Path: The log file which is rotated by size.
+	- MaxSize: The size (in bytes) from which Path is rotated before a solve.
+	- Keep: The number of rotated files (Path.1, Path.2, ...) which are kept;
+	  0 keeps all of them.
+	Exactly one of Dir and Path is set.
+*/
+type LogRotation struct {
+	Dir     string
+	Path    string
+	MaxSize int64
+	Keep    int
+
+	// LastLog is the log file of the most recent solve ("" if there was none).
+	LastLog string
+}
+
+/*
+EnablePerSolveLogs
+Description:
+
+	Makes every Optimize call write its log to a new file in dir, named
+	after the model and the time at which the solve started.
+*/
+func (model *Model) EnablePerSolveLogs(dir string) (*LogRotation, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if dir == "" {
+		return nil, fmt.Errorf("the log directory must not be empty")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	model.logRotation = &LogRotation{Dir: dir}
+	return model.logRotation, nil
+}
+
+/*
+EnableLogRotation
+Description:
+
+	Makes every Optimize call append its log to path, after rotating path to
+	path.1 (and path.1 to path.2, etc.) if it is at least maxSize bytes
+	long. At most keep rotated files are kept (all of them if keep is 0).
+*/
+func (model *Model) EnableLogRotation(path string, maxSize int64, keep int) (*LogRotation, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if path == "" {
+		return nil, fmt.Errorf("the log file must not be empty")
+	}
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("the maximum log size must be positive; received %v", maxSize)
+	}
+	if keep < 0 {
+		return nil, fmt.Errorf("the number of rotated logs to keep must be non-negative; received %v", keep)
+	}
+
+	model.logRotation = &LogRotation{Path: path, MaxSize: maxSize, Keep: keep}
+	return model.logRotation, nil
+}
+
+/*
+DisableLogRotation
+Description:
+
+	Turns off per-solve logs and log rotation. The LogFile parameter is left unchanged.
+*/
+func (model *Model) DisableLogRotation() {
+	if model != nil {
+		model.logRotation = nil
+	}
+}
+
+// openSolveLog points LogFile to the log of the next solve, rotating the log if needed.
+// It is called by the solve which holds the model (see beginSolve).
+func (model *Model) openSolveLog() error {
+	lr := model.logRotation
+	if lr == nil {
+		return nil
+	}
+
+	path := lr.Path
+	if lr.Dir != "" {
+		name, _ := model.stringAttr("ModelName")
+		if name == "" {
+			name = "model"
+		}
+		path = filepath.Join(
+			lr.Dir,
+			fmt.Sprintf("%v-%v.log", sanitizeFilename(name), time.Now().Format("20060102-150405.000000")),
+		)
+	} else if info, err := os.Stat(path); err == nil && info.Size() >= lr.MaxSize {
+		if err := rotateLog(path, lr.Keep); err != nil {
+			return err
+		}
+	}
+
+	if err := model.Env.SetStringParam("LogFile", path); err != nil {
+		return err
+	}
+	lr.LastLog = path
+	return nil
+}
+
+// closeSolveLog resets LogFile so that Gurobi closes the log of the last solve.
+func (model *Model) closeSolveLog() error {
+	if model.logRotation == nil {
+		return nil
+	}
+	return model.SetStringParam("LogFile", "")
+}
+
+// rotateLog renames path to path.1, path.1 to path.2 and so on, removing the
+// file which would become path.(keep+1) if keep is positive.
+func rotateLog(path string, keep int) error {
+	last := 1
+	for {
+		if _, err := os.Stat(fmt.Sprintf("%v.%v", path, last)); err != nil {
+			break
+		}
+		last++
+	}
+
+	if keep > 0 && last > keep {
+		for i := keep; i < last; i++ {
+			if err := os.Remove(fmt.Sprintf("%v.%v", path, i)); err != nil {
+				return err
+			}
+		}
+		last = keep
+	}
+
+	for i := last - 1; i >= 1; i-- {
+		if err := os.Rename(fmt.Sprintf("%v.%v", path, i), fmt.Sprintf("%v.%v", path, i+1)); err != nil {
+			return err
+		}
+	}
+	return os.Rename(path, path+".1")
+}
@@ -0,0 +1,604 @@
+package gurobi
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"unicode"
+)
+
+/*
+lpformat.go
+Description:
+	A pure-Go reader for the LP format, which fills a ModelSpec without a
+	Gurobi environment.
+Notes:
+	Sections which a ModelSpec cannot represent (SOS, lazy constraints, user
+	cuts, general constraints), quadratic constraints and indicator
+	constraints are rejected with an error, as are multiple objectives.
+*/
+
+/*
+ReadModelSpecLP
+Description:
+
+	Reads a model in the LP format from r and validates it.
+	Variables are numbered in the order in which they first appear and
+	default to the bounds [0, INFINITY]. The bounds of binary variables are
+	clipped to [0, 1].
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/lp_format.html
+*/
+func ReadModelSpecLP(r io.Reader) (*ModelSpec, error) {
+	reader := &lpReader{spec: NewModelSpec(""), cols: map[string]int32{}}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		if k := strings.IndexByte(line, '\\'); k >= 0 {
+			line = line[:k]
+		}
+
+		if section, rest, ok := lpSectionHeader(line); ok {
+			if err := reader.parse(); err != nil {
+				return nil, err
+			}
+			if section == lpUnsupported {
+				return nil, fmt.Errorf("LP line %v: the section %q is not supported by a ModelSpec", lineNumber, strings.TrimSpace(line))
+			}
+			reader.section = section
+			line = rest
+		}
+		if reader.section == lpEnd {
+			break
+		}
+
+		tokens, err := tokenizeLP(line, lineNumber)
+		if err != nil {
+			return nil, err
+		}
+		if len(tokens) > 0 && reader.section == lpNone {
+			return nil, fmt.Errorf("LP line %v: expected an objective section", lineNumber)
+		}
+		reader.tokens = append(reader.tokens, tokens...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := reader.parse(); err != nil {
+		return nil, err
+	}
+
+	if err := reader.spec.Check(); err != nil {
+		return nil, err
+	}
+	return reader.spec, nil
+}
+
+// lpSection is a section of an LP file.
+type lpSection int
+
+const (
+	lpNone lpSection = iota
+	lpMinimize
+	lpMaximize
+	lpConstraints
+	lpBounds
+	lpBinaries
+	lpGenerals
+	lpSemis
+	lpEnd
+	lpUnsupported
+)
+
+// lpHeaders are the keywords which start each section. Longer keywords come
+// before their prefixes, so that e.g. "general constraints" is not read as "general".
+var lpHeaders = []struct {
+	keyword string
+	section lpSection
+}{
+	{"minimize multi-objectives", lpUnsupported},
+	{"maximize multi-objectives", lpUnsupported},
+	{"minimize", lpMinimize}, {"minimum", lpMinimize}, {"min", lpMinimize},
+	{"maximize", lpMaximize}, {"maximum", lpMaximize}, {"max", lpMaximize},
+	{"subject to", lpConstraints}, {"such that", lpConstraints}, {"s.t.", lpConstraints}, {"st.", lpConstraints}, {"st", lpConstraints},
+	{"bounds", lpBounds}, {"bound", lpBounds},
+	{"binaries", lpBinaries}, {"binary", lpBinaries}, {"bin", lpBinaries},
+	{"general constraints", lpUnsupported}, {"genconstrs", lpUnsupported},
+	{"generals", lpGenerals}, {"general", lpGenerals}, {"gen", lpGenerals},
+	{"semi-continuous", lpSemis}, {"semis", lpSemis}, {"semi", lpSemis},
+	{"lazy constraints", lpUnsupported}, {"user cuts", lpUnsupported}, {"sos", lpUnsupported}, {"pwlobj", lpUnsupported},
+	{"end", lpEnd},
+}
+
+// lpSectionHeader returns the section started by line, if any, and the rest of the line.
+func lpSectionHeader(line string) (lpSection, string, bool) {
+	trimmed := strings.TrimLeftFunc(line, unicode.IsSpace)
+	lower := strings.ToLower(strings.Join(strings.Fields(trimmed), " "))
+	for _, header := range lpHeaders {
+		if !strings.HasPrefix(lower, header.keyword) {
+			continue
+		}
+		if len(lower) > len(header.keyword) && lower[len(header.keyword)] != ' ' {
+			continue
+		}
+		// Skip the words of the keyword in the original line.
+		rest := trimmed
+		for range strings.Fields(header.keyword) {
+			rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+			rest = strings.TrimLeftFunc(rest, func(c rune) bool { return !unicode.IsSpace(c) })
+		}
+		return header.section, rest, true
+	}
+	return lpNone, "", false
+}
+
+// lpToken is a number, a name or an operator of an LP file.
+type lpToken struct {
+	text   string
+	number bool
+	value  float64
+	line   int
+}
+
+// lpOperators are the characters which end a name.
+const lpOperators = "+-*^:[]<>="
+
+// tokenizeLP splits a line of an LP file into tokens.
+func tokenizeLP(line string, lineNumber int) ([]lpToken, error) {
+	var tokens []lpToken
+	for k := 0; k < len(line); {
+		c := line[k]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r':
+			k++
+		case c >= '0' && c <= '9' || c == '.':
+			start := k
+			for k < len(line) && (line[k] >= '0' && line[k] <= '9' || line[k] == '.') {
+				k++
+			}
+			if k+1 < len(line) && (line[k] == 'e' || line[k] == 'E') {
+				exp := k + 1
+				if line[exp] == '+' || line[exp] == '-' {
+					exp++
+				}
+				if exp < len(line) && line[exp] >= '0' && line[exp] <= '9' {
+					for k = exp; k < len(line) && line[k] >= '0' && line[k] <= '9'; k++ {
+					}
+				}
+			}
+			value, err := parseMPSNumber(line[start:k])
+			if err != nil {
+				return nil, fmt.Errorf("LP line %v: %w", lineNumber, err)
+			}
+			tokens = append(tokens, lpToken{text: line[start:k], number: true, value: value, line: lineNumber})
+		case c == '-' && k+1 < len(line) && line[k+1] == '>':
+			tokens = append(tokens, lpToken{text: "->", line: lineNumber})
+			k += 2
+		case c == '<' || c == '>' || c == '=':
+			// <, <=, =< and >, >=, => are all read as <= and >=.
+			var next byte
+			if k++; k < len(line) {
+				next = line[k]
+			}
+			text := "="
+			switch {
+			case c == '<' || (c == '=' && next == '<'):
+				text = "<="
+			case c == '>' || (c == '=' && next == '>'):
+				text = ">="
+			}
+			if next == '=' || (c == '=' && (next == '<' || next == '>')) {
+				k++
+			}
+			tokens = append(tokens, lpToken{text: text, line: lineNumber})
+		case strings.IndexByte(lpOperators, c) >= 0:
+			tokens = append(tokens, lpToken{text: string(c), line: lineNumber})
+			k++
+		case c == '/' && len(tokens) > 0 && tokens[len(tokens)-1].text == "]":
+			tokens = append(tokens, lpToken{text: "/", line: lineNumber})
+			k++
+		default:
+			start := k
+			for k < len(line) && line[k] != ' ' && line[k] != '\t' && line[k] != '\r' && strings.IndexByte(lpOperators, line[k]) < 0 {
+				k++
+			}
+			tokens = append(tokens, lpToken{text: line[start:k], line: lineNumber})
+		}
+	}
+	return tokens, nil
+}
+
+// lpReader holds the state of ReadModelSpecLP. The tokens of a section are
+// collected until the next section starts, as statements may span lines.
+type lpReader struct {
+	spec      *ModelSpec
+	cols      map[string]int32
+	section   lpSection
+	tokens    []lpToken
+	pos       int
+	objective bool
+}
+
+// parse parses the tokens of the current section.
+func (reader *lpReader) parse() error {
+	defer func() { reader.tokens, reader.pos = nil, 0 }()
+
+	switch reader.section {
+	case lpMinimize, lpMaximize:
+		if reader.objective {
+			return reader.errorf("the model has more than one objective")
+		}
+		reader.objective = true
+		reader.spec.Sense = Minimize
+		if reader.section == lpMaximize {
+			reader.spec.Sense = Maximize
+		}
+		return reader.parseObjective()
+	case lpConstraints:
+		for reader.more() {
+			if err := reader.parseConstraint(); err != nil {
+				return err
+			}
+		}
+	case lpBounds:
+		for reader.more() {
+			if err := reader.parseBound(); err != nil {
+				return err
+			}
+		}
+	case lpBinaries, lpGenerals, lpSemis:
+		for reader.more() {
+			token := reader.next()
+			if token.number || strings.IndexByte(lpOperators, token.text[0]) >= 0 {
+				return reader.errorf("expected a variable name; received %q", token.text)
+			}
+			v := &reader.spec.Vars[reader.col(token.text)]
+			switch reader.section {
+			case lpBinaries:
+				v.Type, v.LB, v.UB = Binary, math.Max(v.LB, 0.0), math.Min(v.UB, 1.0)
+			case lpGenerals:
+				v.Type = Integer
+			case lpSemis:
+				v.Type = SemiCont
+			}
+		}
+	}
+	return nil
+}
+
+func (reader *lpReader) more() bool {
+	return reader.pos < len(reader.tokens)
+}
+
+func (reader *lpReader) peek() lpToken {
+	if !reader.more() {
+		return lpToken{}
+	}
+	return reader.tokens[reader.pos]
+}
+
+func (reader *lpReader) next() lpToken {
+	token := reader.peek()
+	reader.pos++
+	return token
+}
+
+func (reader *lpReader) errorf(format string, args ...interface{}) error {
+	line := 0
+	if reader.pos > 0 && reader.pos <= len(reader.tokens) {
+		line = reader.tokens[reader.pos-1].line
+	} else if reader.more() {
+		line = reader.peek().line
+	}
+	return fmt.Errorf("LP line %v: %v", line, fmt.Sprintf(format, args...))
+}
+
+// col returns the index of the variable with the given name, adding it if it is new.
+func (reader *lpReader) col(name string) int32 {
+	j, ok := reader.cols[name]
+	if !ok {
+		j = reader.spec.AddVar(Continuous, 0.0, 0.0, INFINITY, name)
+		reader.cols[name] = j
+	}
+	return j
+}
+
+// isName returns true if token is a name (as opposed to a number, an operator or infinity).
+func isName(token lpToken) bool {
+	return token.text != "" && !token.number && strings.IndexByte(lpOperators, token.text[0]) < 0 &&
+		token.text != "->" && token.text != "/" && !isInfinity(token.text)
+}
+
+func isInfinity(text string) bool {
+	switch strings.ToLower(text) {
+	case "inf", "infinity", "infty":
+		return true
+	}
+	return false
+}
+
+// skipLabel skips the "name:" in front of an objective or constraint and returns the name.
+func (reader *lpReader) skipLabel() string {
+	if reader.pos+1 < len(reader.tokens) && isName(reader.tokens[reader.pos]) && reader.tokens[reader.pos+1].text == ":" {
+		name := reader.tokens[reader.pos].text
+		reader.pos += 2
+		return name
+	}
+	return ""
+}
+
+// parseSigns reads a sequence of + and - signs and returns the resulting sign.
+func (reader *lpReader) parseSigns() (float64, bool) {
+	sign, found := 1.0, false
+	for reader.peek().text == "+" || reader.peek().text == "-" {
+		if reader.next().text == "-" {
+			sign = -sign
+		}
+		found = true
+	}
+	return sign, found
+}
+
+// parseNumber reads a signed number, which may be infinite.
+func (reader *lpReader) parseNumber() (float64, error) {
+	sign, _ := reader.parseSigns()
+	token := reader.next()
+	switch {
+	case token.number:
+		return sign * token.value, nil
+	case isInfinity(token.text):
+		return sign * INFINITY, nil
+	}
+	return 0, reader.errorf("expected a number; received %q", token.text)
+}
+
+// lpExpr is a linear expression with its constant.
+type lpExpr struct {
+	ind      []int32
+	val      []float64
+	constant float64
+	pos      map[int32]int
+}
+
+func (expr *lpExpr) add(j int32, coef float64) {
+	if expr.pos == nil {
+		expr.pos = map[int32]int{}
+	}
+	if k, ok := expr.pos[j]; ok {
+		expr.val[k] += coef
+		return
+	}
+	expr.pos[j] = len(expr.ind)
+	expr.ind = append(expr.ind, j)
+	expr.val = append(expr.val, coef)
+}
+
+// parseExpr reads a linear expression until a comparison or the end of the
+// section. Quadratic terms in brackets are only allowed in the objective.
+func (reader *lpReader) parseExpr(objective bool) (*lpExpr, error) {
+	expr := &lpExpr{}
+	first := true
+	for reader.more() {
+		switch reader.peek().text {
+		case "<=", ">=", "=":
+			return expr, nil
+		case "->":
+			return nil, reader.errorf("indicator constraints are not supported by a ModelSpec")
+		}
+
+		sign, hasSign := reader.parseSigns()
+		if !first && !hasSign {
+			// The statement ended without a comparison (e.g., the next constraint starts).
+			return expr, nil
+		}
+		first = false
+
+		if reader.peek().text == "[" {
+			if !objective {
+				return nil, reader.errorf("quadratic constraints are not supported by a ModelSpec")
+			}
+			if err := reader.parseQuadratic(sign); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		coef, hasCoef := sign, false
+		if token := reader.peek(); token.number {
+			coef *= token.value
+			reader.next()
+			hasCoef = true
+		}
+		if isName(reader.peek()) {
+			expr.add(reader.col(reader.next().text), coef)
+		} else if hasCoef {
+			expr.constant += coef
+		} else {
+			return nil, reader.errorf("expected a term; received %q", reader.peek().text)
+		}
+	}
+	return expr, nil
+}
+
+// parseQuadratic reads "[ ... ]" with an optional "/ 2" and adds its terms to the objective.
+func (reader *lpReader) parseQuadratic(sign float64) error {
+	reader.next()
+	var terms []QTermSpec
+	for reader.peek().text != "]" {
+		if !reader.more() {
+			return reader.errorf("the quadratic expression is not closed")
+		}
+		termSign, _ := reader.parseSigns()
+		coef := sign * termSign
+		if token := reader.peek(); token.number {
+			coef *= token.value
+			reader.next()
+		}
+		if !isName(reader.peek()) {
+			return reader.errorf("expected a variable name; received %q", reader.peek().text)
+		}
+		row := reader.col(reader.next().text)
+		col := row
+		switch reader.next().text {
+		case "^":
+			if token := reader.next(); !token.number || token.value != 2 {
+				return reader.errorf("only squares are supported; received ^ %v", token.text)
+			}
+		case "*":
+			if !isName(reader.peek()) {
+				return reader.errorf("expected a variable name; received %q", reader.peek().text)
+			}
+			col = reader.col(reader.next().text)
+		default:
+			return reader.errorf("expected ^ or * in a quadratic term")
+		}
+		terms = append(terms, QTermSpec{Row: row, Col: col, Val: coef})
+	}
+	reader.next()
+
+	divisor := 1.0
+	if reader.peek().text == "/" {
+		reader.next()
+		token := reader.next()
+		if !token.number || token.value == 0 {
+			return reader.errorf("expected a nonzero divisor; received %q", token.text)
+		}
+		divisor = token.value
+	}
+	for _, term := range terms {
+		reader.spec.AddQTerm(term.Row, term.Col, term.Val/divisor)
+	}
+	return nil
+}
+
+func (reader *lpReader) parseObjective() error {
+	reader.skipLabel()
+	expr, err := reader.parseExpr(true)
+	if err != nil {
+		return err
+	}
+	if reader.more() {
+		return reader.errorf("unexpected %q in the objective", reader.peek().text)
+	}
+
+	for k, j := range expr.ind {
+		reader.spec.Vars[j].Obj += expr.val[k]
+	}
+	reader.spec.ObjCon += expr.constant
+	return nil
+}
+
+func (reader *lpReader) parseConstraint() error {
+	name := reader.skipLabel()
+	if name == "" {
+		name = fmt.Sprintf("R%v", len(reader.spec.Constrs))
+	}
+
+	expr, err := reader.parseExpr(false)
+	if err != nil {
+		return err
+	}
+
+	var sense Sense
+	switch reader.next().text {
+	case "<=":
+		sense = Le
+	case ">=":
+		sense = Ge
+	case "=":
+		sense = Eq
+	default:
+		return reader.errorf("the constraint %v has no comparison", name)
+	}
+
+	rhs, err := reader.parseNumber()
+	if err != nil {
+		return err
+	}
+	if reader.peek().text == "->" {
+		return reader.errorf("indicator constraints are not supported by a ModelSpec")
+	}
+
+	reader.spec.AddConstr(expr.ind, expr.val, sense, rhs-expr.constant, name)
+	return nil
+}
+
+// parseBound reads one of "x free", "x (op) v", "v (op) x" and "l (op) x (op) u".
+func (reader *lpReader) parseBound() error {
+	if isName(reader.peek()) {
+		j := reader.col(reader.next().text)
+		v := &reader.spec.Vars[j]
+		if strings.EqualFold(reader.peek().text, "free") {
+			reader.next()
+			v.LB, v.UB = -INFINITY, INFINITY
+			return nil
+		}
+		op := reader.next().text
+		value, err := reader.parseNumber()
+		if err != nil {
+			return err
+		}
+		if err := setLPBound(v, op, value, false); err != nil {
+			return reader.errorf("%v", err)
+		}
+		return nil
+	}
+
+	value, err := reader.parseNumber()
+	if err != nil {
+		return err
+	}
+	op := reader.next().text
+	if !isName(reader.peek()) {
+		return reader.errorf("expected a variable name; received %q", reader.peek().text)
+	}
+	v := &reader.spec.Vars[reader.col(reader.next().text)]
+	if err := setLPBound(v, op, value, true); err != nil {
+		return reader.errorf("%v", err)
+	}
+
+	switch reader.peek().text {
+	case "<=", ">=", "=":
+		op := reader.next().text
+		value, err := reader.parseNumber()
+		if err != nil {
+			return err
+		}
+		if err := setLPBound(v, op, value, false); err != nil {
+			return reader.errorf("%v", err)
+		}
+	}
+	return nil
+}
+
+// setLPBound applies "x (op) value", or "value (op) x" if flipped, to the bounds of v.
+func setLPBound(v *VarSpec, op string, value float64, flipped bool) error {
+	if flipped {
+		switch op {
+		case "<=":
+			op = ">="
+		case ">=":
+			op = "<="
+		}
+	}
+
+	switch op {
+	case "<=":
+		v.UB = value
+	case ">=":
+		v.LB = value
+	case "=":
+		v.LB, v.UB = value, value
+	default:
+		return fmt.Errorf("expected a comparison in the bound of %v; received %q", v.Name, op)
+	}
+	return nil
+}
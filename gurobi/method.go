@@ -0,0 +1,177 @@
+package gurobi
+
+import "fmt"
+
+/*
+method.go
+Description:
+	Typed values for the Method parameter (the algorithm used to solve
+	continuous models and the root relaxation of MIPs) and the Crossover
+	parameter (how the barrier solution is converted into a basic solution).
+Notes:
+	https://www.gurobi.com/documentation/current/refman/method.html
+	https://www.gurobi.com/documentation/current/refman/crossover.html
+*/
+
+// Method is the algorithm used to solve a continuous model (the Method parameter).
+type Method int
+
+const (
+	MethodAuto                 Method = -1
+	MethodPrimalSimplex        Method = 0
+	MethodDualSimplex          Method = 1
+	MethodBarrier              Method = 2
+	MethodConcurrent           Method = 3
+	MethodDetConcurrent        Method = 4
+	MethodDetConcurrentSimplex Method = 5
+)
+
+/*
+Check
+Description:
+
+	Checks that the Method is one of the algorithms that Gurobi recognizes.
+*/
+func (m Method) Check() error {
+	if m < MethodAuto || m > MethodDetConcurrentSimplex {
+		return fmt.Errorf("the method %v is not valid; expected a value between %v and %v", int(m), int(MethodAuto), int(MethodDetConcurrentSimplex))
+	}
+	return nil
+}
+
+/*
+String
+Description:
+
+	Returns the name of the algorithm.
+*/
+func (m Method) String() string {
+	switch m {
+	case MethodAuto:
+		return "Auto"
+	case MethodPrimalSimplex:
+		return "PrimalSimplex"
+	case MethodDualSimplex:
+		return "DualSimplex"
+	case MethodBarrier:
+		return "Barrier"
+	case MethodConcurrent:
+		return "Concurrent"
+	case MethodDetConcurrent:
+		return "DetConcurrent"
+	case MethodDetConcurrentSimplex:
+		return "DetConcurrentSimplex"
+	default:
+		return fmt.Sprintf("Method(%v)", int(m))
+	}
+}
+
+// Crossover is the strategy used to transform the barrier solution into a basic solution
+// (the Crossover parameter).
+type Crossover int
+
+const (
+	CrossoverAuto Crossover = -1
+	CrossoverOff  Crossover = 0
+	// Push dual variables first, then primal variables; finish with primal simplex.
+	CrossoverDualPrimalPrimal Crossover = 1
+	// Push dual variables first, then primal variables; finish with dual simplex.
+	CrossoverDualPrimalDual Crossover = 2
+	// Push primal variables first, then dual variables; finish with primal simplex.
+	CrossoverPrimalDualPrimal Crossover = 3
+	// Push primal variables first, then dual variables; finish with dual simplex.
+	CrossoverPrimalDualDual Crossover = 4
+)
+
+/*
+Check
+Description:
+
+	Checks that the Crossover strategy is one that Gurobi recognizes.
+*/
+func (c Crossover) Check() error {
+	if c < CrossoverAuto || c > CrossoverPrimalDualDual {
+		return fmt.Errorf("the crossover strategy %v is not valid; expected a value between %v and %v", int(c), int(CrossoverAuto), int(CrossoverPrimalDualDual))
+	}
+	return nil
+}
+
+/*
+SetMethod
+Description:
+
+	Sets the algorithm used to solve continuous models (the Method parameter).
+*/
+func (env *Env) SetMethod(method Method) error {
+	if err := method.Check(); err != nil {
+		return err
+	}
+	return env.SetIntParam("Method", int(method))
+}
+
+/*
+GetMethod
+Description:
+
+	Returns the algorithm used to solve continuous models (the Method parameter).
+*/
+func (env *Env) GetMethod() (Method, error) {
+	method, err := env.GetIntParam("Method")
+	return Method(method), err
+}
+
+/*
+SetCrossover
+Description:
+
+	Sets the crossover strategy used after barrier (the Crossover parameter).
+	Use CrossoverOff to skip crossover and return the interior solution.
+*/
+func (env *Env) SetCrossover(crossover Crossover) error {
+	if err := crossover.Check(); err != nil {
+		return err
+	}
+	return env.SetIntParam("Crossover", int(crossover))
+}
+
+/*
+SetMethod
+Description:
+
+	Sets the algorithm used to solve this model (the Method parameter).
+*/
+func (model *Model) SetMethod(method Method) error {
+	env, err := model.ModelEnv()
+	if err != nil {
+		return err
+	}
+	return env.SetMethod(method)
+}
+
+/*
+GetMethod
+Description:
+
+	Returns the algorithm used to solve this model (the Method parameter).
+*/
+func (model *Model) GetMethod() (Method, error) {
+	env, err := model.ModelEnv()
+	if err != nil {
+		return MethodAuto, err
+	}
+	return env.GetMethod()
+}
+
+/*
+SetCrossover
+Description:
+
+	Sets the crossover strategy used after barrier for this model (the Crossover parameter).
+*/
+func (model *Model) SetCrossover(crossover Crossover) error {
+	env, err := model.ModelEnv()
+	if err != nil {
+		return err
+	}
+	return env.SetCrossover(crossover)
+}
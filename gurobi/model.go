@@ -5,6 +5,8 @@ import "C"
 import (
 	"errors"
 	"fmt"
+	"runtime/cgo"
+	"sync/atomic"
 )
 
 // Model ...
@@ -14,15 +16,58 @@ type Model struct {
 	Env         Env
 	Variables   []Var
 	Constraints []Constr
+	GenConstrs  []GenConstr
+	SOSs        []SOS
+
+	autoDump    *AutoDumpConfig
+	logRotation *LogRotation
+	dryRun      *DryRun
+	changes     changeLog
+	warm        *warmStart
+	tags        map[string]*tagSet
+
+	deterministic bool
+	namer         *Namer
+	softConstrs   []*SoftConstr
+	listeners     []listenerEntry
+	nextListener  int
+
+	priorRuntime float64
+	paused       bool
+
+	varArena    varArena
+	constrArena constrArena
+	// vars and constrs hold the arena handles behind Variables and Constraints.
+	vars    []*Var
+	constrs []*Constr
+	// scratchInd is reused by the attribute getters which write into a buffer.
+	scratchInd []int32
+	// attrCache is nil unless it was enabled with SetAttrCache.
+	attrCache *attrCache
+	// names is nil until the name index is built (see nameindex.go).
+	names *nameIndex
+
+	callbackHandle cgo.Handle
+	inCallback     int32
+	solving        int32
+
+	// master is the environment the model was created in (see registry.go).
+	master *C.GRBenv
+	// freed is set (atomically) to 1 when the model is freed.
+	freed int32
 }
 
 /*
 MakeUninitializedError
 Description:
 
-	This function simply returns a fixed error for when the model is not initialized.
+	This function simply returns a fixed error for when the model is not initialized,
+	or ErrFreed if the model was already freed.
 */
 func (model *Model) MakeUninitializedError() error {
+	if model != nil && model.isFreed() {
+		return ErrFreed
+	}
 	return fmt.Errorf("The gurobi model was not yet initialized!")
 }
 
@@ -30,14 +75,35 @@ func (model *Model) MakeUninitializedError() error {
 Check
 Description:
 
-	Checks that the model has been properly created.
+	Checks that the model has been properly created, has not been freed and
+	can be queried right now (i.e., it is not being solved).
 */
 func (model *Model) Check() error {
+	return model.checkFor("Check")
+}
+
+// checkFor is Check for the method op, which is named in the error if the
+// model is in a callback or being solved. Every method which calls into the
+// C API runs it first.
+func (model *Model) checkFor(op string) error {
 	// Check to see if pointer is nil
 	if model == nil {
 		return model.MakeUninitializedError()
 	}
 
+	if model.isFreed() {
+		return ErrFreed
+	}
+
+	if err := model.checkNotInCallback(op); err != nil {
+		return err
+	}
+
+	// Dry-run models never touch the C API, so they do not need an environment.
+	if model.dryRun != nil {
+		return nil
+	}
+
 	// Check on env component
 	err := model.Env.Check()
 	if err != nil {
@@ -57,11 +123,16 @@ Description:
 func NewModel(modelname string, env *Env) (*Model, error) {
 	err := env.Check()
 	if err != nil {
-		return nil, env.MakeUninitializedError()
+		return nil, err
 	}
 
 	var model *C.GRBmodel
-	errcode := C.GRBnewmodel(env.env, &model, C.CString(modelname), 0, nil, nil, nil, nil, nil)
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBnewmodel", modelname)
+	errcode := C.GRBnewmodel(env.env, &model, args.str(modelname), 0, nil, nil, nil, nil, nil)
+	done(errcode)
 	if errcode != 0 {
 		return nil, env.MakeError(errcode)
 	}
@@ -71,7 +142,9 @@ func NewModel(modelname string, env *Env) (*Model, error) {
 		return nil, errors.New("Failed retrieve the environment")
 	}
 
-	return &Model{AsGRBModel: model, Env: Env{newenv}}, nil
+	out := &Model{AsGRBModel: model, Env: Env{env: newenv}}
+	registry.addModel(env.env, out)
+	return out, nil
 }
 
 /*
@@ -83,11 +156,16 @@ Description:
 func LoadModel(modelPath string, env *Env) (*Model, error) {
 	err := env.Check()
 	if err != nil {
-		return nil, env.MakeUninitializedError()
+		return nil, err
 	}
 
 	var model *C.GRBmodel
-	errcode := C.GRBreadmodel(env.env, C.CString(modelPath), &model)
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBreadmodel", modelPath)
+	errcode := C.GRBreadmodel(env.env, args.str(modelPath), &model)
+	done(errcode)
 	if errcode != 0 {
 		return nil, env.MakeError(errcode)
 	}
@@ -97,16 +175,151 @@ func LoadModel(modelPath string, env *Env) (*Model, error) {
 		return nil, errors.New("Failed retrieve the environment")
 	}
 
-	return &Model{AsGRBModel: model, Env: Env{newenv}}, nil
+	out := &Model{AsGRBModel: model, Env: Env{env: newenv}}
+	registry.addModel(env.env, out)
+	return out, nil
 }
 
 // Free ...
-// free the model
+// free the model. Freeing a model whose environment was already freed
+// (which frees the model as well) or which was already freed does nothing.
+// Afterwards, Check returns ErrFreed.
 func (model *Model) Free() {
-	if model == nil {
+	if model == nil || model.isFreed() {
 		return
 	}
-	C.GRBfreemodel(model.AsGRBModel)
+	if model.dryRun != nil {
+		model.free()
+		return
+	}
+	registry.freeModel(model)
+}
+
+// free frees the model without unregistering it. The copy of the
+// environment in model.Env is freed along with the model.
+func (model *Model) free() {
+	atomic.StoreInt32(&model.freed, 1)
+	atomic.StoreInt32(&model.Env.freed, 1)
+	if model.dryRun == nil {
+		C.GRBfreemodel(model.AsGRBModel)
+	}
+	model.releaseCallback()
+	model.AsGRBModel, model.Env.env = nil, nil
+}
+
+// isFreed returns true once the model was freed.
+func (model *Model) isFreed() bool {
+	return atomic.LoadInt32(&model.freed) != 0
+}
+
+/*
+Copy
+Description:
+
+	Creates an independent copy of the model (with GRBcopymodel). The copy must
+	be freed separately. Callbacks are not copied.
+*/
+func (model *Model) Copy() (*Model, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if model.dryRun != nil {
+		return nil, fmt.Errorf("cannot copy: %w", ErrDryRun)
+	}
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	copied := C.GRBcopymodel(model.AsGRBModel)
+	if copied == nil {
+		return nil, errors.New("Failed to copy the model")
+	}
+
+	return model.wrapCopy(copied, model.master)
+}
+
+/*
+CopyToEnv
+Description:
+
+	Creates an independent copy of the model in another environment (with
+	GRBcopymodeltoenv), e.g., so that the copy can be optimized in another
+	goroutine than the original. The copy must be freed separately.
+	Callbacks are not copied.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_copymodeltoenv.html
+*/
+func (model *Model) CopyToEnv(env *Env) (*Model, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := env.Check(); err != nil {
+		return nil, err
+	}
+
+	if model.dryRun != nil {
+		return nil, fmt.Errorf("cannot copy: %w", ErrDryRun)
+	}
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	copied := C.GRBcopymodeltoenv(model.AsGRBModel, env.env)
+	if copied == nil {
+		return nil, errors.New("Failed to copy the model")
+	}
+
+	return model.wrapCopy(copied, env.env)
+}
+
+// wrapCopy creates the Model of copied, a copy of model in the master
+// environment master, with the same elements, tags and settings.
+func (model *Model) wrapCopy(copied *C.GRBmodel, master *C.GRBenv) (*Model, error) {
+	newenv := C.GRBgetenv(copied)
+	if newenv == nil {
+		C.GRBfreemodel(copied)
+		return nil, errors.New("Failed retrieve the environment")
+	}
+
+	out := &Model{AsGRBModel: copied, Env: Env{env: newenv}}
+	out.appendVars(len(model.Variables), nil)
+	out.appendConstrs(len(model.Constraints), nil)
+	for _, gc := range model.GenConstrs {
+		out.GenConstrs = append(out.GenConstrs, GenConstr{out, gc.Index})
+	}
+	for _, sos := range model.SOSs {
+		out.SOSs = append(out.SOSs, SOS{out, sos.Index})
+	}
+	out.tags = copyTags(model.tags)
+	out.softConstrs = copySoftConstrs(model.softConstrs, out)
+	out.deterministic = model.deterministic
+	registry.addModel(master, out)
+
+	return out, nil
+}
+
+/*
+AddVarInt8
+Description:
+
+	The AddVar of earlier versions, which takes the type of the variable as
+	a raw int8 (e.g., a typed int8 variable or a value read from a file).
+	The type is validated with ToVarType before the variable is added.
+*/
+func (model *Model) AddVarInt8(vtype int8, obj float64, lb float64, ub float64, name string, constrs []*Constr, columns []float64) (*Var, error) {
+	vt, err := ToVarType(vtype)
+	if err != nil {
+		return nil, err
+	}
+	return model.AddVar(vt, obj, lb, ub, name, constrs, columns)
 }
 
 /*
@@ -115,6 +328,7 @@ Description:
 
 	Create a variable to the model
 	This includes inputs for:
+	- vtype = Type of the variable (e.g., Continuous, Binary, Integer)
 	- obj = Linear coefficient applied to this variable in the objective function (i.e. objective =  ... + obj * newvar + ...)
 	- lb = Lower Bound
 	- ub = Upper Bound
@@ -128,16 +342,24 @@ Links:
 	Comes from the C API for Gurobi.
 	Documentation for 9.0: https://www.gurobi.com/documentation/9.0/refman/c_addvar.html
 */
-func (model *Model) AddVar(vtype int8, obj float64, lb float64, ub float64, name string, constrs []*Constr, columns []float64) (*Var, error) {
+func (model *Model) AddVar(vtype VarType, obj float64, lb float64, ub float64, name string, constrs []*Constr, columns []float64) (*Var, error) {
 	err := model.Check()
 	if err != nil {
 		return nil, err
 	}
 
+	if err := vtype.Check(); err != nil {
+		return nil, err
+	}
+
 	if len(constrs) != len(columns) {
 		return nil, errors.New("either the length of constrs or columns are wrong")
 	}
 
+	if model.namer != nil {
+		name = model.namer.Name(name)
+	}
+
 	ind := make([]int32, len(constrs))
 	for i, c := range constrs {
 		if c.Index < 0 {
@@ -146,24 +368,52 @@ func (model *Model) AddVar(vtype int8, obj float64, lb float64, ub float64, name
 		ind[i] = c.Index
 	}
 
-	pind := (*C.int)(nil)
-	pval := (*C.double)(nil)
-	if len(ind) > 0 {
-		pind = (*C.int)(&ind[0])
-		pval = (*C.double)(&columns[0])
+	if model.dryRun != nil {
+		err := model.dryRun.addVars("AddVar", []VarType{vtype}, []float64{obj}, []float64{lb}, []float64{ub}, [][]*Constr{constrs}, [][]float64{columns})
+		if err != nil {
+			return nil, err
+		}
+		return model.appendVars(1, []string{name})[0], nil
 	}
 
-	errCode := C.GRBaddvar(model.AsGRBModel, C.int(len(constrs)), pind, pval, C.double(obj), C.double(lb), C.double(ub), C.char(vtype), C.CString(name))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBaddvar", obj, lb, ub, vtype, name)
+	errCode := C.GRBaddvar(model.AsGRBModel, C.int(len(constrs)), args.ints(ind), args.doubles(columns), C.double(obj), C.double(lb), C.double(ub), C.char(vtype), args.str(name))
+	done(errCode)
 	if errCode != 0 {
 		return nil, model.MakeError(errCode)
 	}
 
+	model.changes.newVars++
+	model.emitItems(EventVarsAdded, len(model.Variables), 1)
+
 	if err := model.Update(); err != nil {
 		return nil, err
 	}
 
-	model.Variables = append(model.Variables, Var{model, int32(len(model.Variables))})
-	return &model.Variables[len(model.Variables)-1], nil
+	return model.appendVars(1, []string{name})[0], nil
+}
+
+/*
+AddVarsInt8
+Description:
+
+	The AddVars of earlier versions, which takes the types of the variables
+	as raw int8 values. Each type is validated with ToVarType before the
+	variables are added.
+*/
+func (model *Model) AddVarsInt8(vtypes []int8, objs []float64, lbs []float64, ubs []float64, names []string, constrs [][]*Constr, columns [][]float64) ([]*Var, error) {
+	types := make([]VarType, len(vtypes))
+	for i, vtype := range vtypes {
+		vt, err := ToVarType(vtype)
+		if err != nil {
+			return nil, err
+		}
+		types[i] = vt
+	}
+	return model.AddVars(types, objs, lbs, ubs, names, constrs, columns)
 }
 
 /*
@@ -172,13 +422,24 @@ Description:
 
 	Adds the list of variables defined by the input slices.
 */
-func (model *Model) AddVars(vtypes []int8, objs []float64, lbs []float64, ubs []float64, names []string, constrs [][]*Constr, columns [][]float64) ([]*Var, error) {
+func (model *Model) AddVars(vtypes []VarType, objs []float64, lbs []float64, ubs []float64, names []string, constrs [][]*Constr, columns [][]float64) ([]*Var, error) {
 	// Input Processing
 	err := model.AddVars_InputChecking(vtypes, objs, lbs, ubs, names, constrs, columns)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := model.checkFor("AddVars"); err != nil {
+		return nil, err
+	}
+	names = model.varNames(names)
+	if model.dryRun != nil {
+		if err := model.dryRun.addVars("AddVars", vtypes, objs, lbs, ubs, constrs, columns); err != nil {
+			return nil, err
+		}
+		return model.appendVars(len(vtypes), names), nil
+	}
+
 	numnz := 0
 	for _, constr := range constrs {
 		numnz += len(constr)
@@ -206,132 +467,150 @@ func (model *Model) AddVars(vtypes []int8, objs []float64, lbs []float64, ubs []
 		k += len(constrs[i])
 	}
 
-	vnames := make([](*C.char), len(vtypes))
-	for i, n := range names {
-		vnames[i] = C.CString(n)
-	}
-
-	pbeg := (*C.int)(nil)
-	pind := (*C.int)(nil)
-	pval := (*C.double)(nil)
-	if len(beg) > 0 {
-		pbeg = (*C.int)(&beg[0])
-		pind = (*C.int)(&ind[0])
-		pval = (*C.double)(&val[0])
+	chars := make([]int8, len(vtypes))
+	for i, vtype := range vtypes {
+		chars[i] = int8(vtype)
 	}
 
-	pobjs := (*C.double)(nil)
-	plbs := (*C.double)(nil)
-	pubs := (*C.double)(nil)
-	pvtypes := (*C.char)(nil)
-	pnames := (**C.char)(nil)
-	if len(vtypes) > 0 {
-		pobjs = (*C.double)(&objs[0])
-		plbs = (*C.double)(&lbs[0])
-		pubs = (*C.double)(&ubs[0])
-		pvtypes = (*C.char)(&vtypes[0])
-		pnames = (**C.char)(&vnames[0])
-	}
+	args := &cgoArgs{}
+	defer args.free()
 
-	errCode := C.GRBaddvars(model.AsGRBModel, C.int(len(vtypes)), C.int(numnz), pbeg, pind, pval, pobjs, plbs, pubs, pvtypes, pnames)
+	done := traceCall("GRBaddvars", numnz)
+	errCode := C.GRBaddvars(
+		model.AsGRBModel, C.int(len(vtypes)), C.int(numnz),
+		args.ints(beg), args.ints(ind), args.doubles(val),
+		args.doubles(objs), args.doubles(lbs), args.doubles(ubs), args.chars(chars), args.strings(names),
+	)
+	done(errCode)
 	if errCode != 0 {
 		return nil, model.MakeError(errCode)
 	}
 
+	model.changes.newVars += len(vtypes)
+	model.emitItems(EventVarsAdded, len(model.Variables), len(vtypes))
+
 	if err := model.Update(); err != nil {
 		return nil, err
 	}
 
 	//fmt.Printf("len(vtypes)=%v\n", len(vtypes))
 
-	vars := make([]*Var, len(vtypes))
-	xcols := len(model.Variables)
-	for i := xcols; i < xcols+len(vtypes); i++ {
-		model.Variables = append(model.Variables, Var{model, int32(i)})
-		vars[i] = &model.Variables[len(model.Variables)-1]
-	}
-	return vars, nil
+	return model.appendVars(len(vtypes), names), nil
 }
 
-func (model *Model) AddVarsWithTypes(count int, vtype int8) ([]*Var, error) {
+/*
+AddVarsWithTypes
+Description:
+
+	Adds count variables of the same type vtype (with default bounds) to the model.
+*/
+func (model *Model) AddVarsWithTypes(count int, vtype VarType) ([]*Var, error) {
+	if err := vtype.Check(); err != nil {
+		return nil, err
+	}
+
 	vtypes := make([]int8, count)
 
 	for i := 0; i < count; i++ {
-		vtypes[i] = vtype
+		vtypes[i] = int8(vtype)
+	}
+
+	if err := model.checkFor("AddVarsWithTypes"); err != nil {
+		return nil, err
 	}
 
-	pbeg := (*C.int)(nil)
-	pind := (*C.int)(nil)
-	pval := (*C.double)(nil)
+	// The variables are unnamed unless the model has a Namer.
+	var names []string
+	if model.namer != nil {
+		names = model.varNames(make([]string, count))
+	}
 
-	pobjs := (*C.double)(nil)
-	plbs := (*C.double)(nil)
-	pubs := (*C.double)(nil)
-	pvtypes := (*C.char)(nil)
-	pnames := (**C.char)(nil)
+	if model.dryRun != nil {
+		types := make([]VarType, count)
+		lbs := make([]float64, count)
+		ubs := make([]float64, count)
+		for i := 0; i < count; i++ {
+			types[i] = vtype
+			ubs[i] = INFINITY
+		}
+		if err := model.dryRun.addVars("AddVarsWithTypes", types, make([]float64, count), lbs, ubs, nil, nil); err != nil {
+			return nil, err
+		}
+		return model.appendVars(count, names), nil
+	}
 
-	pvtypes = (*C.char)(&vtypes[0])
+	args := &cgoArgs{}
+	defer args.free()
 
-	errCode := C.GRBaddvars(model.AsGRBModel, C.int(count), C.int(0), pbeg, pind, pval, pobjs, plbs, pubs, pvtypes, pnames)
+	done := traceCall("GRBaddvars", count)
+	errCode := C.GRBaddvars(model.AsGRBModel, C.int(count), C.int(0), nil, nil, nil, nil, nil, nil, args.chars(vtypes), args.strings(names))
+	done(errCode)
 	if errCode != 0 {
 		return nil, model.MakeError(errCode)
 	}
 
+	model.changes.newVars += count
+	model.emitItems(EventVarsAdded, len(model.Variables), count)
+
 	if err := model.Update(); err != nil {
 		return nil, err
 	}
 
 	//fmt.Printf("len(vtypes)=%v\n", len(vtypes))
 
-	vars := make([]*Var, len(vtypes))
-	xcols := len(model.Variables)
-	for i := xcols; i < xcols+len(vtypes); i++ {
-		model.Variables = append(model.Variables, Var{model, int32(i)})
-		vars[i-xcols] = &model.Variables[len(model.Variables)-1]
-	}
-	return vars, nil
+	return model.appendVars(len(vtypes), names), nil
 }
 
 func (model *Model) AddVarsWithoutTypes(lbs []float64, ubs []float64) ([]*Var, error) {
+	if err := model.checkFor("AddVarsWithoutTypes"); err != nil {
+		return nil, err
+	}
+	if len(lbs) != len(ubs) {
+		return nil, MismatchedLengthError{
+			Length1: len(lbs),
+			Name1:   "lbs",
+			Length2: len(ubs),
+			Name2:   "ubs",
+		}
+	}
+	if model.dryRun != nil {
+		types := make([]VarType, len(lbs))
+		for i := range types {
+			types[i] = Continuous
+		}
+		if err := model.dryRun.addVars("AddVarsWithoutTypes", types, make([]float64, len(lbs)), lbs, ubs, nil, nil); err != nil {
+			return nil, err
+		}
+		return model.appendVars(len(lbs), nil), nil
+	}
 
-	pbeg := (*C.int)(nil)
-	pind := (*C.int)(nil)
-	pval := (*C.double)(nil)
-
-	pobjs := (*C.double)(nil)
-	plbs := (*C.double)(nil)
-	pubs := (*C.double)(nil)
-	pvtypes := (*C.char)(nil)
-	pnames := (**C.char)(nil)
-
-	plbs = (*C.double)(&lbs[0])
-	pubs = (*C.double)(&ubs[0])
+	args := &cgoArgs{}
+	defer args.free()
 
-	errCode := C.GRBaddvars(model.AsGRBModel, C.int(len(lbs)), C.int(0), pbeg, pind, pval, pobjs, plbs, pubs, pvtypes, pnames)
+	done := traceCall("GRBaddvars")
+	errCode := C.GRBaddvars(model.AsGRBModel, C.int(len(lbs)), C.int(0), nil, nil, nil, nil, args.doubles(lbs), args.doubles(ubs), nil, nil)
+	done(errCode)
 	if errCode != 0 {
 		return nil, model.MakeError(errCode)
 	}
 
+	model.changes.newVars += len(lbs)
+	model.emitItems(EventVarsAdded, len(model.Variables), len(lbs))
+
 	if err := model.Update(); err != nil {
 		return nil, err
 	}
 
 	//fmt.Printf("len(vtypes)=%v\n", len(vtypes))
 
-	vars := make([]*Var, len(lbs))
-	xcols := len(model.Variables)
-	for i := xcols; i < xcols+len(lbs); i++ {
-		model.Variables = append(model.Variables, Var{model, int32(i)})
-		vars[i-xcols] = &model.Variables[len(model.Variables)-1]
-	}
-	return vars, nil
+	return model.appendVars(len(lbs), nil), nil
 }
 
-func (model *Model) AddVars_InputChecking(vtypes []int8, objs []float64, lbs []float64, ubs []float64, names []string, constrs [][]*Constr, columns [][]float64) error {
+func (model *Model) AddVars_InputChecking(vtypes []VarType, objs []float64, lbs []float64, ubs []float64, names []string, constrs [][]*Constr, columns [][]float64) error {
 	// Check the model
 	err := model.Check()
 	if err != nil {
-		return model.MakeUninitializedError()
+		return err
 	}
 
 	// Check the length of each of the slices.
@@ -393,6 +672,13 @@ func (model *Model) AddVars_InputChecking(vtypes []int8, objs []float64, lbs []f
 		}
 	}
 
+	// Check that each of the variable types is valid.
+	for _, vtype := range vtypes {
+		if err := vtype.Check(); err != nil {
+			return err
+		}
+	}
+
 	// Everything is good!
 	return nil
 }
@@ -407,7 +693,7 @@ Description:
 Inputs:
   - vars: A slice of variable arrays which provide the indices for the gurobi model's variables.
   - val: A slice of float values which are used as coefficients for the variables in the linear constraint.
-  - sense: A flag which determines if this is an equality, less than equal or greater than or equal constraint.
+  - sense: A flag which determines if this is an equality (Eq), less than equal (Le) or greater than or equal (Ge) constraint.
   - rhs: A float value which determines the constant which is on the other side of the constraint.
   - constrname: An optional name for the constraint.
 
@@ -415,10 +701,32 @@ Link:
 
 	https://www.gurobi.com/documentation/9.1/refman/c_addconstr.html
 */
-func (model *Model) AddConstr(vars []*Var, val []float64, sense int8, rhs float64, constrname string) (*Constr, error) {
+func (model *Model) AddConstr(vars []*Var, val []float64, sense Sense, rhs float64, constrname string) (*Constr, error) {
 	err := model.Check()
 	if err != nil {
-		return nil, model.MakeUninitializedError()
+		return nil, err
+	}
+
+	if err := sense.Check(); err != nil {
+		return nil, err
+	}
+
+	if len(vars) != len(val) {
+		return nil, MismatchedLengthError{
+			Length1: len(vars),
+			Name1:   "vars",
+			Length2: len(val),
+			Name2:   "val",
+		}
+	}
+
+	vars, val = model.canonicalTerms(vars, val)
+
+	if model.dryRun != nil {
+		if err := model.dryRun.addConstr("AddConstr", vars, val, sense, rhs); err != nil {
+			return nil, err
+		}
+		return model.appendConstrs(1, []string{constrname})[0], nil
 	}
 
 	ind := make([]int32, len(vars))
@@ -429,36 +737,65 @@ func (model *Model) AddConstr(vars []*Var, val []float64, sense int8, rhs float6
 		ind[i] = v.Index
 	}
 
-	pind := (*C.int)(nil)
-	pval := (*C.double)(nil)
-	if len(ind) > 0 {
-		pind = (*C.int)(&ind[0])
-		pval = (*C.double)(&val[0])
-	}
-
-	//fmt.Printf("pind = %v\n", *pind)
-	//fmt.Printf("ind = %v\n vars[0] = %v\n", ind, vars[0].Index)
+	// GRBclean2 compacts ind and val in place, so val is copied first.
+	val = append([]float64{}, val...)
+	args := &cgoArgs{}
+	defer args.free()
+	pind, pval := args.ints(ind), args.doubles(val)
 
 	var length int32
 	length = (int32)(len(ind))
 
 	C.GRBclean2((*C.int)(&length), pind, pval)
 
+	done := traceCall("GRBaddconstr", length, sense, rhs, constrname)
 	errCode := C.GRBaddconstr(
 		model.AsGRBModel,
 		C.int(length),
 		pind, pval,
-		C.char(sense), C.double(rhs), C.CString(constrname))
+		C.char(sense), C.double(rhs), args.str(constrname))
+	done(errCode)
 	if errCode != 0 {
 		return nil, model.MakeError(errCode)
 	}
 
+	model.changes.newConstrs++
+	model.emitItems(EventConstrsAdded, len(model.Constraints), 1)
+
 	if err := model.Update(); err != nil {
 		return nil, err
 	}
 
-	model.Constraints = append(model.Constraints, Constr{model, int32(len(model.Constraints))})
-	return &model.Constraints[len(model.Constraints)-1], nil
+	return model.appendConstrs(1, []string{constrname})[0], nil
+}
+
+/*
+AddTempConstr
+Description:
+
+	Adds the linear constraint described by tc to the model.
+*/
+func (model *Model) AddTempConstr(tc TempConstr, constrname string) (*Constr, error) {
+	if err := tc.Check(); err != nil {
+		return nil, err
+	}
+
+	return model.AddConstr(tc.LHS.Ind, tc.LHS.Val, tc.Sense, tc.NormalizedRHS(), constrname)
+}
+
+/*
+AddConstrsInt8
+Description:
+
+	The AddConstrs of earlier versions, which takes the senses of the
+	constraints as raw int8 values. Each sense must still be a valid Sense.
+*/
+func (model *Model) AddConstrsInt8(vars [][]*Var, vals [][]float64, senses []int8, rhs []float64, constrnames []string) ([]*Constr, error) {
+	typed := make([]Sense, len(senses))
+	for i, sense := range senses {
+		typed[i] = Sense(sense)
+	}
+	return model.AddConstrs(vars, vals, typed, rhs, constrnames)
 }
 
 /*
@@ -467,10 +804,10 @@ Description:
 
 	Adds a set of constraints at once.
 */
-func (model *Model) AddConstrs(vars [][]*Var, vals [][]float64, senses []int8, rhs []float64, constrnames []string) ([]*Constr, error) {
+func (model *Model) AddConstrs(vars [][]*Var, vals [][]float64, senses []Sense, rhs []float64, constrnames []string) ([]*Constr, error) {
 	err := model.Check()
 	if err != nil {
-		return nil, model.MakeUninitializedError()
+		return nil, err
 	}
 
 	err = model.InputChecking_AddConstrs(vars, vals, senses, rhs, constrnames)
@@ -478,6 +815,30 @@ func (model *Model) AddConstrs(vars [][]*Var, vals [][]float64, senses []int8, r
 		return nil, err
 	}
 
+	if model.deterministic {
+		vars, vals = append([][]*Var{}, vars...), append([][]float64{}, vals...)
+		for i := range vars {
+			vars[i], vals[i] = model.canonicalTerms(vars[i], vals[i])
+		}
+	}
+
+	if model.dryRun != nil {
+		if len(vars) != len(constrnames) || len(vals) != len(constrnames) || len(senses) != len(constrnames) || len(rhs) != len(constrnames) {
+			return nil, fmt.Errorf("vars, vals, senses, rhs and constrnames must all have length %v", len(constrnames))
+		}
+		constrs := make([]*Constr, len(constrnames))
+		for i := range constrnames {
+			if err := senses[i].Check(); err != nil {
+				return nil, err
+			}
+			if err := model.dryRun.addConstr("AddConstrs", vars[i], vals[i], senses[i], rhs[i]); err != nil {
+				return nil, fmt.Errorf("constraint %v: %w", i, err)
+			}
+			constrs[i] = model.appendConstrs(1, constrnames[i:i+1])[0]
+		}
+		return constrs, nil
+	}
+
 	numnz := 0
 	for _, v := range vars {
 		numnz += len(v)
@@ -505,45 +866,33 @@ func (model *Model) AddConstrs(vars [][]*Var, vals [][]float64, senses []int8, r
 		k += len(vars[i])
 	}
 
-	name := make([](*C.char), len(constrnames))
-	for i, n := range constrnames {
-		name[i] = C.CString(n)
-	}
-
-	pbeg := (*C.int)(nil)
-	pind := (*C.int)(nil)
-	pvals := (*C.double)(nil)
-	if len(beg) > 0 {
-		pbeg = (*C.int)(&beg[0])
-		pind = (*C.int)(&ind[0])
-		pvals = (*C.double)(&_vals[0])
+	chars := make([]int8, len(senses))
+	for i, sense := range senses {
+		chars[i] = int8(sense)
 	}
 
-	psenses := (*C.char)(nil)
-	prhs := (*C.double)(nil)
-	pname := (**C.char)(nil)
-	if len(constrnames) > 0 {
-		psenses = (*C.char)(&senses[0])
-		prhs = (*C.double)(&rhs[0])
-		pname = (**C.char)(&name[0])
-	}
+	args := &cgoArgs{}
+	defer args.free()
 
-	errCode := C.GRBaddconstrs(model.AsGRBModel, C.int(len(constrnames)), C.int(numnz), pbeg, pind, pvals, psenses, prhs, pname)
+	done := traceCall("GRBaddconstrs", numnz)
+	errCode := C.GRBaddconstrs(
+		model.AsGRBModel, C.int(len(constrnames)), C.int(numnz),
+		args.ints(beg), args.ints(ind), args.doubles(_vals),
+		args.chars(chars), args.doubles(rhs), args.strings(constrnames),
+	)
+	done(errCode)
 	if errCode != 0 {
 		return nil, model.MakeError(errCode)
 	}
 
+	model.changes.newConstrs += len(constrnames)
+	model.emitItems(EventConstrsAdded, len(model.Constraints), len(constrnames))
+
 	if err := model.Update(); err != nil {
 		return nil, err
 	}
 
-	constrs := make([]*Constr, len(constrnames))
-	xrows := len(model.Constraints)
-	for i := xrows; i < xrows+len(constrnames); i++ {
-		model.Constraints = append(model.Constraints, Constr{model, int32(i)})
-		constrs[i] = &model.Constraints[len(model.Constraints)-1]
-	}
-	return constrs, nil
+	return model.appendConstrs(len(constrnames), constrnames), nil
 }
 
 /*
@@ -552,11 +901,11 @@ Description:
 
 	Checks the inputs to the AddConstrs function.
 */
-func (model *Model) InputChecking_AddConstrs(vars [][]*Var, vals [][]float64, senses []int8, rhs []float64, constrnames []string) error {
+func (model *Model) InputChecking_AddConstrs(vars [][]*Var, vals [][]float64, senses []Sense, rhs []float64, constrnames []string) error {
 	// Check the model
 	err := model.Check()
 	if err != nil {
-		return model.MakeUninitializedError()
+		return err
 	}
 
 	// Check the length of each of the slices.
@@ -596,6 +945,13 @@ func (model *Model) InputChecking_AddConstrs(vars [][]*Var, vals [][]float64, se
 		}
 	}
 
+	// Check that each of the senses is valid.
+	for _, sense := range senses {
+		if err := sense.Check(); err != nil {
+			return err
+		}
+	}
+
 	//if len(constrs) > 0 {
 	//	if len(names) != len(constrs) {
 	//		return MismatchedLengthError{
@@ -623,11 +979,19 @@ func (model *Model) InputChecking_AddConstrs(vars [][]*Var, vals [][]float64, se
 }
 
 // SetObjective ...
-func (model *Model) SetObjective(objectiveExpr interface{}, sense int32) error {
+func (model *Model) SetObjective(objectiveExpr interface{}, sense ObjSense) error {
 
 	// Clear Out All Previous Quadratic Objective Terms
-	if err := C.GRBdelq(model.AsGRBModel); err != 0 {
-		return model.MakeError(err)
+	if err := model.checkFor("SetObjective"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		model.dryRun.NumQNZs = 0
+	} else {
+		if err := C.GRBdelq(model.AsGRBModel); err != 0 {
+			return model.MakeError(err)
+		}
+		model.changes.attr("Q")
 	}
 
 	// Detect the Type of Objective We Have
@@ -655,7 +1019,7 @@ Description:
 
 	Adds a linear objective to the model.
 */
-func (model *Model) SetLinearObjective(expr *LinExpr, sense int32) error {
+func (model *Model) SetLinearObjective(expr *LinExpr, sense ObjSense) error {
 	// Constants
 
 	// Algorithm
@@ -669,10 +1033,10 @@ func (model *Model) SetLinearObjective(expr *LinExpr, sense int32) error {
 	// if err := model.SetDoubleAttrVars(C.GRB_DBL_ATTR_OBJ, expr.lind, expr.lval); err != nil {
 	// 	return err
 	// }
-	if err := model.SetDoubleAttr(C.GRB_DBL_ATTR_OBJCON, expr.Offset); err != nil {
+	if err := model.SetDoubleAttr(DBL_ATTR_OBJCON, expr.Offset); err != nil {
 		return err
 	}
-	if err := model.SetIntAttr(C.GRB_INT_ATTR_MODELSENSE, sense); err != nil {
+	if err := model.SetIntAttr(C.GRB_INT_ATTR_MODELSENSE, int32(sense)); err != nil {
 		return err
 	}
 
@@ -680,13 +1044,34 @@ func (model *Model) SetLinearObjective(expr *LinExpr, sense int32) error {
 	return nil
 }
 
+/*
+SetObjConstant
+Description:
+
+	Sets the constant term of the objective (the ObjCon attribute) without
+	changing the coefficients of the variables.
+*/
+func (model *Model) SetObjConstant(c float64) error {
+	return model.SetDoubleAttr(DBL_ATTR_OBJCON, c)
+}
+
+/*
+GetObjConstant
+Description:
+
+	Returns the constant term of the objective (the ObjCon attribute).
+*/
+func (model *Model) GetObjConstant() (float64, error) {
+	return model.GetDoubleAttr(DBL_ATTR_OBJCON)
+}
+
 /*
 SetQuadraticObjective
 Description:
 
 	Adds a quadratic objective to the model.
 */
-func (model *Model) SetQuadraticObjective(expr *QuadExpr, sense int32) error {
+func (model *Model) SetQuadraticObjective(expr *QuadExpr, sense ObjSense) error {
 	// Constants
 
 	// Algorithm
@@ -697,10 +1082,10 @@ func (model *Model) SetQuadraticObjective(expr *QuadExpr, sense int32) error {
 	if err := model.SetDoubleAttrVars(C.GRB_DBL_ATTR_OBJ, expr.lind, expr.lval); err != nil {
 		return err
 	}
-	if err := model.SetDoubleAttr(C.GRB_DBL_ATTR_OBJCON, expr.offset); err != nil {
+	if err := model.SetDoubleAttr(DBL_ATTR_OBJCON, expr.offset); err != nil {
 		return err
 	}
-	if err := model.SetIntAttr(C.GRB_INT_ATTR_MODELSENSE, sense); err != nil {
+	if err := model.SetIntAttr(C.GRB_INT_ATTR_MODELSENSE, int32(sense)); err != nil {
 		return err
 	}
 
@@ -731,53 +1116,209 @@ func (model *Model) addQPTerms(qrow []*Var, qcol []*Var, qval []float64) error {
 		_qcol[i] = qcol[i].Index
 	}
 
-	pqrow := (*C.int)(nil)
-	pqcol := (*C.int)(nil)
-	pqval := (*C.double)(nil)
-	if len(qrow) > 0 {
-		pqrow = (*C.int)(&_qrow[0])
-		pqcol = (*C.int)(&_qcol[0])
-		pqval = (*C.double)(&qval[0])
+	if err := model.checkFor("addQPTerms"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		return model.dryRun.addQPTerms(qrow, qcol, qval)
 	}
 
-	err := C.GRBaddqpterms(model.AsGRBModel, C.int(len(qrow)), pqrow, pqcol, pqval)
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBaddqpterms")
+	err := C.GRBaddqpterms(model.AsGRBModel, C.int(len(qrow)), args.ints(_qrow), args.ints(_qcol), args.doubles(qval))
+	done(err)
 	if err != 0 {
 		return model.MakeError(err)
 	}
+	model.changes.attr("Q")
 
 	return nil
 }
 
+/*
+SetMaximize
+Description:
+
+	Changes the sense of the model's objective to Maximize (without changing the objective itself).
+*/
+func (model *Model) SetMaximize() error {
+	return model.SetModelSense(Maximize)
+}
+
+/*
+SetMinimize
+Description:
+
+	Changes the sense of the model's objective to Minimize (without changing the objective itself).
+*/
+func (model *Model) SetMinimize() error {
+	return model.SetModelSense(Minimize)
+}
+
+/*
+SetModelSense
+Description:
+
+	Sets the ModelSense attribute of the model to the given ObjSense.
+*/
+func (model *Model) SetModelSense(sense ObjSense) error {
+	if err := sense.Check(); err != nil {
+		return err
+	}
+
+	return model.SetIntAttr(C.GRB_INT_ATTR_MODELSENSE, int32(sense))
+}
+
+/*
+GetModelSense
+Description:
+
+	Returns the current ModelSense attribute of the model as an ObjSense.
+*/
+func (model *Model) GetModelSense() (ObjSense, error) {
+	sense, err := model.GetIntAttr(C.GRB_INT_ATTR_MODELSENSE)
+	if err != nil {
+		return Minimize, err
+	}
+
+	return ObjSense(sense), nil
+}
+
 // Update ...
 func (model *Model) Update() error {
-	if model == nil {
-		return errors.New("")
+	if err := model.checkFor("Update"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		return nil
 	}
+	done := traceCall("GRBupdatemodel")
 	err := C.GRBupdatemodel(model.AsGRBModel)
+	done(err)
+	model.attrCache.clear()
 	if err != 0 {
 		return model.MakeError(err)
 	}
 	return nil
 }
 
+/*
+Reset
+Description:
+
+	Discards any solution information (and, if clearAll is true, also the
+	warm-start information such as the basis and MIP start) so that the next
+	call to Optimize starts from scratch.
+*/
+func (model *Model) Reset(clearAll bool) error {
+	if err := model.checkFor("Reset"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		// A dry-run model has no solution information to discard.
+		return nil
+	}
+	clearall := 0
+	if clearAll {
+		clearall = 1
+	}
+	done := traceCall("GRBreset", clearall)
+	err := C.GRBreset(model.AsGRBModel, C.int(clearall))
+	done(err)
+	model.attrCache.clear()
+	if err != 0 {
+		return model.MakeError(err)
+	}
+	model.changes.optimized = false
+	if clearAll {
+		model.warm = nil
+	}
+	return nil
+}
+
 // Optimize ...
 func (model *Model) Optimize() error {
-	if model == nil {
-		return errors.New("")
+	if err := model.checkFor("Optimize"); err != nil {
+		return err
 	}
+	if model.dryRun != nil {
+		return fmt.Errorf("cannot optimize: %w", ErrDryRun)
+	}
+	// Claim the model first, so that a refused solve changes nothing.
+	endSolve, solveErr := model.beginSolve("Optimize")
+	if solveErr != nil {
+		return solveErr
+	}
+	if cbErr := model.prepareCallback(); cbErr != nil {
+		endSolve()
+		return cbErr
+	}
+	if logErr := model.openSolveLog(); logErr != nil {
+		endSolve()
+		return logErr
+	}
+	done := traceCall("GRBoptimize")
 	err := C.GRBoptimize(model.AsGRBModel)
+	done(err)
+	endSolve()
+	model.attrCache.clear()
+	if err == 0 {
+		model.changes.reset()
+	}
+	if logErr := model.closeSolveLog(); logErr != nil && err == 0 {
+		return logErr
+	}
+	if cbErr := model.takeCallbackError(); cbErr != nil {
+		return model.dumpOnFailure(cbErr)
+	}
 	if err != 0 {
-		return model.MakeError(err)
+		return model.dumpOnFailure(model.MakeError(err))
 	}
-	return nil
+	return model.dumpOnFailure(nil)
 }
 
 // Write ...
 func (model *Model) Write(filename string) error {
-	if model == nil {
-		return errors.New("")
+	if err := model.checkFor("Write"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		return fmt.Errorf("cannot write %v: %w", filename, ErrDryRun)
 	}
-	err := C.GRBwrite(model.AsGRBModel, C.CString(filename))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBwrite", filename)
+	err := C.GRBwrite(model.AsGRBModel, args.str(filename))
+	done(err)
+	if err != 0 {
+		return model.MakeError(err)
+	}
+	return nil
+}
+
+/*
+Read
+Description:
+
+	Reads data from filename into the model, e.g. a MIP start (.mst), a
+	basis (.bas), a solution (.sol) or a parameter file (.prm).
+*/
+func (model *Model) Read(filename string) error {
+	if err := model.checkFor("Read"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		return fmt.Errorf("cannot read %v: %w", filename, ErrDryRun)
+	}
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBread", filename)
+	err := C.GRBread(model.AsGRBModel, args.str(filename))
+	done(err)
 	if err != 0 {
 		return model.MakeError(err)
 	}
@@ -792,78 +1333,178 @@ func (model *Model) NumConstrs() (int32, error) {
 	return model.GetIntAttr(INT_ATTR_NUMCONSTRS)
 }
 
+func (model *Model) NumSOS() (int32, error) {
+	return model.GetIntAttr(INT_ATTR_NUMSOS)
+}
+
+func (model *Model) NumGenConstrs() (int32, error) {
+	return model.GetIntAttr("NumGenConstrs")
+}
+
+func (model *Model) NumIntVars() (int32, error) {
+	return model.GetIntAttr("NumIntVars")
+}
+
+func (model *Model) NumBinVars() (int32, error) {
+	return model.GetIntAttr("NumBinVars")
+}
+
 // GetIntAttr ...
 func (model *Model) GetIntAttr(attrname string) (int32, error) {
-	if model == nil {
-		return 0, errors.New("")
+	if err := model.checkFor("GetIntAttr"); err != nil {
+		return 0, err
+	}
+	return model.intAttr(attrname)
+}
+
+// intAttr is GetIntAttr without its checks, for the solve which holds the
+// model (see beginSolve).
+func (model *Model) intAttr(attrname string) (int32, error) {
+	if model.dryRun != nil {
+		return model.dryRun.intAttr(attrname)
+	}
+	if value, ok := model.attrCache.get(attrCacheInt, attrname, -1); ok {
+		return value.(int32), nil
 	}
 	var attr int32
-	err := C.GRBgetintattr(model.AsGRBModel, C.CString(attrname), (*C.int)(&attr))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBgetintattr", attrname)
+	err := C.GRBgetintattr(model.AsGRBModel, args.str(attrname), (*C.int)(&attr))
+	done(err)
 	if err != 0 {
 		return 0, model.MakeError(err)
 	}
+	model.attrCache.put(attrCacheInt, attrname, -1, attr)
 	return attr, nil
 }
 
 // GetDoubleAttr ...
 func (model *Model) GetDoubleAttr(attrname string) (float64, error) {
-	if model == nil {
-		return 0, errors.New("")
+	if err := model.checkFor("GetDoubleAttr"); err != nil {
+		return 0, err
+	}
+	if model.dryRun != nil {
+		return 0, fmt.Errorf("attribute %v: %w", attrname, ErrDryRun)
+	}
+	if value, ok := model.attrCache.get(attrCacheDouble, attrname, -1); ok {
+		return value.(float64), nil
 	}
 	var attr float64
-	err := C.GRBgetdblattr(model.AsGRBModel, C.CString(attrname), (*C.double)(&attr))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBgetdblattr", attrname)
+	err := C.GRBgetdblattr(model.AsGRBModel, args.str(attrname), (*C.double)(&attr))
+	done(err)
 	if err != 0 {
 		return 0, model.MakeError(err)
 	}
+	model.attrCache.put(attrCacheDouble, attrname, -1, attr)
 	return attr, nil
 }
 
 // GetStringAttr ...
 func (model *Model) GetStringAttr(attrname string) (string, error) {
-	if model == nil {
-		return "", errors.New("")
+	if err := model.checkFor("GetStringAttr"); err != nil {
+		return "", err
+	}
+	return model.stringAttr(attrname)
+}
+
+// stringAttr is GetStringAttr without its checks, for the solve which holds
+// the model (see beginSolve).
+func (model *Model) stringAttr(attrname string) (string, error) {
+	if model.dryRun != nil {
+		return "", fmt.Errorf("attribute %v: %w", attrname, ErrDryRun)
+	}
+	if value, ok := model.attrCache.get(attrCacheString, attrname, -1); ok {
+		return value.(string), nil
 	}
 	var attr *C.char
-	err := C.GRBgetstrattr(model.AsGRBModel, C.CString(attrname), (**C.char)(&attr))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBgetstrattr", attrname)
+	err := C.GRBgetstrattr(model.AsGRBModel, args.str(attrname), (**C.char)(&attr))
+	done(err)
 	if err != 0 {
 		return "", model.MakeError(err)
 	}
-	return C.GoString(attr), nil
+	value := C.GoString(attr)
+	model.attrCache.put(attrCacheString, attrname, -1, value)
+	return value, nil
 }
 
 // SetIntAttr ...
 func (model *Model) SetIntAttr(attrname string, value int32) error {
-	if model == nil {
-		return errors.New("")
+	if err := model.checkFor("SetIntAttr"); err != nil {
+		return err
 	}
-	err := C.GRBsetintattr(model.AsGRBModel, C.CString(attrname), C.int(value))
-	if err != 0 {
-		return model.MakeError(err)
+	if model.dryRun != nil {
+		if err := model.dryRun.setAttr(attrname, value); err != nil {
+			return err
+		}
+	} else {
+		args := &cgoArgs{}
+		defer args.free()
+
+		done := traceCall("GRBsetintattr", attrname, value)
+		err := C.GRBsetintattr(model.AsGRBModel, args.str(attrname), C.int(value))
+		done(err)
+		if err != 0 {
+			return model.MakeError(err)
+		}
+		model.changes.attr(attrname)
+	}
+
+	// The penalties of soft constraints follow the sense of the objective.
+	if attrname == INT_ATTR_MODELSENSE {
+		return model.signSoftPenalties(ObjSense(value))
 	}
 	return nil
 }
 
 // SetDoubleAttr ...
 func (model *Model) SetDoubleAttr(attrname string, value float64) error {
-	if model == nil {
-		return errors.New("")
+	if err := model.checkFor("SetDoubleAttr"); err != nil {
+		return err
 	}
-	err := C.GRBsetdblattr(model.AsGRBModel, C.CString(attrname), C.double(value))
+	if model.dryRun != nil {
+		return model.dryRun.setAttr(attrname, value)
+	}
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBsetdblattr", attrname, value)
+	err := C.GRBsetdblattr(model.AsGRBModel, args.str(attrname), C.double(value))
+	done(err)
 	if err != 0 {
 		return model.MakeError(err)
 	}
+	model.changes.attr(attrname)
 	return nil
 }
 
 // SetStringAttr ...
 func (model *Model) SetStringAttr(attrname string, value string) error {
-	if model == nil {
-		return errors.New("")
+	if err := model.checkFor("SetStringAttr"); err != nil {
+		return err
 	}
-	err := C.GRBsetstrattr(model.AsGRBModel, C.CString(attrname), C.CString(value))
+	if model.dryRun != nil {
+		return model.dryRun.setAttr(attrname, value)
+	}
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBsetstrattr", attrname, value)
+	err := C.GRBsetstrattr(model.AsGRBModel, args.str(attrname), args.str(value))
+	done(err)
 	if err != 0 {
 		return model.MakeError(err)
 	}
+	model.changes.attr(attrname)
 	return nil
 }
 
@@ -891,107 +1532,547 @@ func (model *Model) SetDoubleAttrVars(attrname string, vars []*Var, value []floa
 	return model.setDoubleAttrList(attrname, ind, value)
 }
 
+/*
+SetDoubleAttrConstrs
+Description:
+
+	Sets the double attribute attrname (e.g., RHS) of each constraint in
+	constrs to the corresponding entry of value, with a single call to
+	GRBsetdblattrlist.
+*/
+func (model *Model) SetDoubleAttrConstrs(attrname string, constrs []*Constr, value []float64) error {
+	ind := make([]int32, len(constrs))
+	for i, c := range constrs {
+		if c == nil || c.Index < 0 {
+			return fmt.Errorf("the constraint at position %v is invalid", i)
+		}
+		ind[i] = c.Index
+	}
+	return model.setDoubleAttrList(attrname, ind, value)
+}
+
 func (model *Model) getIntAttrElement(attr string, ind int32) (int32, error) {
-	if model == nil {
-		return 0.0, model.MakeUninitializedError()
+	if err := model.checkFor("getIntAttrElement"); err != nil {
+		return 0, err
+	}
+	if model.dryRun != nil {
+		return 0, fmt.Errorf("attribute %v: %w", attr, ErrDryRun)
+	}
+	if value, ok := model.attrCache.get(attrCacheInt, attr, ind); ok {
+		return value.(int32), nil
 	}
 	var value int32
-	err := C.GRBgetintattrelement(model.AsGRBModel, C.CString(attr), C.int(ind), (*C.int)(&value))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBgetintattrelement", attr, ind)
+	err := C.GRBgetintattrelement(model.AsGRBModel, args.str(attr), C.int(ind), (*C.int)(&value))
+	done(err)
 	if err != 0 {
 		return 0, model.MakeError(err)
 	}
+	model.attrCache.put(attrCacheInt, attr, ind, value)
 	return value, nil
 }
 
 func (model *Model) getCharAttrElement(attr string, ind int32) (int8, error) {
-	if model == nil {
-		return 0, errors.New("")
+	if err := model.checkFor("getCharAttrElement"); err != nil {
+		return 0, err
+	}
+	if model.dryRun != nil {
+		return 0, fmt.Errorf("attribute %v: %w", attr, ErrDryRun)
+	}
+	if value, ok := model.attrCache.get(attrCacheChar, attr, ind); ok {
+		return value.(int8), nil
 	}
 	var value int8
-	err := C.GRBgetcharattrelement(model.AsGRBModel, C.CString(attr), C.int(ind), (*C.char)(&value))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBgetcharattrelement", attr, ind)
+	err := C.GRBgetcharattrelement(model.AsGRBModel, args.str(attr), C.int(ind), (*C.char)(&value))
+	done(err)
 	if err != 0 {
 		return 0, model.MakeError(err)
 	}
+	model.attrCache.put(attrCacheChar, attr, ind, value)
 	return value, nil
 }
 
 func (model *Model) getDoubleAttrElement(attr string, ind int32) (float64, error) {
-	if model == nil {
-		return 0, errors.New("")
+	if err := model.checkFor("getDoubleAttrElement"); err != nil {
+		return 0, err
+	}
+	if model.dryRun != nil {
+		return 0, fmt.Errorf("attribute %v: %w", attr, ErrDryRun)
+	}
+	if value, ok := model.attrCache.get(attrCacheDouble, attr, ind); ok {
+		return value.(float64), nil
 	}
 	var value float64
-	err := C.GRBgetdblattrelement(model.AsGRBModel, C.CString(attr), C.int(ind), (*C.double)(&value))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBgetdblattrelement", attr, ind)
+	err := C.GRBgetdblattrelement(model.AsGRBModel, args.str(attr), C.int(ind), (*C.double)(&value))
+	done(err)
 	if err != 0 {
 		return 0, model.MakeError(err)
 	}
+	model.attrCache.put(attrCacheDouble, attr, ind, value)
 	return value, nil
 }
 
 func (model *Model) getStringAttrElement(attr string, ind int32) (string, error) {
-	if model == nil {
-		return "", errors.New("")
+	if err := model.checkFor("getStringAttrElement"); err != nil {
+		return "", err
+	}
+	if model.dryRun != nil {
+		return "", fmt.Errorf("attribute %v: %w", attr, ErrDryRun)
+	}
+	if cached, ok := model.attrCache.get(attrCacheString, attr, ind); ok {
+		return cached.(string), nil
 	}
 	var value *C.char
-	err := C.GRBgetstrattrelement(model.AsGRBModel, C.CString(attr), C.int(ind), (**C.char)(&value))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBgetstrattrelement", attr, ind)
+	err := C.GRBgetstrattrelement(model.AsGRBModel, args.str(attr), C.int(ind), (**C.char)(&value))
+	done(err)
 	if err != 0 {
 		return "", model.MakeError(err)
 	}
-	return C.GoString(value), nil
+	name := C.GoString(value)
+	model.attrCache.put(attrCacheString, attr, ind, name)
+	return name, nil
 }
 
 func (model *Model) setIntAttrElement(attr string, ind int32, value int32) error {
-	if model == nil {
-		return errors.New("")
+	if err := model.checkFor("setIntAttrElement"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		return model.dryRun.setAttrElement(attr, ind, value)
 	}
-	err := C.GRBsetintattrelement(model.AsGRBModel, C.CString(attr), C.int(ind), C.int(value))
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBsetintattrelement", attr, ind, value)
+	err := C.GRBsetintattrelement(model.AsGRBModel, args.str(attr), C.int(ind), C.int(value))
+	done(err)
 	if err != 0 {
 		return model.MakeError(err)
 	}
+	model.changes.element(attr, ind)
 	return nil
 }
 
 func (model *Model) setCharAttrElement(attr string, ind int32, value int8) error {
+	if err := model.checkFor("setCharAttrElement"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		return model.dryRun.setAttrElement(attr, ind, value)
+	}
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBsetcharattrelement", attr, ind, value)
+	err := C.GRBsetcharattrelement(model.AsGRBModel, args.str(attr), C.int(ind), C.char(value))
+	done(err)
+	if err != 0 {
+		return model.MakeError(err)
+	}
+	model.changes.element(attr, ind)
+	return nil
+}
+
+func (model *Model) setDoubleAttrElement(attr string, ind int32, value float64) error {
+	if err := model.checkFor("setDoubleAttrElement"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		return model.dryRun.setAttrElement(attr, ind, value)
+	}
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBsetdblattrelement", attr, ind, value)
+	err := C.GRBsetdblattrelement(model.AsGRBModel, args.str(attr), C.int(ind), C.double(value))
+	done(err)
+	if err != 0 {
+		return model.MakeError(err)
+	}
+	model.changes.element(attr, ind)
+	return nil
+}
+
+func (model *Model) setStringAttrElement(attr string, ind int32, value string) error {
+	if err := model.checkFor("setStringAttrElement"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		if err := model.dryRun.setAttrElement(attr, ind, value); err != nil {
+			return err
+		}
+		model.renameIndexed(attr, ind, value)
+		return nil
+	}
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBsetstrattrelement", attr, ind, value)
+	err := C.GRBsetstrattrelement(model.AsGRBModel, args.str(attr), C.int(ind), args.str(value))
+	done(err)
+	if err != 0 {
+		return model.MakeError(err)
+	}
+	model.changes.element(attr, ind)
+	model.renameIndexed(attr, ind, value)
+	return nil
+}
+
+// Data and element types reported by GRBgetattrinfo.
+const (
+	attrDataChar   = 0
+	attrDataInt    = 1
+	attrDataDouble = 2
+	attrDataString = 3
+
+	attrElemVar     = 1
+	attrElemConstr  = 2
+	attrElemQConstr = 4
+)
+
+// attrInfo returns the data type and the element type of an attribute.
+func (model *Model) attrInfo(attr string) (int32, int32, error) {
+	if err := model.checkFor("attrInfo"); err != nil {
+		return 0, 0, err
+	}
+
+	var datatype, attrtype, settable int32
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBgetattrinfo", attr)
+	err := C.GRBgetattrinfo(model.AsGRBModel, args.str(attr), (*C.int)(&datatype), (*C.int)(&attrtype), (*C.int)(&settable))
+	done(err)
+	if err != 0 {
+		return 0, 0, model.MakeError(err)
+	}
+	return datatype, attrtype, nil
+}
+
+// getAttrElement returns the value of the element attribute attr at ind
+// as an int32, int8, float64 or string, depending on the attribute's type.
+func (model *Model) getAttrElement(attr string, ind int32, elemType int32) (interface{}, error) {
+	if err := model.checkFor("GetAttr"); err != nil {
+		return nil, err
+	}
+	if model.dryRun != nil {
+		return nil, fmt.Errorf("attribute %v: %w", attr, ErrDryRun)
+	}
+
+	datatype, attrtype, err := model.attrInfo(attr)
+	if err != nil {
+		return nil, err
+	}
+	if attrtype != elemType {
+		return nil, fmt.Errorf("%v is not an attribute of this kind of element", attr)
+	}
+
+	switch datatype {
+	case attrDataChar:
+		return model.getCharAttrElement(attr, ind)
+	case attrDataInt:
+		return model.getIntAttrElement(attr, ind)
+	case attrDataDouble:
+		return model.getDoubleAttrElement(attr, ind)
+	case attrDataString:
+		return model.getStringAttrElement(attr, ind)
+	default:
+		return nil, fmt.Errorf("attribute %v has the unknown data type %v", attr, datatype)
+	}
+}
+
+// setAttrElement sets the element attribute attr at ind to value. Numeric
+// values are converted to the type of the attribute; in a dry run (where the
+// types are unknown) the type of value is used as is.
+func (model *Model) setAttrElement(attr string, ind int32, elemType int32, value interface{}) error {
+	if err := model.checkFor("SetAttr"); err != nil {
+		return err
+	}
+
+	if model.dryRun == nil {
+		datatype, attrtype, err := model.attrInfo(attr)
+		if err != nil {
+			return err
+		}
+		if attrtype != elemType {
+			return fmt.Errorf("%v is not an attribute of this kind of element", attr)
+		}
+		if value, err = convertAttrValue(attr, datatype, value); err != nil {
+			return err
+		}
+	}
+
+	switch v := value.(type) {
+	case int8:
+		return model.setCharAttrElement(attr, ind, v)
+	case VarType:
+		return model.setCharAttrElement(attr, ind, int8(v))
+	case Sense:
+		return model.setCharAttrElement(attr, ind, int8(v))
+	case int32:
+		return model.setIntAttrElement(attr, ind, v)
+	case int:
+		return model.setIntAttrElement(attr, ind, int32(v))
+	case float64:
+		return model.setDoubleAttrElement(attr, ind, v)
+	case string:
+		return model.setStringAttrElement(attr, ind, v)
+	default:
+		return fmt.Errorf("cannot set attribute %v to a value of type %T", attr, value)
+	}
+}
+
+// convertAttrValue converts value to the Go type of the given attribute data type.
+func convertAttrValue(attr string, datatype int32, value interface{}) (interface{}, error) {
+	var number float64
+	isNumber := true
+	switch v := value.(type) {
+	case int:
+		number = float64(v)
+	case int8:
+		number = float64(v)
+	case int32:
+		number = float64(v)
+	case int64:
+		number = float64(v)
+	case float64:
+		number = v
+	case VarType:
+		number = float64(v)
+	case Sense:
+		number = float64(v)
+	default:
+		isNumber = false
+	}
+
+	switch datatype {
+	case attrDataChar:
+		if isNumber && number == float64(int8(number)) {
+			return int8(number), nil
+		}
+	case attrDataInt:
+		if isNumber && number == float64(int32(number)) {
+			return int32(number), nil
+		}
+	case attrDataDouble:
+		if isNumber {
+			return number, nil
+		}
+	case attrDataString:
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot set attribute %v to %v (of type %T)", attr, value, value)
+}
+
+func (model *Model) getDoubleAttrArray(attrname string, start int32, length int32) ([]float64, error) {
+	if err := model.checkFor("getDoubleAttrArray"); err != nil {
+		return nil, err
+	}
+	if model.dryRun != nil {
+		return []float64{}, fmt.Errorf("attribute %v: %w", attrname, ErrDryRun)
+	}
+	if length <= 0 {
+		return []float64{}, nil
+	}
+	value := make([]float64, length)
+	args := &cgoArgs{}
+	defer args.free()
+	done := traceCall("GRBgetdblattrarray", attrname, start, length)
+	err := C.GRBgetdblattrarray(model.AsGRBModel, args.str(attrname), C.int(start), C.int(length), args.doubles(value))
+	done(err)
+	if err != 0 {
+		return []float64{}, model.MakeError(err)
+	}
+	return value, nil
+}
+
+func (model *Model) getCharAttrArray(attrname string, start int32, length int32) ([]int8, error) {
+	if err := model.checkFor("getCharAttrArray"); err != nil {
+		return nil, err
+	}
+	if model.dryRun != nil {
+		return []int8{}, fmt.Errorf("attribute %v: %w", attrname, ErrDryRun)
+	}
+	if length <= 0 {
+		return []int8{}, nil
+	}
+	value := make([]int8, length)
+	args := &cgoArgs{}
+	defer args.free()
+	done := traceCall("GRBgetcharattrarray", attrname, start, length)
+	err := C.GRBgetcharattrarray(model.AsGRBModel, args.str(attrname), C.int(start), C.int(length), args.chars(value))
+	done(err)
+	if err != 0 {
+		return []int8{}, model.MakeError(err)
+	}
+	return value, nil
+}
+
+func (model *Model) getStringAttrArray(attrname string, start int32, length int32) ([]string, error) {
+	if err := model.checkFor("getStringAttrArray"); err != nil {
+		return nil, err
+	}
+	if model.dryRun != nil {
+		return []string{}, fmt.Errorf("attribute %v: %w", attrname, ErrDryRun)
+	}
+	if length <= 0 {
+		return []string{}, nil
+	}
+	cvalues := make([]*C.char, length)
+	args := &cgoArgs{}
+	defer args.free()
+	done := traceCall("GRBgetstrattrarray", attrname, start, length)
+	err := C.GRBgetstrattrarray(model.AsGRBModel, args.str(attrname), C.int(start), C.int(length), (**C.char)(&cvalues[0]))
+	done(err)
+	if err != 0 {
+		return []string{}, model.MakeError(err)
+	}
+	values := make([]string, length)
+	for i, cv := range cvalues {
+		values[i] = C.GoString(cv)
+	}
+	return values, nil
+}
+
+func (model *Model) getIntAttrArray(attrname string, start int32, length int32) ([]int32, error) {
+	if err := model.checkFor("getIntAttrArray"); err != nil {
+		return nil, err
+	}
+	if model.dryRun != nil {
+		return []int32{}, fmt.Errorf("attribute %v: %w", attrname, ErrDryRun)
+	}
+	if length <= 0 {
+		return []int32{}, nil
+	}
+	value := make([]int32, length)
+	args := &cgoArgs{}
+	defer args.free()
+	done := traceCall("GRBgetintattrarray", attrname, start, length)
+	err := C.GRBgetintattrarray(model.AsGRBModel, args.str(attrname), C.int(start), C.int(length), args.ints(value))
+	done(err)
+	if err != 0 {
+		return []int32{}, model.MakeError(err)
+	}
+	return value, nil
+}
+
+func (model *Model) setIntAttrArray(attrname string, start int32, value []int32) error {
 	if model == nil {
 		return errors.New("")
 	}
-	err := C.GRBsetcharattrelement(model.AsGRBModel, C.CString(attr), C.int(ind), C.char(value))
+	if len(value) == 0 {
+		return nil
+	}
+	if err := model.checkFor("setIntAttrArray"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		return model.dryRun.setAttrElement(attrname, start, value)
+	}
+	args := &cgoArgs{}
+	defer args.free()
+	done := traceCall("GRBsetintattrarray", attrname, start, len(value))
+	err := C.GRBsetintattrarray(model.AsGRBModel, args.str(attrname), C.int(start), C.int(len(value)), args.ints(value))
+	done(err)
 	if err != 0 {
 		return model.MakeError(err)
 	}
+	model.changes.elementRange(attrname, start, int32(len(value)))
 	return nil
 }
 
-func (model *Model) setDoubleAttrElement(attr string, ind int32, value float64) error {
+func (model *Model) setDoubleAttrArray(attrname string, start int32, value []float64) error {
 	if model == nil {
 		return errors.New("")
 	}
-	err := C.GRBsetdblattrelement(model.AsGRBModel, C.CString(attr), C.int(ind), C.double(value))
+	if len(value) == 0 {
+		return nil
+	}
+	if err := model.checkFor("setDoubleAttrArray"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		return model.dryRun.setAttrElement(attrname, start, value)
+	}
+	args := &cgoArgs{}
+	defer args.free()
+	done := traceCall("GRBsetdblattrarray", attrname, start, len(value))
+	err := C.GRBsetdblattrarray(model.AsGRBModel, args.str(attrname), C.int(start), C.int(len(value)), args.doubles(value))
+	done(err)
 	if err != 0 {
 		return model.MakeError(err)
 	}
+	model.changes.elementRange(attrname, start, int32(len(value)))
 	return nil
 }
 
-func (model *Model) setStringAttrElement(attr string, ind int32, value string) error {
+func (model *Model) setStringAttrArray(attrname string, start int32, value []string) error {
 	if model == nil {
 		return errors.New("")
 	}
-	err := C.GRBsetstrattrelement(model.AsGRBModel, C.CString(attr), C.int(ind), C.CString(value))
+	if len(value) == 0 {
+		return nil
+	}
+	if err := model.checkFor("setStringAttrArray"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		if err := model.dryRun.setAttrElement(attrname, start, value); err != nil {
+			return err
+		}
+		for i, name := range value {
+			model.renameIndexed(attrname, start+int32(i), name)
+		}
+		return nil
+	}
+	args := &cgoArgs{}
+	defer args.free()
+	done := traceCall("GRBsetstrattrarray", attrname, start, len(value))
+	err := C.GRBsetstrattrarray(model.AsGRBModel, args.str(attrname), C.int(start), C.int(len(value)), args.strings(value))
+	done(err)
 	if err != 0 {
 		return model.MakeError(err)
 	}
+	model.changes.elementRange(attrname, start, int32(len(value)))
+	for i, name := range value {
+		model.renameIndexed(attrname, start+int32(i), name)
+	}
 	return nil
 }
 
 func (model *Model) getDoubleAttrList(attrname string, ind []int32) ([]float64, error) {
-	if model == nil {
-		return []float64{}, errors.New("")
+	if err := model.checkFor("getDoubleAttrList"); err != nil {
+		return nil, err
+	}
+	if model.dryRun != nil {
+		return []float64{}, fmt.Errorf("attribute %v: %w", attrname, ErrDryRun)
 	}
 	if len(ind) == 0 {
 		return []float64{}, nil
 	}
 	value := make([]float64, len(ind))
-	err := C.GRBgetdblattrlist(model.AsGRBModel, C.CString(attrname), C.int(len(ind)), (*C.int)(&ind[0]), (*C.double)(&value[0]))
+	args := &cgoArgs{}
+	defer args.free()
+	done := traceCall("GRBgetdblattrlist", attrname)
+	err := C.GRBgetdblattrlist(model.AsGRBModel, args.str(attrname), C.int(len(ind)), args.ints(ind), args.doubles(value))
+	done(err)
 	if err != 0 {
 		return []float64{}, model.MakeError(err)
 	}
@@ -1008,10 +2089,59 @@ func (model *Model) setDoubleAttrList(attrname string, ind []int32, value []floa
 	if len(ind) == 0 {
 		return nil
 	}
-	err := C.GRBsetdblattrlist(model.AsGRBModel, C.CString(attrname), C.int(len(ind)), (*C.int)(&ind[0]), (*C.double)(&value[0]))
+	if err := model.checkFor("setDoubleAttrList"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		for i := range ind {
+			if err := model.dryRun.setAttrElement(attrname, ind[i], value[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	args := &cgoArgs{}
+	defer args.free()
+	done := traceCall("GRBsetdblattrlist", attrname)
+	err := C.GRBsetdblattrlist(model.AsGRBModel, args.str(attrname), C.int(len(ind)), args.ints(ind), args.doubles(value))
+	done(err)
+	if err != 0 {
+		return model.MakeError(err)
+	}
+	model.changes.element(attrname, ind...)
+	return nil
+}
+
+func (model *Model) setCharAttrList(attrname string, ind []int32, value []int8) error {
+	if model == nil {
+		return errors.New("")
+	}
+	if len(ind) != len(value) {
+		return errors.New("")
+	}
+	if len(ind) == 0 {
+		return nil
+	}
+	if err := model.checkFor("setCharAttrList"); err != nil {
+		return err
+	}
+	if model.dryRun != nil {
+		for i := range ind {
+			if err := model.dryRun.setAttrElement(attrname, ind[i], value[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	args := &cgoArgs{}
+	defer args.free()
+	done := traceCall("GRBsetcharattrlist", attrname)
+	err := C.GRBsetcharattrlist(model.AsGRBModel, args.str(attrname), C.int(len(ind)), args.ints(ind), args.chars(value))
+	done(err)
 	if err != 0 {
 		return model.MakeError(err)
 	}
+	model.changes.element(attrname, ind...)
 	return nil
 }
 
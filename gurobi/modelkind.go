@@ -0,0 +1,107 @@
+package gurobi
+
+/*
+modelkind.go
+Description:
+	Classifies a model by the structure Gurobi has to handle (integrality,
+	quadratic objective, quadratic constraints), e.g., to route models to
+	appropriate parameter presets.
+Notes:
+	The classification is based on the IsMIP, IsQP and IsQCP attributes.
+	SOS and general constraints make a model a MIP.
+*/
+
+// ModelKind is the class of a model.
+type ModelKind int
+
+const (
+	KindLP ModelKind = iota
+	KindQP
+	KindQCP
+	KindMILP
+	KindMIQP
+	KindMIQCP
+)
+
+func (k ModelKind) String() string {
+	switch k {
+	case KindLP:
+		return "LP"
+	case KindQP:
+		return "QP"
+	case KindQCP:
+		return "QCP"
+	case KindMILP:
+		return "MILP"
+	case KindMIQP:
+		return "MIQP"
+	case KindMIQCP:
+		return "MIQCP"
+	default:
+		return "Unknown"
+	}
+}
+
+// IsMIP returns true if the kind has integrality restrictions.
+func (k ModelKind) IsMIP() bool {
+	return k == KindMILP || k == KindMIQP || k == KindMIQCP
+}
+
+// IsQuadratic returns true if the kind has a quadratic objective or quadratic constraints.
+func (k ModelKind) IsQuadratic() bool {
+	return k != KindLP && k != KindMILP
+}
+
+/*
+Kind
+Description:
+
+	Returns the class of the model (LP, QP, QCP, MILP, MIQP or MIQCP). A
+	model with quadratic constraints is a QCP (or MIQCP) whether or not its
+	objective is quadratic. Pending changes are applied first.
+*/
+func (model *Model) Kind() (ModelKind, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return KindLP, err
+	}
+
+	if err := model.Update(); err != nil {
+		return KindLP, err
+	}
+
+	// Algorithm
+	isMIP, err := model.GetIntAttr("IsMIP")
+	if err != nil {
+		return KindLP, err
+	}
+	isQP, err := model.GetIntAttr("IsQP")
+	if err != nil {
+		return KindLP, err
+	}
+	isQCP, err := model.GetIntAttr("IsQCP")
+	if err != nil {
+		return KindLP, err
+	}
+
+	kind := KindLP
+	switch {
+	case isQCP != 0:
+		kind = KindQCP
+	case isQP != 0:
+		kind = KindQP
+	}
+	if isMIP != 0 {
+		// The MIP kinds are declared in the same order as their relaxations.
+		kind += KindMILP
+	}
+	return kind, nil
+}
+
+func boolToInt32(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,325 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+/*
+modelwriter.go
+Description:
+	A streaming builder for very large models. Variables and constraints
+	are added one at a time by index (without creating a Var or Constr for
+	every term), buffered in CSR blocks and flushed with a single
+	GRBaddvars or GRBaddconstrs call once a block is full, so that the Go
+	side never holds more than one block of nonzeros at a time.
+Notes:
+	The model is only updated by Close, so the buffered blocks are not
+	visible to attribute queries before then. Gurobi allows constraints to
+	refer to variables which were added but not yet updated.
+*/
+
+// DefaultModelWriterBlockSize is the default number of nonzeros (or variables) per block.
+const DefaultModelWriterBlockSize = 1 << 20
+
+/*
+ModelWriter
+Description:
+
+	Streams variables and constraints into Model in blocks of at most
+	BlockSize variables, constraints or nonzeros.
+*/
+type ModelWriter struct {
+	Model     *Model
+	BlockSize int
+
+	// The buffered variables.
+	vtypes []int8
+	objs   []float64
+	lbs    []float64
+	ubs    []float64
+	names  []string
+
+	// The buffered constraints, in CSR format.
+	beg         []int32
+	ind         []int32
+	val         []float64
+	senses      []int8
+	rhs         []float64
+	constrNames []string
+
+	numVars    int32
+	numConstrs int32
+	numNZs     int64
+	flushes    int
+	closed     bool
+}
+
+/*
+NewModelWriter
+Description:
+
+	Creates a ModelWriter for model which flushes a block once it holds
+	blockSize variables, constraints or nonzeros (DefaultModelWriterBlockSize
+	if blockSize is 0).
+*/
+func NewModelWriter(model *Model, blockSize int) (*ModelWriter, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if blockSize < 0 {
+		return nil, fmt.Errorf("the block size must be nonnegative; received %v", blockSize)
+	}
+	if blockSize == 0 {
+		blockSize = DefaultModelWriterBlockSize
+	}
+
+	return &ModelWriter{
+		Model:      model,
+		BlockSize:  blockSize,
+		numVars:    int32(len(model.Variables)),
+		numConstrs: int32(len(model.Constraints)),
+	}, nil
+}
+
+/*
+AddVar
+Description:
+
+	Buffers a variable and returns its index, which can be used in the
+	constraints added afterwards.
+*/
+func (w *ModelWriter) AddVar(vtype VarType, obj float64, lb float64, ub float64, name string) (int32, error) {
+	if w.closed {
+		return -1, errors.New("the model writer is closed")
+	}
+	if err := vtype.Check(); err != nil {
+		return -1, err
+	}
+	if err := checkFinite("the objective coefficient", obj); err != nil {
+		return -1, err
+	}
+	if math.IsNaN(lb) || math.IsNaN(ub) || lb > ub {
+		return -1, fmt.Errorf("invalid bounds [%v, %v] for variable %v", lb, ub, w.numVars)
+	}
+
+	if len(w.vtypes) >= w.BlockSize {
+		if err := w.flushVars(); err != nil {
+			return -1, err
+		}
+	}
+
+	w.vtypes = append(w.vtypes, int8(vtype))
+	w.objs = append(w.objs, obj)
+	w.lbs = append(w.lbs, lb)
+	w.ubs = append(w.ubs, ub)
+	w.names = append(w.names, name)
+	w.numVars++
+	return w.numVars - 1, nil
+}
+
+/*
+AddConstr
+Description:
+
+	Buffers the constraint sum_i val[i] * x[ind[i]] (sense) rhs and returns
+	its index. The indices may refer to buffered variables.
+*/
+func (w *ModelWriter) AddConstr(ind []int32, val []float64, sense Sense, rhs float64, name string) (int32, error) {
+	if w.closed {
+		return -1, errors.New("the model writer is closed")
+	}
+	if err := sense.Check(); err != nil {
+		return -1, err
+	}
+	if len(ind) != len(val) {
+		return -1, MismatchedLengthError{
+			Length1: len(ind),
+			Name1:   "ind",
+			Length2: len(val),
+			Name2:   "val",
+		}
+	}
+	for i := range ind {
+		if ind[i] < 0 || ind[i] >= w.numVars {
+			return -1, fmt.Errorf("the variable index %v at position %v does not belong to the model", ind[i], i)
+		}
+		if err := checkFinite(fmt.Sprintf("the coefficient at position %v", i), val[i]); err != nil {
+			return -1, err
+		}
+	}
+	if err := checkFinite("the right-hand side", rhs); err != nil {
+		return -1, err
+	}
+
+	if len(w.senses) > 0 && (len(w.senses) >= w.BlockSize || len(w.ind)+len(ind) > w.BlockSize) {
+		if err := w.flushConstrs(); err != nil {
+			return -1, err
+		}
+	}
+
+	w.beg = append(w.beg, int32(len(w.ind)))
+	w.ind = append(w.ind, ind...)
+	w.val = append(w.val, val...)
+	w.senses = append(w.senses, int8(sense))
+	w.rhs = append(w.rhs, rhs)
+	w.constrNames = append(w.constrNames, name)
+	w.numNZs += int64(len(ind))
+	w.numConstrs++
+	return w.numConstrs - 1, nil
+}
+
+/*
+Flush
+Description:
+
+	Adds the buffered variables and constraints to the model.
+*/
+func (w *ModelWriter) Flush() error {
+	if err := w.flushVars(); err != nil {
+		return err
+	}
+	return w.flushConstrs()
+}
+
+/*
+Close
+Description:
+
+	Flushes the remaining buffers and updates the model. The writer can not
+	be used afterwards.
+*/
+func (w *ModelWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	w.closed = true
+	return w.Model.Update()
+}
+
+/*
+Flushes
+Description:
+
+	Returns the number of blocks which have been added to the model.
+*/
+func (w *ModelWriter) Flushes() int {
+	return w.flushes
+}
+
+/*
+NumNZs
+Description:
+
+	Returns the number of nonzeros of all constraints added through the writer.
+*/
+func (w *ModelWriter) NumNZs() int64 {
+	return w.numNZs
+}
+
+// flushVars adds the buffered variables with a single GRBaddvars call.
+func (w *ModelWriter) flushVars() error {
+	model := w.Model
+	n := len(w.vtypes)
+	if n == 0 {
+		return nil
+	}
+	if err := model.Check(); err != nil {
+		return err
+	}
+
+	names := model.varNames(w.names)
+	if model.dryRun != nil {
+		types := make([]VarType, n)
+		for i, vtype := range w.vtypes {
+			types[i] = VarType(vtype)
+		}
+		if err := model.dryRun.addVars("ModelWriter", types, w.objs, w.lbs, w.ubs, nil, nil); err != nil {
+			return err
+		}
+	} else {
+		args := &cgoArgs{}
+		defer args.free()
+
+		done := traceCall("GRBaddvars", n)
+		errCode := C.GRBaddvars(
+			model.AsGRBModel, C.int(n), 0, nil, nil, nil,
+			args.doubles(w.objs), args.doubles(w.lbs), args.doubles(w.ubs), args.chars(w.vtypes), args.strings(names),
+		)
+		done(errCode)
+		if errCode != 0 {
+			return model.MakeError(errCode)
+		}
+	}
+
+	model.changes.newVars += n
+	model.emitItems(EventVarsAdded, len(model.Variables), n)
+	model.appendVars(n, names)
+
+	w.vtypes, w.objs, w.lbs, w.ubs, w.names = w.vtypes[:0], w.objs[:0], w.lbs[:0], w.ubs[:0], w.names[:0]
+	w.flushes++
+	return nil
+}
+
+// flushConstrs adds the buffered constraints with a single GRBaddconstrs
+// call, after the variables which they may refer to.
+func (w *ModelWriter) flushConstrs() error {
+	model := w.Model
+	n := len(w.senses)
+	if n == 0 {
+		return nil
+	}
+	if err := w.flushVars(); err != nil {
+		return err
+	}
+	if err := model.Check(); err != nil {
+		return err
+	}
+
+	if model.dryRun != nil {
+		for i := 0; i < n; i++ {
+			end := int32(len(w.ind))
+			if i+1 < n {
+				end = w.beg[i+1]
+			}
+			vars := make([]*Var, 0, end-w.beg[i])
+			for _, j := range w.ind[w.beg[i]:end] {
+				vars = append(vars, model.vars[j])
+			}
+			if err := model.dryRun.addConstr("ModelWriter", vars, w.val[w.beg[i]:end], Sense(w.senses[i]), w.rhs[i]); err != nil {
+				return fmt.Errorf("constraint %v: %w", len(model.Constraints)+i, err)
+			}
+		}
+	} else {
+		args := &cgoArgs{}
+		defer args.free()
+
+		done := traceCall("GRBaddconstrs", len(w.ind))
+		errCode := C.GRBaddconstrs(
+			model.AsGRBModel, C.int(n), C.int(len(w.ind)),
+			args.ints(w.beg), args.ints(w.ind), args.doubles(w.val),
+			args.chars(w.senses), args.doubles(w.rhs), args.strings(w.constrNames),
+		)
+		done(errCode)
+		if errCode != 0 {
+			return model.MakeError(errCode)
+		}
+	}
+
+	model.changes.newConstrs += n
+	model.emitItems(EventConstrsAdded, len(model.Constraints), n)
+	model.appendConstrs(n, w.constrNames)
+
+	w.beg, w.ind, w.val = w.beg[:0], w.ind[:0], w.val[:0]
+	w.senses, w.rhs, w.constrNames = w.senses[:0], w.rhs[:0], w.constrNames[:0]
+	w.flushes++
+	return nil
+}
@@ -0,0 +1,160 @@
+package gurobi
+
+import "sync"
+
+/*
+monitor.go
+Description:
+	A Monitor records the progress of a solve from the model's callback, so
+	that other goroutines (e.g., a dashboard or a progress bar) can read it
+	while the solve is running. Querying the model itself during a solve is
+	not safe (see callbackguard.go); reading a Monitor is.
+*/
+
+/*
+MonitorSnapshot
+Description:
+
+	The progress of a solve as of the last callback.
+	- Where: The where code of the last callback.
+	- Solving: True while the model is being solved.
+	- Callbacks: The number of callbacks which have been seen.
+*/
+type MonitorSnapshot struct {
+	ConvergencePoint
+	Where     Where
+	Solving   bool
+	Callbacks int
+}
+
+/*
+Monitor
+Description:
+
+	Collects the progress of a solve from the callback. All of its methods
+	may be called from any goroutine.
+*/
+type Monitor struct {
+	mu    sync.Mutex
+	model *Model
+	last  MonitorSnapshot
+}
+
+/*
+NewMonitor
+Description:
+
+	Creates a Monitor which has not seen a callback yet.
+*/
+func NewMonitor() *Monitor {
+	return &Monitor{
+		last: MonitorSnapshot{
+			ConvergencePoint: ConvergencePoint{Incumbent: INFINITY, Bound: -INFINITY},
+		},
+	}
+}
+
+/*
+Install
+Description:
+
+	Registers the monitor's callback on the model, replacing any previous callback.
+*/
+func (m *Monitor) Install(model *Model) error {
+	return m.InstallWith(model, nil)
+}
+
+/*
+InstallWith
+Description:
+
+	Registers the monitor's callback on the model, calling next (if it is
+	not nil) after the progress is recorded.
+*/
+func (m *Monitor) InstallWith(model *Model, next CallbackFunc) error {
+	m.mu.Lock()
+	m.model = model
+	m.mu.Unlock()
+
+	return model.SetCallback(m.Wrap(next))
+}
+
+/*
+Callback
+Description:
+
+	Returns the CallbackFunc which records the progress.
+*/
+func (m *Monitor) Callback() CallbackFunc {
+	return m.Wrap(nil)
+}
+
+/*
+Wrap
+Description:
+
+	Returns a CallbackFunc which records the progress and then calls next
+	(if it is not nil).
+*/
+func (m *Monitor) Wrap(next CallbackFunc) CallbackFunc {
+	return func(cb *CallbackContext) error {
+		if err := m.record(cb); err != nil {
+			return err
+		}
+		if next != nil {
+			return next(cb)
+		}
+		return nil
+	}
+}
+
+// record reads the progress which is available for the where code of cb.
+func (m *Monitor) record(cb *CallbackContext) error {
+	var p ConvergencePoint
+	hasRuntime, hasMIP := cb.CanCall("Runtime"), cb.CanCall("MIPProgress")
+	if hasRuntime {
+		runtime, err := cb.Runtime()
+		if err != nil {
+			return err
+		}
+		p.Time = runtime
+	}
+	if hasMIP {
+		incumbent, bound, nodes, err := cb.MIPProgress()
+		if err != nil {
+			return err
+		}
+		p.Incumbent, p.Bound, p.Nodes = incumbent, bound, nodes
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.model == nil {
+		m.model = cb.Model
+	}
+	m.last.Where = cb.Where
+	m.last.Callbacks++
+	if hasRuntime {
+		m.last.Time = p.Time
+	}
+	if hasMIP {
+		m.last.Incumbent, m.last.Bound, m.last.Nodes = p.Incumbent, p.Bound, p.Nodes
+	}
+	return nil
+}
+
+/*
+Snapshot
+Description:
+
+	Returns the progress as of the last callback.
+*/
+func (m *Monitor) Snapshot() MonitorSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := m.last
+	snapshot.Solving = m.model.Solving()
+	return snapshot
+}
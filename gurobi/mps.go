@@ -0,0 +1,390 @@
+package gurobi
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+/*
+mps.go
+Description:
+	A pure-Go reader for the MPS format, which fills a ModelSpec without a
+	Gurobi environment. Both fixed and free MPS are read, as long as names
+	do not contain spaces.
+Notes:
+	Sections which a ModelSpec cannot represent (quadratic constraints, SOS,
+	indicator and general constraints) are rejected with an error. A ranged
+	row is read as its constraint plus a second constraint named
+	"<row>_range" which holds the other side of the range.
+*/
+
+/*
+ReadModelSpecMPS
+Description:
+
+	Reads a model in the MPS format from r and validates it.
+	As in Gurobi, variables default to the bounds [0, INFINITY], integer
+	variables between the INTORG and INTEND markers to [0, INFINITY], and a
+	negative upper bound on a variable with a lower bound of 0 makes the
+	lower bound -INFINITY.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/mps_format.html
+*/
+func ReadModelSpecMPS(r io.Reader) (*ModelSpec, error) {
+	reader := &mpsReader{
+		spec:      NewModelSpec(""),
+		rows:      map[string]int32{},
+		cols:      map[string]int32{},
+		hasBounds: map[int32]bool{},
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		if err := reader.readLine(scanner.Text()); err != nil {
+			return nil, fmt.Errorf("MPS line %v: %w", lineNumber, err)
+		}
+		if reader.section == "ENDATA" {
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	reader.addRanges()
+	if err := reader.spec.Check(); err != nil {
+		return nil, err
+	}
+	return reader.spec, nil
+}
+
+// mpsReader holds the state of ReadModelSpecMPS between lines.
+type mpsReader struct {
+	spec    *ModelSpec
+	section string
+
+	// objName is the name of the objective row; rows maps the other row names to constraints.
+	objName string
+	rows    map[string]int32
+	cols    map[string]int32
+
+	integer   bool
+	hasBounds map[int32]bool
+	ranges    map[int32]float64
+}
+
+func (reader *mpsReader) readLine(line string) error {
+	if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "*") {
+		return nil
+	}
+
+	fields := strings.Fields(line)
+	if line[0] != ' ' && line[0] != '\t' {
+		// A section header, which may carry a value (e.g., "NAME model" or "OBJSENSE MAX").
+		reader.section = strings.ToUpper(fields[0])
+		switch reader.section {
+		case "NAME":
+			if len(fields) > 1 {
+				reader.spec.Name = fields[1]
+			}
+		case "OBJSENSE":
+			if len(fields) > 1 {
+				return reader.readObjSense(fields[1])
+			}
+		case "ROWS", "COLUMNS", "RHS", "RANGES", "BOUNDS", "QUADOBJ", "QMATRIX", "ENDATA":
+		case "QCMATRIX", "SOS", "INDICATORS", "GENCONS", "PWLOBJ":
+			return fmt.Errorf("the %v section is not supported by a ModelSpec", reader.section)
+		default:
+			return fmt.Errorf("unknown section %v", fields[0])
+		}
+		return nil
+	}
+
+	switch reader.section {
+	case "OBJSENSE":
+		return reader.readObjSense(fields[0])
+	case "ROWS":
+		return reader.readRow(fields)
+	case "COLUMNS":
+		return reader.readColumn(fields)
+	case "RHS":
+		return reader.readPairs(fields, reader.setRHS)
+	case "RANGES":
+		return reader.readPairs(fields, reader.setRange)
+	case "BOUNDS":
+		return reader.readBound(fields)
+	case "QUADOBJ", "QMATRIX":
+		return reader.readQTerm(fields)
+	}
+	return fmt.Errorf("unexpected data outside of a section")
+}
+
+func (reader *mpsReader) readObjSense(value string) error {
+	switch strings.ToUpper(value) {
+	case "MIN", "MINIMIZE":
+		reader.spec.Sense = Minimize
+	case "MAX", "MAXIMIZE":
+		reader.spec.Sense = Maximize
+	default:
+		return fmt.Errorf("unknown objective sense %v", value)
+	}
+	return nil
+}
+
+func (reader *mpsReader) readRow(fields []string) error {
+	if len(fields) != 2 {
+		return fmt.Errorf("expected a row type and a name; received %v", fields)
+	}
+
+	var sense Sense
+	switch strings.ToUpper(fields[0]) {
+	case "N":
+		// Only the first free row is the objective; other free rows are ignored.
+		if reader.objName == "" {
+			reader.objName = fields[1]
+		} else {
+			reader.rows[fields[1]] = -1
+		}
+		return nil
+	case "L":
+		sense = Le
+	case "G":
+		sense = Ge
+	case "E":
+		sense = Eq
+	default:
+		return fmt.Errorf("unknown row type %v", fields[0])
+	}
+
+	if _, ok := reader.rows[fields[1]]; ok {
+		return fmt.Errorf("the row %v is defined twice", fields[1])
+	}
+	reader.rows[fields[1]] = reader.spec.AddConstr(nil, nil, sense, 0.0, fields[1])
+	return nil
+}
+
+func (reader *mpsReader) readColumn(fields []string) error {
+	if len(fields) == 3 && strings.Trim(fields[1], "'") == "MARKER" {
+		switch strings.Trim(fields[2], "'") {
+		case "INTORG":
+			reader.integer = true
+		case "INTEND":
+			reader.integer = false
+		default:
+			return fmt.Errorf("unknown marker %v", fields[2])
+		}
+		return nil
+	}
+
+	j, ok := reader.cols[fields[0]]
+	if !ok {
+		vtype := Continuous
+		if reader.integer {
+			vtype = Integer
+		}
+		j = reader.spec.AddVar(vtype, 0.0, 0.0, INFINITY, fields[0])
+		reader.cols[fields[0]] = j
+	}
+
+	return reader.readPairs(fields, func(row string, value float64) error {
+		if row == reader.objName {
+			reader.spec.Vars[j].Obj = value
+			return nil
+		}
+		i, ok := reader.rows[row]
+		if !ok {
+			return fmt.Errorf("unknown row %v", row)
+		}
+		if i < 0 {
+			return nil
+		}
+		constr := &reader.spec.Constrs[i]
+		constr.Ind = append(constr.Ind, j)
+		constr.Val = append(constr.Val, value)
+		return nil
+	})
+}
+
+// readPairs calls fn for each (row, value) pair after the first field of a line.
+func (reader *mpsReader) readPairs(fields []string, fn func(row string, value float64) error) error {
+	// The set name of the RHS and RANGES sections may be omitted in free MPS.
+	if len(fields)%2 == 0 {
+		fields = append([]string{""}, fields...)
+	}
+	if len(fields) < 3 {
+		return fmt.Errorf("expected a name and at least one (row, value) pair; received %v", fields)
+	}
+
+	for k := 1; k+1 < len(fields); k += 2 {
+		value, err := parseMPSNumber(fields[k+1])
+		if err != nil {
+			return err
+		}
+		if err := fn(fields[k], value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (reader *mpsReader) setRHS(row string, value float64) error {
+	if row == reader.objName {
+		// The right-hand side of the objective is the negated objective constant.
+		reader.spec.ObjCon = -value
+		return nil
+	}
+	i, ok := reader.rows[row]
+	if !ok {
+		return fmt.Errorf("unknown row %v", row)
+	}
+	if i >= 0 {
+		reader.spec.Constrs[i].RHS = value
+	}
+	return nil
+}
+
+func (reader *mpsReader) setRange(row string, value float64) error {
+	i, ok := reader.rows[row]
+	if !ok {
+		return fmt.Errorf("unknown row %v", row)
+	}
+	if i < 0 {
+		return nil
+	}
+	if reader.ranges == nil {
+		reader.ranges = map[int32]float64{}
+	}
+	reader.ranges[i] = value
+	return nil
+}
+
+// addRanges adds the other side of each ranged row as a constraint of its own.
+func (reader *mpsReader) addRanges() {
+	numConstrs := int32(len(reader.spec.Constrs))
+	for i := int32(0); i < numConstrs; i++ {
+		r, ok := reader.ranges[i]
+		if !ok {
+			continue
+		}
+
+		constr := reader.spec.Constrs[i]
+		sense, rhs := Ge, constr.RHS-math.Abs(r)
+		if constr.Sense == Ge || (constr.Sense == Eq && r > 0) {
+			sense, rhs = Le, constr.RHS+math.Abs(r)
+		}
+		if constr.Sense == Eq {
+			// An equality with a range becomes an inequality on its original side.
+			reader.spec.Constrs[i].Sense = Ge
+			if sense == Ge {
+				reader.spec.Constrs[i].Sense = Le
+			}
+		}
+		reader.spec.AddConstr(constr.Ind, constr.Val, sense, rhs, constr.Name+"_range")
+	}
+}
+
+func (reader *mpsReader) readBound(fields []string) error {
+	boundType := strings.ToUpper(fields[0])
+	needsValue := boundType != "FR" && boundType != "MI" && boundType != "PL" && boundType != "BV"
+	// The set name of the bound may be omitted in free MPS.
+	if (needsValue && len(fields) == 3) || (!needsValue && len(fields) == 2) {
+		fields = append([]string{fields[0], ""}, fields[1:]...)
+	}
+	if len(fields) < 3 || (needsValue && len(fields) < 4) {
+		return fmt.Errorf("incomplete bound %v", fields)
+	}
+
+	j, ok := reader.cols[fields[2]]
+	if !ok {
+		return fmt.Errorf("unknown column %v", fields[2])
+	}
+	v := &reader.spec.Vars[j]
+
+	value := 0.0
+	if needsValue {
+		var err error
+		if value, err = parseMPSNumber(fields[3]); err != nil {
+			return err
+		}
+	}
+
+	switch boundType {
+	case "UP", "UI":
+		if value < 0 && v.LB == 0 && !reader.hasBounds[j] {
+			v.LB = -INFINITY
+		}
+		v.UB = value
+	case "LO", "LI":
+		v.LB = value
+	case "FX":
+		v.LB, v.UB = value, value
+	case "FR":
+		v.LB, v.UB = -INFINITY, INFINITY
+	case "MI":
+		v.LB = -INFINITY
+	case "PL":
+		v.UB = INFINITY
+	case "BV":
+		v.Type, v.LB, v.UB = Binary, 0.0, 1.0
+	case "SC":
+		v.Type, v.UB = SemiCont, value
+	default:
+		return fmt.Errorf("unknown bound type %v", fields[0])
+	}
+	if boundType == "UI" || boundType == "LI" {
+		v.Type = Integer
+	}
+	reader.hasBounds[j] = true
+	return nil
+}
+
+func (reader *mpsReader) readQTerm(fields []string) error {
+	if len(fields) != 3 {
+		return fmt.Errorf("expected two columns and a value; received %v", fields)
+	}
+
+	row, ok := reader.cols[fields[0]]
+	if !ok {
+		return fmt.Errorf("unknown column %v", fields[0])
+	}
+	col, ok := reader.cols[fields[1]]
+	if !ok {
+		return fmt.Errorf("unknown column %v", fields[1])
+	}
+	value, err := parseMPSNumber(fields[2])
+	if err != nil {
+		return err
+	}
+
+	// The objective is 0.5 x'Qx. QMATRIX lists every entry of Q, while QUADOBJ
+	// only lists its lower triangle, so an off-diagonal entry there stands for two.
+	if reader.section == "QUADOBJ" && row != col {
+		value *= 2
+	}
+	reader.spec.AddQTerm(row, col, 0.5*value)
+	return nil
+}
+
+// parseMPSNumber parses a number of an MPS or LP file, mapping infinite values to ±INFINITY.
+func parseMPSNumber(s string) (float64, error) {
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%v is not a number", s)
+	}
+	if value >= INFINITY {
+		return INFINITY, nil
+	}
+	if value <= -INFINITY {
+		return -INFINITY, nil
+	}
+	return value, nil
+}
@@ -0,0 +1,361 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"errors"
+	"fmt"
+)
+
+/*
+multiobj.go
+Description:
+	Functions for working with models that have multiple objectives.
+Notes:
+	The attributes of the objectives of a multi-objective model are queried by
+	first setting the ObjNumber parameter to the index of the objective.
+	https://www.gurobi.com/documentation/current/refman/working_with_multiple_obje.html
+*/
+
+const INT_ATTR_NUMOBJ = "NumObj"
+const DBL_ATTR_OBJN = "ObjN"
+const DBL_ATTR_OBJNVAL = "ObjNVal"
+const STR_ATTR_OBJNNAME = "ObjNName"
+const INT_ATTR_OBJNPRIORITY = "ObjNPriority"
+const DBL_ATTR_OBJNWEIGHT = "ObjNWeight"
+const DBL_ATTR_OBJNRELTOL = "ObjNRelTol"
+const DBL_ATTR_OBJNABSTOL = "ObjNAbsTol"
+
+// MultiObjMethod is the algorithm used to warm start each pass after the first
+// in a hierarchical multi-objective solve (the MultiObjMethod parameter).
+type MultiObjMethod int
+
+const (
+	MultiObjMethodAuto          MultiObjMethod = -1
+	MultiObjMethodPrimalSimplex MultiObjMethod = 0
+	MultiObjMethodDualSimplex   MultiObjMethod = 1
+	MultiObjMethodIgnoreWarm    MultiObjMethod = 2
+)
+
+// MultiObjPre controls the initial presolve level of a multi-objective solve
+// (the MultiObjPre parameter).
+type MultiObjPre int
+
+const (
+	MultiObjPreAuto         MultiObjPre = -1
+	MultiObjPreOff          MultiObjPre = 0
+	MultiObjPreConservative MultiObjPre = 1
+	MultiObjPreAggressive   MultiObjPre = 2
+)
+
+/*
+ObjectiveResult
+Description:
+
+	The value achieved for a single objective of a multi-objective model,
+	together with its priority and weight.
+*/
+type ObjectiveResult struct {
+	Index    int
+	Name     string
+	Priority int32
+	Weight   float64
+	Value    float64
+}
+
+/*
+NumObj
+Description:
+
+	Returns the number of objectives in the model (the NumObj attribute).
+*/
+func (model *Model) NumObj() (int32, error) {
+	return model.GetIntAttr(INT_ATTR_NUMOBJ)
+}
+
+/*
+SetObjectiveN
+Description:
+
+	Sets objective index of a multi-objective model to expr. Objectives
+	with a higher priority are optimized first (hierarchical objectives);
+	objectives with the same priority are blended, using their weights.
+	absTol and relTol are the amounts by which this objective may degrade
+	when objectives with a lower priority are optimized.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_setobjectiven.html
+*/
+func (model *Model) SetObjectiveN(expr *LinExpr, index, priority int, weight, absTol, relTol float64, name string) error {
+	// Input Processing
+	if err := model.checkFor("SetObjectiveN"); err != nil {
+		return err
+	}
+
+	if expr == nil {
+		return errors.New("the objective expression is nil")
+	}
+	if index < 0 {
+		return fmt.Errorf("the objective index must be nonnegative; received %v", index)
+	}
+	if err := checkFinite("the weight", weight); err != nil {
+		return err
+	}
+	if !(absTol >= 0) {
+		return fmt.Errorf("the absolute tolerance must be nonnegative; received %v", absTol)
+	}
+	if !(relTol >= 0) {
+		return fmt.Errorf("the relative tolerance must be nonnegative; received %v", relTol)
+	}
+
+	if model.dryRun != nil {
+		if err := model.dryRun.checkTerms(expr.Ind, expr.Val); err != nil {
+			return err
+		}
+		if index >= model.dryRun.NumObj {
+			model.dryRun.NumObj = index + 1
+		}
+		model.dryRun.record("SetObjectiveN", "objective %v, priority %v, %v terms", index, priority, len(expr.Ind))
+		return nil
+	}
+
+	if len(expr.Ind) != len(expr.Val) {
+		return MismatchedLengthError{
+			Length1: len(expr.Ind),
+			Name1:   "expr.Ind",
+			Length2: len(expr.Val),
+			Name2:   "expr.Val",
+		}
+	}
+	ind := make([]int32, len(expr.Ind))
+	for i, v := range expr.Ind {
+		if v == nil || v.Index < 0 {
+			return fmt.Errorf("the variable at position %v of the objective is invalid", i)
+		}
+		ind[i] = v.Index
+	}
+
+	// Algorithm
+	args := &cgoArgs{}
+	defer args.free()
+
+	done := traceCall("GRBsetobjectiven", index, len(ind))
+	errCode := C.GRBsetobjectiven(
+		model.AsGRBModel, C.int(index), C.int(priority), C.double(weight),
+		C.double(absTol), C.double(relTol), args.str(name), C.double(expr.Offset),
+		C.int(len(ind)), args.ints(ind), args.doubles(expr.Val),
+	)
+	done(errCode)
+	if errCode != 0 {
+		return model.MakeError(errCode)
+	}
+	model.changes.attr(DBL_ATTR_OBJN)
+
+	return nil
+}
+
+/*
+SetObjNumber
+Description:
+
+	Selects the objective (by its index) whose ObjN* attributes will be
+	queried or modified next.
+*/
+func (model *Model) SetObjNumber(i int) error {
+	numObj, err := model.NumObj()
+	if err != nil {
+		return err
+	}
+
+	if i < 0 || i >= int(numObj) {
+		return fmt.Errorf("the objective index %v is out of range; the model has %v objectives", i, numObj)
+	}
+
+	return model.SetIntParam("ObjNumber", i)
+}
+
+/*
+withObjNumber
+Description:
+
+	Selects objective i, calls fn and then restores the ObjNumber parameter
+	to the objective which was selected before, so that reading or writing
+	the ObjN* attributes of one objective does not change which objective
+	the caller has selected.
+*/
+func (model *Model) withObjNumber(i int, fn func() error) error {
+	return model.withSelection("ObjNumber", func() error { return model.SetObjNumber(i) }, fn)
+}
+
+/*
+GetObjNVal
+Description:
+
+	Returns the value that the current solution achieves for objective i
+	(the ObjNVal attribute).
+*/
+func (model *Model) GetObjNVal(i int) (float64, error) {
+	var val float64
+	err := model.withObjNumber(i, func() (err error) {
+		val, err = model.GetDoubleAttr(DBL_ATTR_OBJNVAL)
+		return err
+	})
+
+	return val, err
+}
+
+/*
+GetObjN
+Description:
+
+	Returns the coefficients of vars in objective i (the ObjN attribute).
+*/
+func (model *Model) GetObjN(i int, vars []*Var) ([]float64, error) {
+	var coeffs []float64
+	err := model.withObjNumber(i, func() (err error) {
+		coeffs, err = model.GetDoubleAttrVars(DBL_ATTR_OBJN, vars)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return coeffs, nil
+}
+
+/*
+ObjectiveResults
+Description:
+
+	Returns the name, priority, weight and achieved value of every objective in
+	the model, in order of their index. For hierarchical objectives, this is
+	the value achieved at every priority level.
+*/
+func (model *Model) ObjectiveResults() ([]ObjectiveResult, error) {
+	numObj, err := model.NumObj()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ObjectiveResult, numObj)
+	for i := range results {
+		result := ObjectiveResult{Index: i}
+		err := model.withObjNumber(i, func() (err error) {
+			if result.Name, err = model.GetStringAttr(STR_ATTR_OBJNNAME); err != nil {
+				return err
+			}
+			if result.Priority, err = model.GetIntAttr(INT_ATTR_OBJNPRIORITY); err != nil {
+				return err
+			}
+			if result.Weight, err = model.GetDoubleAttr(DBL_ATTR_OBJNWEIGHT); err != nil {
+				return err
+			}
+			result.Value, err = model.GetDoubleAttr(DBL_ATTR_OBJNVAL)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		results[i] = result
+	}
+
+	return results, nil
+}
+
+/*
+SetObjNRelTol
+Description:
+
+	Sets the relative amount by which objective i is allowed to degrade when
+	the objectives with lower priority are optimized (the ObjNRelTol attribute).
+*/
+func (model *Model) SetObjNRelTol(i int, tol float64) error {
+	if tol < 0 {
+		return fmt.Errorf("the relative tolerance must be nonnegative; received %v", tol)
+	}
+
+	return model.withObjNumber(i, func() error {
+		return model.SetDoubleAttr(DBL_ATTR_OBJNRELTOL, tol)
+	})
+}
+
+/*
+GetObjNRelTol
+Description:
+
+	Returns the relative degradation allowed for objective i (the ObjNRelTol attribute).
+*/
+func (model *Model) GetObjNRelTol(i int) (float64, error) {
+	var tol float64
+	err := model.withObjNumber(i, func() (err error) {
+		tol, err = model.GetDoubleAttr(DBL_ATTR_OBJNRELTOL)
+		return err
+	})
+
+	return tol, err
+}
+
+/*
+SetObjNAbsTol
+Description:
+
+	Sets the absolute amount by which objective i is allowed to degrade when
+	the objectives with lower priority are optimized (the ObjNAbsTol attribute).
+*/
+func (model *Model) SetObjNAbsTol(i int, tol float64) error {
+	if tol < 0 {
+		return fmt.Errorf("the absolute tolerance must be nonnegative; received %v", tol)
+	}
+
+	return model.withObjNumber(i, func() error {
+		return model.SetDoubleAttr(DBL_ATTR_OBJNABSTOL, tol)
+	})
+}
+
+/*
+GetObjNAbsTol
+Description:
+
+	Returns the absolute degradation allowed for objective i (the ObjNAbsTol attribute).
+*/
+func (model *Model) GetObjNAbsTol(i int) (float64, error) {
+	var tol float64
+	err := model.withObjNumber(i, func() (err error) {
+		tol, err = model.GetDoubleAttr(DBL_ATTR_OBJNABSTOL)
+		return err
+	})
+
+	return tol, err
+}
+
+/*
+SetMultiObjMethod
+Description:
+
+	Sets the algorithm used to warm start the passes of a hierarchical
+	multi-objective solve (the MultiObjMethod parameter).
+*/
+func (model *Model) SetMultiObjMethod(method MultiObjMethod) error {
+	if method < MultiObjMethodAuto || method > MultiObjMethodIgnoreWarm {
+		return fmt.Errorf("the MultiObjMethod %v is not valid; expected a value between %v and %v", method, MultiObjMethodAuto, MultiObjMethodIgnoreWarm)
+	}
+
+	return model.SetIntParam("MultiObjMethod", int(method))
+}
+
+/*
+SetMultiObjPre
+Description:
+
+	Sets the presolve level used at the start of a multi-objective solve
+	(the MultiObjPre parameter).
+*/
+func (model *Model) SetMultiObjPre(level MultiObjPre) error {
+	if level < MultiObjPreAuto || level > MultiObjPreAggressive {
+		return fmt.Errorf("the MultiObjPre level %v is not valid; expected a value between %v and %v", level, MultiObjPreAuto, MultiObjPreAggressive)
+	}
+
+	return model.SetIntParam("MultiObjPre", int(level))
+}
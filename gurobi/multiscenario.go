@@ -0,0 +1,239 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"errors"
+	"fmt"
+)
+
+/*
+multiscenario.go
+Description:
+	Functions for working with multi-scenario models: a base model together
+	with NumScenarios scenarios, each of which changes some bounds, objective
+	coefficients or right-hand sides, and which are all solved in a single
+	optimization.
+Notes:
+	The attributes of a scenario are queried or modified by first setting the
+	ScenarioNumber parameter to its index. A value of UNDEFINED in a ScenN*
+	attribute means that the scenario keeps the value of the base model.
+	https://www.gurobi.com/documentation/current/refman/multiple_scenarios.html
+*/
+
+const INT_ATTR_NUMSCENARIOS = "NumScenarios"
+const DBL_ATTR_SCENNLB = "ScenNLB"
+const DBL_ATTR_SCENNUB = "ScenNUB"
+const DBL_ATTR_SCENNOBJ = "ScenNObj"
+const DBL_ATTR_SCENNRHS = "ScenNRHS"
+const STR_ATTR_SCENNNAME = "ScenNName"
+const DBL_ATTR_SCENNOBJVAL = "ScenNObjVal"
+const DBL_ATTR_SCENNOBJBOUND = "ScenNObjBound"
+const DBL_ATTR_SCENNX = "ScenNX"
+
+/*
+NumScenarios
+Description:
+
+	Returns the number of scenarios in the model (the NumScenarios attribute).
+*/
+func (model *Model) NumScenarios() (int32, error) {
+	return model.GetIntAttr(INT_ATTR_NUMSCENARIOS)
+}
+
+/*
+SetNumScenarios
+Description:
+
+	Sets the number of scenarios of the model. 0 turns the model back into a
+	single-scenario model.
+*/
+func (model *Model) SetNumScenarios(n int) error {
+	if n < 0 {
+		return fmt.Errorf("the number of scenarios must be nonnegative; received %v", n)
+	}
+
+	return model.SetIntAttr(INT_ATTR_NUMSCENARIOS, int32(n))
+}
+
+/*
+SetScenarioNumber
+Description:
+
+	Selects the scenario (by its index) whose ScenN* attributes will be
+	queried or modified next. The model is updated first, so that a number
+	of scenarios which was just set is taken into account.
+*/
+func (model *Model) SetScenarioNumber(i int) error {
+	if err := model.Update(); err != nil {
+		return err
+	}
+
+	numScenarios, err := model.NumScenarios()
+	if err != nil {
+		return err
+	}
+
+	if i < 0 || i >= int(numScenarios) {
+		return fmt.Errorf("the scenario index %v is out of range; the model has %v scenarios", i, numScenarios)
+	}
+
+	return model.SetIntParam("ScenarioNumber", i)
+}
+
+/*
+withScenarioNumber
+Description:
+
+	Selects scenario i, calls fn and then restores the ScenarioNumber
+	parameter to the scenario which was selected before.
+*/
+func (model *Model) withScenarioNumber(i int, fn func() error) error {
+	return model.withSelection("ScenarioNumber", func() error { return model.SetScenarioNumber(i) }, fn)
+}
+
+/*
+SetScenNLB
+Description:
+
+	Sets the lower bound of v in scenario i (the ScenNLB attribute).
+*/
+func (model *Model) SetScenNLB(i int, v *Var, lb float64) error {
+	return model.setScenVarAttr(i, DBL_ATTR_SCENNLB, v, lb)
+}
+
+/*
+SetScenNUB
+Description:
+
+	Sets the upper bound of v in scenario i (the ScenNUB attribute).
+*/
+func (model *Model) SetScenNUB(i int, v *Var, ub float64) error {
+	return model.setScenVarAttr(i, DBL_ATTR_SCENNUB, v, ub)
+}
+
+/*
+SetScenNObj
+Description:
+
+	Sets the objective coefficient of v in scenario i (the ScenNObj attribute).
+*/
+func (model *Model) SetScenNObj(i int, v *Var, obj float64) error {
+	return model.setScenVarAttr(i, DBL_ATTR_SCENNOBJ, v, obj)
+}
+
+/*
+SetScenNRHS
+Description:
+
+	Sets the right-hand side of the linear constraint c in scenario i (the
+	ScenNRHS attribute).
+*/
+func (model *Model) SetScenNRHS(i int, c *Constr, rhs float64) error {
+	if c == nil || c.Index < 0 {
+		return errors.New("Invalid constraint")
+	}
+	return model.withScenarioNumber(i, func() error {
+		return model.setDoubleAttrElement(DBL_ATTR_SCENNRHS, c.Index, rhs)
+	})
+}
+
+// setScenVarAttr sets the scenario attribute attr of v in scenario i.
+func (model *Model) setScenVarAttr(i int, attr string, v *Var, value float64) error {
+	if v == nil || v.Index < 0 {
+		return errors.New("Invalid variable")
+	}
+	return model.withScenarioNumber(i, func() error {
+		return model.setDoubleAttrElement(attr, v.Index, value)
+	})
+}
+
+/*
+SetScenNName
+Description:
+
+	Sets the name of scenario i (the ScenNName attribute).
+*/
+func (model *Model) SetScenNName(i int, name string) error {
+	return model.withScenarioNumber(i, func() error {
+		return model.SetStringAttr(STR_ATTR_SCENNNAME, name)
+	})
+}
+
+/*
+GetScenNObjVal
+Description:
+
+	Returns the objective value of the solution of scenario i (the
+	ScenNObjVal attribute). It is INFINITY (or -INFINITY when maximizing) if
+	no solution was found for the scenario.
+*/
+func (model *Model) GetScenNObjVal(i int) (float64, error) {
+	var val float64
+	err := model.withScenarioNumber(i, func() (err error) {
+		val, err = model.GetDoubleAttr(DBL_ATTR_SCENNOBJVAL)
+		return err
+	})
+
+	return val, err
+}
+
+/*
+GetScenNX
+Description:
+
+	Returns the values of vars in the solution of scenario i (the ScenNX
+	attribute).
+*/
+func (model *Model) GetScenNX(i int, vars []*Var) ([]float64, error) {
+	var vals []float64
+	err := model.withScenarioNumber(i, func() (err error) {
+		vals, err = model.GetDoubleAttrVars(DBL_ATTR_SCENNX, vars)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vals, nil
+}
+
+/*
+SingleScenarioModel
+Description:
+
+	Creates a regular model which is the base model with the changes of
+	scenario i applied (with GRBsinglescenariomodel), e.g., to inspect or
+	debug a single scenario. The new model must be freed separately.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_singlescenariomodel.html
+*/
+func (model *Model) SingleScenarioModel(i int) (*Model, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if model.dryRun != nil {
+		return nil, fmt.Errorf("cannot create a single-scenario model: %w", ErrDryRun)
+	}
+
+	var single *C.GRBmodel
+	err = model.withScenarioNumber(i, func() error {
+		single = C.GRBsinglescenariomodel(model.AsGRBModel)
+		if single == nil {
+			return fmt.Errorf("Failed to create the model of scenario %v", i)
+		}
+		return nil
+	})
+	if err != nil {
+		if single != nil {
+			C.GRBfreemodel(single)
+		}
+		return nil, err
+	}
+
+	return model.wrapCopy(single, model.master)
+}
@@ -0,0 +1,244 @@
+package gurobi
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+nameindex.go
+Description:
+	Maps the names of variables and constraints to their handles, so that
+	looking up an item by name does not cross the cgo boundary.
+Notes:
+	The index of a model is built on the first lookup (with one read of the
+	VarName or ConstrName array, which updates the model first) and is kept
+	up to date afterwards when items are added, renamed or removed through
+	this package. Dry-run models maintain their index from the start, since
+	their names can not be read back. Unnamed items are not indexed; if
+	several items share a name, the one with the smallest index is found.
+*/
+
+type nameIndex struct {
+	varNames    []string
+	constrNames []string
+	vars        map[string]*Var
+	constrs     map[string]*Constr
+}
+
+func newNameIndex() *nameIndex {
+	return &nameIndex{vars: map[string]*Var{}, constrs: map[string]*Constr{}}
+}
+
+// addVars indexes vars, which were just appended to the model, under names
+// (nil if they are unnamed). It does nothing if the index was not built yet.
+func (ni *nameIndex) addVars(vars []*Var, names []string) {
+	if ni == nil {
+		return
+	}
+	for i, v := range vars {
+		name := ""
+		if i < len(names) {
+			name = names[i]
+		}
+		ni.varNames = append(ni.varNames, name)
+		if _, ok := ni.vars[name]; name != "" && !ok {
+			ni.vars[name] = v
+		}
+	}
+}
+
+// addConstrs indexes constrs, which were just appended to the model, under
+// names (nil if they are unnamed).
+func (ni *nameIndex) addConstrs(constrs []*Constr, names []string) {
+	if ni == nil {
+		return
+	}
+	for i, c := range constrs {
+		name := ""
+		if i < len(names) {
+			name = names[i]
+		}
+		ni.constrNames = append(ni.constrNames, name)
+		if _, ok := ni.constrs[name]; name != "" && !ok {
+			ni.constrs[name] = c
+		}
+	}
+}
+
+// renameIndexed updates the index after the name attribute attr of the
+// item at index ind was set to name.
+func (model *Model) renameIndexed(attr string, ind int32, name string) {
+	ni := model.names
+	if ni == nil {
+		return
+	}
+
+	switch {
+	case strings.EqualFold(attr, "VarName") && int(ind) < len(ni.varNames):
+		old := ni.varNames[ind]
+		ni.varNames[ind] = name
+		if v, ok := ni.vars[old]; ok && v.Index == ind {
+			delete(ni.vars, old)
+			model.reindexVarName(old)
+		}
+		if v, ok := ni.vars[name]; name != "" && (!ok || v.Index > ind) {
+			ni.vars[name] = model.vars[ind]
+		}
+	case strings.EqualFold(attr, "ConstrName") && int(ind) < len(ni.constrNames):
+		old := ni.constrNames[ind]
+		ni.constrNames[ind] = name
+		if c, ok := ni.constrs[old]; ok && c.Index == ind {
+			delete(ni.constrs, old)
+			model.reindexConstrName(old)
+		}
+		if c, ok := ni.constrs[name]; name != "" && (!ok || c.Index > ind) {
+			ni.constrs[name] = model.constrs[ind]
+		}
+	}
+}
+
+// reindexVarName points name to the first remaining variable with that name, if any.
+func (model *Model) reindexVarName(name string) {
+	for i, n := range model.names.varNames {
+		if n == name {
+			model.names.vars[name] = model.vars[i]
+			return
+		}
+	}
+}
+
+// reindexConstrName points name to the first remaining constraint with that name, if any.
+func (model *Model) reindexConstrName(name string) {
+	for i, n := range model.names.constrNames {
+		if n == name {
+			model.names.constrs[name] = model.constrs[i]
+			return
+		}
+	}
+}
+
+// remove updates the index after items were removed from the model; the
+// mappings are those of RemoveWhere (-1 for removed items).
+func (model *Model) removeIndexed(varMap []int32, constrMap []int32) {
+	ni := model.names
+	if ni == nil {
+		return
+	}
+
+	rebuilt := newNameIndex()
+	rebuilt.addVars(model.vars, keepNames(ni.varNames, varMap))
+	rebuilt.addConstrs(model.constrs, keepNames(ni.constrNames, constrMap))
+	model.names = rebuilt
+}
+
+// keepNames returns the names whose items were not removed.
+func keepNames(names []string, mapping []int32) []string {
+	kept := make([]string, 0, len(names))
+	for i, name := range names {
+		if i >= len(mapping) || mapping[i] >= 0 {
+			kept = append(kept, name)
+		}
+	}
+	return kept
+}
+
+// nameIndex returns the index of the model, building it first if necessary.
+func (model *Model) nameIndex() (*nameIndex, error) {
+	if model.names != nil {
+		return model.names, nil
+	}
+	if err := model.Check(); err != nil {
+		return nil, err
+	}
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	numVars, err := model.GetIntAttr(INT_ATTR_NUMVARS)
+	if err != nil {
+		return nil, err
+	}
+	varNames, err := model.getStringAttrArray("VarName", 0, numVars)
+	if err != nil {
+		return nil, err
+	}
+	numConstrs, err := model.GetIntAttr(INT_ATTR_NUMCONSTRS)
+	if err != nil {
+		return nil, err
+	}
+	constrNames, err := model.getStringAttrArray("ConstrName", 0, numConstrs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Models which were read from a file (or copied) may not have handles yet.
+	if n := int(numVars) - len(model.Variables); n > 0 {
+		model.appendVars(n, nil)
+	}
+	if n := int(numConstrs) - len(model.Constraints); n > 0 {
+		model.appendConstrs(n, nil)
+	}
+
+	ni := newNameIndex()
+	ni.addVars(model.vars[:numVars], varNames)
+	ni.addConstrs(model.constrs[:numConstrs], constrNames)
+	model.names = ni
+	return ni, nil
+}
+
+/*
+GetVarByName
+Description:
+
+	Returns the variable with the given name.
+*/
+func (model *Model) GetVarByName(name string) (*Var, error) {
+	ni, err := model.nameIndex()
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := ni.vars[name]; ok && name != "" {
+		return v, nil
+	}
+	return nil, fmt.Errorf("the model has no variable named %q", name)
+}
+
+/*
+GetConstrByName
+Description:
+
+	Returns the linear constraint with the given name.
+*/
+func (model *Model) GetConstrByName(name string) (*Constr, error) {
+	ni, err := model.nameIndex()
+	if err != nil {
+		return nil, err
+	}
+	if c, ok := ni.constrs[name]; ok && name != "" {
+		return c, nil
+	}
+	return nil, fmt.Errorf("the model has no constraint named %q", name)
+}
+
+/*
+VarsByPrefix
+Description:
+
+	Returns the variables whose names start with prefix (e.g., "x[" for
+	the variables x[0], x[1], ...), ordered by their index.
+*/
+func (model *Model) VarsByPrefix(prefix string) ([]*Var, error) {
+	ni, err := model.nameIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	vars := []*Var{}
+	for i, name := range ni.varNames {
+		if name != "" && strings.HasPrefix(name, prefix) {
+			vars = append(vars, model.vars[i])
+		}
+	}
+	return vars, nil
+}
@@ -0,0 +1,181 @@
+package gurobi
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+namer.go
+Description:
+	Deterministic names for variables. A Namer turns the names passed to
+	AddVar/AddVars into names which are valid in LP files and unique, and
+	generates names for variables which were added without one. Gurobi does
+	not reject illegal or duplicate names when they are added, but writing
+	the model to an LP file then silently renames or misreads them.
+Notes:
+	LP names must not contain whitespace or any of + - * ^ < > = [ ] : , ; /,
+	must not start with a digit or a period, must not start like a number
+	in exponent notation (e.g., e1), and are limited to 255 characters.
+	https://www.gurobi.com/documentation/current/refman/lp_format.html
+*/
+
+// maxNameLength is the longest name allowed in an LP file.
+const maxNameLength = 255
+
+/*
+Namer
+Description:
+
+	Produces valid, unique variable names. Generated names are Prefix
+	followed by a counter, zero-padded to Width digits (e.g., x0007 for
+	Prefix "x" and Width 4). Only names produced by the Namer are known to
+	be unique; names of variables added before the Namer was installed are
+	not tracked.
+*/
+type Namer struct {
+	Prefix string
+	Width  int
+
+	next int
+	used map[string]bool
+}
+
+/*
+NewNamer
+Description:
+
+	Creates a Namer which generates the names <prefix>0, <prefix>1, ...
+*/
+func NewNamer(prefix string) *Namer {
+	return &Namer{Prefix: prefix}
+}
+
+/*
+Next
+Description:
+
+	Returns the next generated name.
+*/
+func (n *Namer) Next() string {
+	prefix := SanitizeName(n.Prefix)
+	if n.Prefix == "" {
+		prefix = "x"
+	}
+
+	for {
+		name := fmt.Sprintf("%v%0*d", prefix, n.Width, n.next)
+		n.next++
+		if n.claim(name) {
+			return name
+		}
+	}
+}
+
+/*
+Name
+Description:
+
+	Returns a valid, unique version of name: illegal characters are
+	replaced (see SanitizeName) and a duplicate gets the suffix _<k>.
+	An empty name is replaced by the next generated name.
+*/
+func (n *Namer) Name(name string) string {
+	if name == "" {
+		return n.Next()
+	}
+
+	name = SanitizeName(name)
+	if n.claim(name) {
+		return name
+	}
+	for k := 1; ; k++ {
+		suffix := fmt.Sprintf("_%v", k)
+		candidate := name
+		if len(candidate)+len(suffix) > maxNameLength {
+			candidate = candidate[:maxNameLength-len(suffix)]
+		}
+		if n.claim(candidate + suffix) {
+			return candidate + suffix
+		}
+	}
+}
+
+// claim marks name as used and returns false if it was already used.
+func (n *Namer) claim(name string) bool {
+	if n.used == nil {
+		n.used = map[string]bool{}
+	}
+	if n.used[name] {
+		return false
+	}
+	n.used[name] = true
+	return true
+}
+
+/*
+SanitizeName
+Description:
+
+	Returns name with every character which is not allowed in an LP file
+	replaced by an underscore, an underscore prepended if it would start
+	like a number, and truncated to 255 characters.
+*/
+func SanitizeName(name string) string {
+	sanitized := strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' || strings.ContainsRune("+-*^<>=[]:,;/", r) {
+			return '_'
+		}
+		return r
+	}, name)
+
+	if sanitized == "" || startsLikeNumber(sanitized) {
+		sanitized = "_" + sanitized
+	}
+	if len(sanitized) > maxNameLength {
+		sanitized = sanitized[:maxNameLength]
+	}
+	return sanitized
+}
+
+// startsLikeNumber returns true if name starts with a digit, a period, or e/E followed by a digit.
+func startsLikeNumber(name string) bool {
+	switch {
+	case name[0] >= '0' && name[0] <= '9', name[0] == '.':
+		return true
+	case (name[0] == 'e' || name[0] == 'E') && len(name) > 1 && name[1] >= '0' && name[1] <= '9':
+		return true
+	default:
+		return false
+	}
+}
+
+/*
+SetNamer
+Description:
+
+	Installs a Namer which is applied to the names of all variables added
+	afterwards with AddVar, AddVars and AddVarsWithTypes. Pass nil to add
+	names unchanged again.
+*/
+func (model *Model) SetNamer(namer *Namer) {
+	model.namer = namer
+}
+
+// Namer returns the Namer installed with SetNamer (or nil).
+func (model *Model) Namer() *Namer {
+	return model.namer
+}
+
+// varNames applies the model's Namer (if any) to names without modifying the slice.
+func (model *Model) varNames(names []string) []string {
+	if model.namer == nil {
+		return names
+	}
+
+	named := make([]string, len(names))
+	for i, name := range names {
+		named[i] = model.namer.Name(name)
+	}
+	return named
+}
@@ -0,0 +1,169 @@
+package gurobi
+
+import (
+	"fmt"
+	"math"
+)
+
+/*
+nogood.go
+Description:
+	Building blocks for custom improvement heuristics: no-good cuts which
+	exclude a binary solution, local branching (proximity) constraints which
+	keep the search close to it, and objective constraints which demand an
+	improvement over an incumbent.
+Notes:
+	The Hamming distance between binary variables x and a binary solution v is
+	sum_{i: v_i = 0} x_i + sum_{i: v_i = 1} (1 - x_i), which is linear in x.
+*/
+
+// binaryTol is the distance from 0 or 1 up to which a value counts as binary.
+const binaryTol = 1e-6
+
+/*
+HammingDistance
+Description:
+
+	Returns the linear expression of the Hamming distance between the binary
+	variables vars and the binary solution values.
+*/
+func HammingDistance(vars []*Var, values []float64) (*LinExpr, error) {
+	if len(vars) != len(values) {
+		return nil, MismatchedLengthError{
+			Length1: len(vars),
+			Name1:   "vars",
+			Length2: len(values),
+			Name2:   "values",
+		}
+	}
+
+	expr := &LinExpr{}
+	for i, v := range vars {
+		if v == nil || v.Index < 0 {
+			return nil, fmt.Errorf("the variable at position %v is invalid", i)
+		}
+
+		switch {
+		case math.Abs(values[i]) <= binaryTol:
+			expr.AddTerm(v, 1.0)
+		case math.Abs(values[i]-1) <= binaryTol:
+			expr.AddTerm(v, -1.0).AddConstant(1.0)
+		default:
+			return nil, fmt.Errorf("the value %v at position %v is not binary", values[i], i)
+		}
+	}
+	return expr, nil
+}
+
+/*
+NoGoodCut
+Description:
+
+	Returns the constraint which excludes the binary solution values of vars
+	(a Hamming distance of at least 1). The constraint can be added with
+	AddTempConstr, or as a lazy constraint from a callback.
+*/
+func NoGoodCut(vars []*Var, values []float64) (TempConstr, error) {
+	distance, err := HammingDistance(vars, values)
+	if err != nil {
+		return TempConstr{}, err
+	}
+	return distance.GreaterEq(1.0), nil
+}
+
+/*
+AddNoGoodCut
+Description:
+
+	Adds the constraint which excludes the binary solution values of vars.
+*/
+func (model *Model) AddNoGoodCut(vars []*Var, values []float64, name string) (*Constr, error) {
+	tc, err := NoGoodCut(vars, values)
+	if err != nil {
+		return nil, err
+	}
+	return model.AddTempConstr(tc, name)
+}
+
+/*
+AddLocalBranching
+Description:
+
+	Adds the constraint which keeps the binary variables vars within a
+	Hamming distance of k from the solution values (local branching).
+*/
+func (model *Model) AddLocalBranching(vars []*Var, values []float64, k int, name string) (*Constr, error) {
+	if k < 0 {
+		return nil, fmt.Errorf("the distance of a local branching constraint must be non-negative; received %v", k)
+	}
+
+	distance, err := HammingDistance(vars, values)
+	if err != nil {
+		return nil, err
+	}
+	return model.AddTempConstr(distance.LessEq(float64(k)), name)
+}
+
+/*
+AddObjectiveImprovement
+Description:
+
+	Adds the constraint which requires the (linear) objective to be at least
+	delta better than incumbent: obj <= incumbent - delta when minimizing and
+	obj >= incumbent + delta when maximizing. The constant of the objective
+	is taken into account.
+*/
+func (model *Model) AddObjectiveImprovement(incumbent float64, delta float64, name string) (*Constr, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if delta < 0 || math.IsNaN(delta) || math.IsInf(incumbent, 0) || math.IsNaN(incumbent) {
+		return nil, fmt.Errorf("the incumbent must be finite and the improvement non-negative; received %v and %v", incumbent, delta)
+	}
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	numQNZs, err := model.GetIntAttr("NumQNZs")
+	if err != nil {
+		return nil, err
+	}
+	if numQNZs > 0 {
+		return nil, fmt.Errorf("the objective is quadratic; only linear objectives can be bounded")
+	}
+
+	// Algorithm
+	numVars, err := model.NumVars()
+	if err != nil {
+		return nil, err
+	}
+	objs, err := model.getDoubleAttrArray(DBL_ATTR_OBJ, 0, numVars)
+	if err != nil {
+		return nil, err
+	}
+	objCon, err := model.GetObjConstant()
+	if err != nil {
+		return nil, err
+	}
+	sense, err := model.GetModelSense()
+	if err != nil {
+		return nil, err
+	}
+
+	expr := &LinExpr{Offset: objCon}
+	for i, c := range objs {
+		if c != 0 {
+			expr.AddTerm(&Var{model, int32(i)}, c)
+		}
+	}
+
+	tc := expr.LessEq(incumbent - delta)
+	if sense == Maximize {
+		tc = expr.GreaterEq(incumbent + delta)
+	}
+	return model.AddTempConstr(tc, name)
+}
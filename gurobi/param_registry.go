@@ -0,0 +1,262 @@
+package gurobi
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+param_registry.go
+Description:
+	A registry of the Gurobi parameters that Gurobi.go knows about, along with
+	their types. The registry is used to validate parameter names before they
+	are passed to the C API and to parse parameter values given as strings.
+Notes:
+	The full list of parameters is on Gurobi's website at:
+	https://www.gurobi.com/documentation/current/refman/parameters.html
+*/
+
+// ParamType is the type of the value of a Gurobi parameter.
+type ParamType int
+
+const (
+	IntParam ParamType = iota
+	DBLParam
+	StringParam
+)
+
+/*
+String
+Description:
+
+	Returns the name of the parameter type.
+*/
+func (pt ParamType) String() string {
+	switch pt {
+	case IntParam:
+		return "int"
+	case DBLParam:
+		return "double"
+	case StringParam:
+		return "string"
+	default:
+		return fmt.Sprintf("ParamType(%v)", int(pt))
+	}
+}
+
+// paramRegistry maps the (case-sensitive) name of each known parameter to its type.
+var paramRegistry = map[string]ParamType{
+	// Termination
+	"BarIterLimit":   IntParam,
+	"BestBdStop":     DBLParam,
+	"BestObjStop":    DBLParam,
+	"Cutoff":         DBLParam,
+	"IterationLimit": DBLParam,
+	"MemLimit":       DBLParam,
+	"NodeLimit":      DBLParam,
+	"SolutionLimit":  IntParam,
+	"TimeLimit":      DBLParam,
+	"WorkLimit":      DBLParam,
+
+	// Tolerances
+	"BarConvTol":     DBLParam,
+	"BarQCPConvTol":  DBLParam,
+	"FeasibilityTol": DBLParam,
+	"IntFeasTol":     DBLParam,
+	"MarkowitzTol":   DBLParam,
+	"MIPGap":         DBLParam,
+	"MIPGapAbs":      DBLParam,
+	"OptimalityTol":  DBLParam,
+	"PSDTol":         DBLParam,
+
+	// Simplex, Barrier and Crossover
+	"BarHomogeneous":   IntParam,
+	"BarOrder":         IntParam,
+	"ConcurrentMethod": IntParam,
+	"Crossover":        IntParam,
+	"CrossoverBasis":   IntParam,
+	"Method":           IntParam,
+	"NormAdjust":       IntParam,
+	"Quad":             IntParam,
+	"Sifting":          IntParam,
+	"SimplexPricing":   IntParam,
+
+	// Scaling and Numerics
+	"NumericFocus": IntParam,
+	"ObjScale":     DBLParam,
+	"ScaleFlag":    IntParam,
+
+	// MIP
+	"BranchDir":         IntParam,
+	"ConcurrentMIP":     IntParam,
+	"Cuts":              IntParam,
+	"DegenMoves":        IntParam,
+	"Disconnected":      IntParam,
+	"Heuristics":        DBLParam,
+	"ImproveStartGap":   DBLParam,
+	"ImproveStartNodes": DBLParam,
+	"ImproveStartTime":  DBLParam,
+	"MIPFocus":          IntParam,
+	"NodefileDir":       StringParam,
+	"NodefileStart":     DBLParam,
+	"NoRelHeurTime":     DBLParam,
+	"PumpPasses":        IntParam,
+	"RINS":              IntParam,
+	"StartNodeLimit":    IntParam,
+	"SubMIPNodes":       IntParam,
+	"Symmetry":          IntParam,
+	"VarBranch":         IntParam,
+
+	// Presolve
+	"Aggregate":      IntParam,
+	"DualReductions": IntParam,
+	"PreCrush":       IntParam,
+	"PreDual":        IntParam,
+	"Presolve":       IntParam,
+	"PreSparsify":    IntParam,
+
+	// Solution Pool
+	"PoolGap":        DBLParam,
+	"PoolGapAbs":     DBLParam,
+	"PoolSearchMode": IntParam,
+	"PoolSolutions":  IntParam,
+	"SolutionNumber": IntParam,
+
+	// Multiple Objectives and Scenarios
+	"MultiObjMethod": IntParam,
+	"MultiObjPre":    IntParam,
+	"ObjNumber":      IntParam,
+	"ScenarioNumber": IntParam,
+
+	// Tuning
+	"TuneCriterion": IntParam,
+	"TuneOutput":    IntParam,
+	"TuneResults":   IntParam,
+	"TuneTimeLimit": DBLParam,
+	"TuneTrials":    IntParam,
+
+	// Other
+	"DisplayInterval": IntParam,
+	"FeasRelaxBigM":   DBLParam,
+	"IISMethod":       IntParam,
+	"InfUnbdInfo":     IntParam,
+	"LazyConstraints": IntParam,
+	"LogFile":         StringParam,
+	"LogToConsole":    IntParam,
+	"NonConvex":       IntParam,
+	"OutputFlag":      IntParam,
+	"ResultFile":      StringParam,
+	"Seed":            IntParam,
+	"SolFiles":        StringParam,
+	"Threads":         IntParam,
+	"UpdateMode":      IntParam,
+}
+
+/*
+LookupParam
+Description:
+
+	Finds the parameter with the given name in the registry (ignoring case, as
+	Gurobi does) and returns its canonical name and type.
+*/
+func LookupParam(paramName string) (string, ParamType, bool) {
+	if paramType, ok := paramRegistry[paramName]; ok {
+		return paramName, paramType, true
+	}
+
+	for name, paramType := range paramRegistry {
+		if strings.EqualFold(name, paramName) {
+			return name, paramType, true
+		}
+	}
+
+	return "", IntParam, false
+}
+
+/*
+KnownParams
+Description:
+
+	Returns the sorted names of all parameters in the registry.
+*/
+func KnownParams() []string {
+	names := make([]string, 0, len(paramRegistry))
+	for name := range paramRegistry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+SetParam
+Description:
+
+	Sets the parameter paramName from a string representation of its value.
+	The value is parsed according to the type of the parameter in the registry.
+*/
+func (env *Env) SetParam(paramName string, value string) error {
+	name, paramType, parsed, err := parseParam(paramName, value)
+	if err != nil {
+		return err
+	}
+
+	switch paramType {
+	case IntParam:
+		return env.SetIntParam(name, parsed.(int))
+	case DBLParam:
+		return env.SetDBLParam(name, parsed.(float64))
+	default:
+		return env.SetStringParam(name, value)
+	}
+}
+
+/*
+SetParam
+Description:
+
+	Sets the parameter paramName of this model from a string representation
+	of its value (see Env.SetParam).
+*/
+func (model *Model) SetParam(paramName string, value string) error {
+	name, paramType, parsed, err := parseParam(paramName, value)
+	if err != nil {
+		return err
+	}
+
+	switch paramType {
+	case IntParam:
+		return model.SetIntParam(name, parsed.(int))
+	case DBLParam:
+		return model.SetDBLParam(name, parsed.(float64))
+	default:
+		return model.SetStringParam(name, value)
+	}
+}
+
+// parseParam looks up paramName in the registry and parses value according to its type.
+func parseParam(paramName string, value string) (string, ParamType, interface{}, error) {
+	name, paramType, ok := LookupParam(paramName)
+	if !ok {
+		return "", paramType, nil, fmt.Errorf("the parameter name %v is not in the parameter registry", paramName)
+	}
+
+	switch paramType {
+	case IntParam:
+		intValue, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return "", paramType, nil, fmt.Errorf("the value %q of the %v parameter %v could not be parsed: %v", value, paramType, name, err)
+		}
+		return name, paramType, intValue, nil
+	case DBLParam:
+		dblValue, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return "", paramType, nil, fmt.Errorf("the value %q of the %v parameter %v could not be parsed: %v", value, paramType, name, err)
+		}
+		return name, paramType, dblValue, nil
+	default:
+		return name, paramType, value, nil
+	}
+}
@@ -0,0 +1,166 @@
+package gurobi
+
+import "fmt"
+
+/*
+params.go
+Description:
+	Parameter setters and getters for a Model.
+Notes:
+	Every model in Gurobi owns a copy of the environment that it was created
+	with (retrieved with GRBgetenv). Changing a parameter through these methods
+	only affects this model and not the environment that was passed to NewModel(),
+	which is also the correct way to set parameters in Compute Server sessions.
+	https://www.gurobi.com/documentation/current/refman/c_getenv.html
+*/
+
+/*
+ModelEnv
+Description:
+
+	Returns the model-owned environment. Parameters set on this environment
+	only affect this model.
+*/
+func (model *Model) ModelEnv() (*Env, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if model.dryRun != nil {
+		return nil, fmt.Errorf("the model environment: %w", ErrDryRun)
+	}
+
+	return &model.Env, nil
+}
+
+/*
+SetIntParam
+Description:
+
+	Sets the integer parameter paramName of this model to val.
+*/
+func (model *Model) SetIntParam(paramName string, val int) error {
+	if model.IsDryRun() {
+		return model.dryRun.setParam(paramName, IntParam, val)
+	}
+
+	env, err := model.ModelEnv()
+	if err != nil {
+		return err
+	}
+
+	// Parameters like SolutionNumber or ObjNumber select which values some
+	// attributes (e.g., PoolObjVal or ObjNVal) return.
+	model.attrCache.clear()
+	return env.SetIntParam(paramName, val)
+}
+
+/*
+GetIntParam
+Description:
+
+	Gets the value of the integer parameter paramName of this model.
+*/
+func (model *Model) GetIntParam(paramName string) (int, error) {
+	env, err := model.ModelEnv()
+	if err != nil {
+		return -1, err
+	}
+
+	return env.GetIntParam(paramName)
+}
+
+/*
+withSelection
+Description:
+
+	Calls selectFn, which sets the integer parameter paramName (e.g.,
+	ObjNumber, ScenarioNumber or SolutionNumber) to select the element that
+	some attributes refer to, then calls fn and finally restores paramName to
+	its previous value, so that reading or writing those attributes does not
+	change the element which the caller has selected.
+*/
+func (model *Model) withSelection(paramName string, selectFn func() error, fn func() error) error {
+	if model.IsDryRun() {
+		previous, wasSet := model.dryRun.Params[paramName]
+		defer func() {
+			if wasSet {
+				model.dryRun.Params[paramName] = previous
+			} else {
+				delete(model.dryRun.Params, paramName)
+			}
+		}()
+		if err := selectFn(); err != nil {
+			return err
+		}
+		return fn()
+	}
+
+	previous, err := model.GetIntParam(paramName)
+	if err != nil {
+		return err
+	}
+	if err := selectFn(); err != nil {
+		return err
+	}
+	err = fn()
+	if restoreErr := model.SetIntParam(paramName, previous); restoreErr != nil && err == nil {
+		err = restoreErr
+	}
+
+	return err
+}
+
+/*
+SetDBLParam
+Description:
+
+	Sets the double parameter paramName of this model to val.
+*/
+func (model *Model) SetDBLParam(paramName string, val float64) error {
+	if model.IsDryRun() {
+		return model.dryRun.setParam(paramName, DBLParam, val)
+	}
+
+	env, err := model.ModelEnv()
+	if err != nil {
+		return err
+	}
+
+	return env.SetDBLParam(paramName, val)
+}
+
+/*
+GetDBLParam
+Description:
+
+	Gets the value of the double parameter paramName of this model.
+*/
+func (model *Model) GetDBLParam(paramName string) (float64, error) {
+	env, err := model.ModelEnv()
+	if err != nil {
+		return -1, err
+	}
+
+	return env.GetDBLParam(paramName)
+}
+
+/*
+SetStringParam
+Description:
+
+	Sets the string parameter paramName of this model to val.
+*/
+func (model *Model) SetStringParam(paramName string, val string) error {
+	if model.IsDryRun() {
+		return model.dryRun.setParam(paramName, StringParam, val)
+	}
+
+	env, err := model.ModelEnv()
+	if err != nil {
+		return err
+	}
+
+	return env.SetStringParam(paramName, val)
+}
@@ -0,0 +1,167 @@
+package gurobi
+
+import "fmt"
+
+/*
+pool.go
+Description:
+	Access to the solution pool of a MIP: the solutions (besides the
+	incumbent) which Gurobi found during the solve, e.g., to present
+	alternative optima of a scheduling problem.
+Notes:
+	The attributes of a pool solution are queried by first setting the
+	SolutionNumber parameter to its index; solution 0 is the incumbent and
+	the others are sorted from best to worst.
+	https://www.gurobi.com/documentation/current/refman/solution_pool.html
+*/
+
+const DBL_ATTR_POOLOBJVAL = "PoolObjVal"
+const DBL_ATTR_XN = "Xn"
+
+// PoolSearchMode selects how the solution pool is filled (the PoolSearchMode parameter).
+type PoolSearchMode int
+
+const (
+	// PoolSearchIncidental keeps the solutions found while looking for one optimal solution.
+	PoolSearchIncidental PoolSearchMode = 0
+	// PoolSearchBestEffort also looks for more solutions, without guarantees on their quality.
+	PoolSearchBestEffort PoolSearchMode = 1
+	// PoolSearchBest systematically searches for the PoolSolutions best solutions.
+	PoolSearchBest PoolSearchMode = 2
+)
+
+/*
+PoolSolution
+Description:
+
+	A solution of the pool: its objective value and the values of all
+	variables (in the order of model.Variables).
+*/
+type PoolSolution struct {
+	Index  int
+	ObjVal float64
+	X      []float64
+}
+
+/*
+SetPoolSearchMode
+Description:
+
+	Selects how the solution pool is filled during the next solve.
+*/
+func (model *Model) SetPoolSearchMode(mode PoolSearchMode) error {
+	if mode < PoolSearchIncidental || mode > PoolSearchBest {
+		return fmt.Errorf("unknown pool search mode %v", int(mode))
+	}
+	return model.SetIntParam("PoolSearchMode", int(mode))
+}
+
+/*
+SetPoolSolutions
+Description:
+
+	Sets the maximum number of solutions which are kept in the pool (the
+	PoolSolutions parameter).
+*/
+func (model *Model) SetPoolSolutions(n int) error {
+	if n < 1 {
+		return fmt.Errorf("the pool must keep at least one solution; received %v", n)
+	}
+	return model.SetIntParam("PoolSolutions", n)
+}
+
+/*
+SetPoolGap
+Description:
+
+	Discards solutions whose relative gap to the optimal objective value is
+	larger than gap (the PoolGap parameter), e.g., 0.05 to keep only
+	solutions within 5% of the optimum.
+*/
+func (model *Model) SetPoolGap(gap float64) error {
+	if !(gap >= 0) {
+		return fmt.Errorf("the pool gap must be nonnegative; received %v", gap)
+	}
+	return model.SetDBLParam("PoolGap", gap)
+}
+
+/*
+SetPoolGapAbs
+Description:
+
+	Discards solutions whose absolute gap to the optimal objective value is
+	larger than gap (the PoolGapAbs parameter).
+*/
+func (model *Model) SetPoolGapAbs(gap float64) error {
+	if !(gap >= 0) {
+		return fmt.Errorf("the absolute pool gap must be nonnegative; received %v", gap)
+	}
+	return model.SetDBLParam("PoolGapAbs", gap)
+}
+
+/*
+PoolSolutionCount
+Description:
+
+	Returns the number of solutions in the pool (the SolCount attribute).
+*/
+func (model *Model) PoolSolutionCount() (int, error) {
+	count, err := model.GetIntAttr(INT_ATTR_SOLCOUNT)
+	return int(count), err
+}
+
+/*
+PoolSolution
+Description:
+
+	Returns solution i of the pool. Solution 0 is the incumbent. The
+	SolutionNumber parameter is restored afterwards.
+*/
+func (model *Model) PoolSolution(i int) (*PoolSolution, error) {
+	count, err := model.PoolSolutionCount()
+	if err != nil {
+		return nil, err
+	}
+	if i < 0 || i >= count {
+		return nil, fmt.Errorf("the pool solution index %v is out of range; the pool has %v solutions", i, count)
+	}
+
+	numVars, err := model.GetIntAttr(INT_ATTR_NUMVARS)
+	if err != nil {
+		return nil, err
+	}
+
+	solution := &PoolSolution{Index: i}
+	err = model.withSelection("SolutionNumber", func() error { return model.SetIntParam("SolutionNumber", i) }, func() (err error) {
+		if solution.ObjVal, err = model.GetDoubleAttr(DBL_ATTR_POOLOBJVAL); err != nil {
+			return err
+		}
+		solution.X, err = model.getDoubleAttrArray(DBL_ATTR_XN, 0, numVars)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return solution, nil
+}
+
+/*
+PoolSolutions
+Description:
+
+	Returns all solutions of the pool, from best to worst.
+*/
+func (model *Model) PoolSolutions() ([]*PoolSolution, error) {
+	count, err := model.PoolSolutionCount()
+	if err != nil {
+		return nil, err
+	}
+
+	solutions := make([]*PoolSolution, count)
+	for i := range solutions {
+		if solutions[i], err = model.PoolSolution(i); err != nil {
+			return nil, err
+		}
+	}
+	return solutions, nil
+}
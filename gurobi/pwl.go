@@ -0,0 +1,134 @@
+package gurobi
+
+import (
+	"fmt"
+	"math"
+)
+
+/*
+pwl.go
+Description:
+	Builds piecewise-linear (PWL) approximations of arbitrary Go functions so
+	that user-defined nonlinearities can be added to a model as PWL general constraints.
+*/
+
+/*
+PWLOptions
+Description:
+
+	Controls the accuracy of a PWL approximation.
+	- MaxError: The maximum absolute error allowed between the function and its approximation.
+	- MaxPoints: The maximum number of breakpoints (defaults to 1000).
+	- SamplesPerSegment: The number of interior points at which each segment is checked (defaults to 16).
+*/
+type PWLOptions struct {
+	MaxError          float64
+	MaxPoints         int
+	SamplesPerSegment int
+}
+
+/*
+PWLApproximation
+Description:
+
+	Samples f over [lb, ub] and returns breakpoints (xpts, ypts) of a
+	piecewise-linear function whose error (measured at SamplesPerSegment
+	points inside each segment) is at most opts.MaxError. Segments are split
+	at their point of largest error until the tolerance is met; an error is
+	returned if that requires more than opts.MaxPoints breakpoints.
+*/
+func PWLApproximation(f func(float64) float64, lb float64, ub float64, opts PWLOptions) ([]float64, []float64, error) {
+	// Input Processing
+	if f == nil {
+		return nil, nil, fmt.Errorf("the function to approximate must not be nil")
+	}
+
+	if math.IsInf(lb, 0) || math.IsInf(ub, 0) || math.IsNaN(lb) || math.IsNaN(ub) || lb >= ub {
+		return nil, nil, fmt.Errorf("the domain [%v, %v] must be a finite, non-empty interval", lb, ub)
+	}
+
+	if opts.MaxError <= 0 {
+		return nil, nil, fmt.Errorf("the maximum error must be positive; received %v", opts.MaxError)
+	}
+
+	if opts.MaxPoints == 0 {
+		opts.MaxPoints = 1000
+	}
+	if opts.SamplesPerSegment == 0 {
+		opts.SamplesPerSegment = 16
+	}
+	if opts.MaxPoints < 2 {
+		return nil, nil, fmt.Errorf("at least 2 breakpoints are needed; received MaxPoints = %v", opts.MaxPoints)
+	}
+
+	// Algorithm
+	xpts := []float64{lb, ub}
+	ypts := []float64{f(lb), f(ub)}
+	for i := 0; i < len(xpts)-1; {
+		worstX, worstErr := pwlSegmentError(f, xpts[i], ypts[i], xpts[i+1], ypts[i+1], opts.SamplesPerSegment)
+		if worstErr <= opts.MaxError {
+			i++
+			continue
+		}
+
+		if len(xpts) >= opts.MaxPoints {
+			return nil, nil, fmt.Errorf(
+				"could not approximate the function within %v using %v breakpoints (error %v near x = %v)",
+				opts.MaxError, opts.MaxPoints, worstErr, worstX,
+			)
+		}
+
+		// Split the segment at its worst point and check the left half again.
+		xpts = append(xpts[:i+1], append([]float64{worstX}, xpts[i+1:]...)...)
+		ypts = append(ypts[:i+1], append([]float64{f(worstX)}, ypts[i+1:]...)...)
+	}
+
+	for i, y := range ypts {
+		if math.IsNaN(y) || math.IsInf(y, 0) {
+			return nil, nil, fmt.Errorf("the function is not finite at x = %v", xpts[i])
+		}
+	}
+
+	return xpts, ypts, nil
+}
+
+// pwlSegmentError returns the sample point with the largest error between f and
+// the line through (x0, y0) and (x1, y1), along with that error.
+func pwlSegmentError(f func(float64) float64, x0, y0, x1, y1 float64, samples int) (float64, float64) {
+	worstX, worstErr := (x0+x1)/2, 0.0
+	for k := 1; k <= samples; k++ {
+		x := x0 + (x1-x0)*float64(k)/float64(samples+1)
+		interpolated := y0 + (y1-y0)*(x-x0)/(x1-x0)
+		if err := math.Abs(f(x) - interpolated); err > worstErr || math.IsNaN(err) {
+			worstX, worstErr = x, err
+			if math.IsNaN(err) {
+				return worstX, math.Inf(1)
+			}
+		}
+	}
+	return worstX, worstErr
+}
+
+/*
+AddPWLFunction
+Description:
+
+	Approximates y = f(x) over [lb, ub] with a PWL function (see PWLApproximation)
+	and adds it to the model as a PWL general constraint. The bounds of x are
+	also restricted to [lb, ub] so that the approximation is never extrapolated.
+*/
+func (model *Model) AddPWLFunction(x *Var, y *Var, f func(float64) float64, lb float64, ub float64, opts PWLOptions, name string) (*GenConstr, error) {
+	xpts, ypts, err := PWLApproximation(f, lb, ub, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := x.SetDouble(DBL_ATTR_LB, lb); err != nil {
+		return nil, err
+	}
+	if err := x.SetDouble(DBL_ATTR_UB, ub); err != nil {
+		return nil, err
+	}
+
+	return model.AddGenConstrPWL(x, y, xpts, ypts, name)
+}
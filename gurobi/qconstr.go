@@ -0,0 +1,201 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import "fmt"
+
+/*
+qconstr.go
+Description:
+	Functions for inspecting the quadratic constraints of a model (e.g., of a
+	QCQP model read from a file): the linear and quadratic terms of each row,
+	its sense and right-hand side, and the QC* attributes.
+Notes:
+	https://www.gurobi.com/documentation/current/refman/c_getqconstr.html
+*/
+
+// Gurobi quadratic constraint object
+type QConstr struct {
+	Model *Model
+	Index int32
+}
+
+/*
+QConstrData
+Description:
+
+	The structure of the quadratic constraint
+	sum(LinVals[k] * LinVars[k]) + sum(QVals[k] * QRows[k] * QCols[k]) Sense RHS.
+*/
+type QConstrData struct {
+	Name    string
+	LinVars []*Var
+	LinVals []float64
+	QRows   []*Var
+	QCols   []*Var
+	QVals   []float64
+	Sense   Sense
+	RHS     float64
+}
+
+/*
+NumQConstrs
+Description:
+
+	Returns the number of quadratic constraints of the model.
+*/
+func (model *Model) NumQConstrs() (int32, error) {
+	return model.GetIntAttr("NumQConstrs")
+}
+
+/*
+QConstrs
+Description:
+
+	Returns a handle for each quadratic constraint of the model.
+*/
+func (model *Model) QConstrs() ([]*QConstr, error) {
+	numQConstrs, err := model.NumQConstrs()
+	if err != nil {
+		return nil, err
+	}
+
+	qconstrs := make([]*QConstr, numQConstrs)
+	for i := range qconstrs {
+		qconstrs[i] = &QConstr{model, int32(i)}
+	}
+	return qconstrs, nil
+}
+
+/*
+GetQConstr
+Description:
+
+	Returns the structure of the quadratic constraint with index i.
+*/
+func (model *Model) GetQConstr(i int32) (*QConstrData, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := model.checkFor("GetQConstr"); err != nil {
+		return nil, err
+	}
+
+	if model.dryRun != nil {
+		return nil, fmt.Errorf("GetQConstr: %w", ErrDryRun)
+	}
+
+	numQConstrs, err := model.NumQConstrs()
+	if err != nil {
+		return nil, err
+	}
+	if i < 0 || i >= numQConstrs {
+		return nil, fmt.Errorf("the quadratic constraint index %v is out of range; the model has %v quadratic constraints", i, numQConstrs)
+	}
+
+	// Algorithm
+	var numlnz, numqnz int32
+	done := traceCall("GRBgetqconstr", i)
+	errCode := C.GRBgetqconstr(model.AsGRBModel, C.int(i), (*C.int)(&numlnz), nil, nil, (*C.int)(&numqnz), nil, nil, nil)
+	done(errCode)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	lind := make([]int32, numlnz+1)
+	lval := make([]float64, numlnz+1)
+	qrow := make([]int32, numqnz+1)
+	qcol := make([]int32, numqnz+1)
+	qval := make([]float64, numqnz+1)
+	done = traceCall("GRBgetqconstr", i, numlnz, numqnz)
+	errCode = C.GRBgetqconstr(
+		model.AsGRBModel, C.int(i),
+		(*C.int)(&numlnz), (*C.int)(&lind[0]), (*C.double)(&lval[0]),
+		(*C.int)(&numqnz), (*C.int)(&qrow[0]), (*C.int)(&qcol[0]), (*C.double)(&qval[0]),
+	)
+	done(errCode)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	qc := &QConstr{model, i}
+	data := &QConstrData{
+		LinVars: model.varsAt(lind[:numlnz]),
+		LinVals: lval[:numlnz],
+		QRows:   model.varsAt(qrow[:numqnz]),
+		QCols:   model.varsAt(qcol[:numqnz]),
+		QVals:   qval[:numqnz],
+	}
+	if data.Name, err = qc.Name(); err != nil {
+		return nil, err
+	}
+	if data.Sense, err = qc.Sense(); err != nil {
+		return nil, err
+	}
+	if data.RHS, err = qc.RHS(); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+/*
+Data
+Description:
+
+	Returns the structure of the quadratic constraint.
+*/
+func (qc *QConstr) Data() (*QConstrData, error) {
+	return qc.Model.GetQConstr(qc.Index)
+}
+
+/*
+GetAttr
+Description:
+
+	Returns the value of the quadratic constraint attribute attr (e.g.,
+	"QCRHS", "QCSense" or "QCPi") as an int32, int8, float64 or string,
+	depending on the attribute's type.
+*/
+func (qc *QConstr) GetAttr(attr string) (interface{}, error) {
+	return qc.Model.getAttrElement(attr, qc.Index, attrElemQConstr)
+}
+
+/*
+SetAttr
+Description:
+
+	Sets the quadratic constraint attribute attr to value. Numeric values are
+	converted to the attribute's type.
+*/
+func (qc *QConstr) SetAttr(attr string, value interface{}) error {
+	return qc.Model.setAttrElement(attr, qc.Index, attrElemQConstr, value)
+}
+
+// Name returns the QCName attribute of the quadratic constraint.
+func (qc *QConstr) Name() (string, error) {
+	return qc.Model.getStringAttrElement("QCName", qc.Index)
+}
+
+// SetName sets the QCName attribute of the quadratic constraint.
+func (qc *QConstr) SetName(name string) error {
+	return qc.Model.setStringAttrElement("QCName", qc.Index, name)
+}
+
+// RHS returns the QCRHS attribute of the quadratic constraint.
+func (qc *QConstr) RHS() (float64, error) {
+	return qc.Model.getDoubleAttrElement("QCRHS", qc.Index)
+}
+
+// SetRHS sets the QCRHS attribute of the quadratic constraint.
+func (qc *QConstr) SetRHS(rhs float64) error {
+	return qc.Model.setDoubleAttrElement("QCRHS", qc.Index, rhs)
+}
+
+// Sense returns the QCSense attribute of the quadratic constraint.
+func (qc *QConstr) Sense() (Sense, error) {
+	sense, err := qc.Model.getCharAttrElement("QCSense", qc.Index)
+	return Sense(sense), err
+}
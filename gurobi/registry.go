@@ -0,0 +1,129 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+registry.go
+Description:
+	Keeps track of the environments created with NewEnv and of the models
+	created in each of them, so that many environments (each with its own
+	log file and parameters) can be created and freed concurrently and so
+	that freeing an environment first frees the models which still use it.
+Notes:
+	A model belongs to the environment it was created in (its master
+	environment), not to Model.Env, which is the copy of the environment
+	that Gurobi keeps for every model.
+*/
+
+// ErrFreed is returned by Check when an environment or model is used after it was freed.
+var ErrFreed = errors.New("gurobi: the environment or model was already freed")
+
+type envRegistry struct {
+	mu     sync.Mutex
+	models map[*C.GRBenv]map[*Model]struct{}
+	// loadMu serializes GRBloadenv, which reads the license and global settings.
+	loadMu sync.Mutex
+}
+
+var registry = &envRegistry{models: map[*C.GRBenv]map[*Model]struct{}{}}
+
+// addEnv registers a new master environment.
+func (r *envRegistry) addEnv(env *C.GRBenv) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.models[env] = map[*Model]struct{}{}
+}
+
+// addModel registers a new model of the master environment env.
+func (r *envRegistry) addModel(env *C.GRBenv, model *Model) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	model.master = env
+	if models, ok := r.models[env]; ok {
+		models[model] = struct{}{}
+	}
+}
+
+// freeModel unregisters and frees model, and returns false if it was not
+// registered. A model whose master environment was not created with NewEnv
+// (e.g., a copy into the ModelEnv of another model) is never registered, but
+// is still freed here; a model whose environment was freed before it was
+// already freed by freeEnv. Both happen under the lock, so that the
+// environment cannot be freed by another goroutine while GRBfreemodel is
+// still running.
+func (r *envRegistry) freeModel(model *Model) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	models := r.models[model.master]
+	if _, ok := models[model]; !ok {
+		if !model.isFreed() {
+			model.free()
+		}
+		return false
+	}
+	delete(models, model)
+	model.free()
+	return true
+}
+
+// freeEnv unregisters env, frees its models which have not been freed yet
+// and then env itself, and returns false if env was not registered.
+func (r *envRegistry) freeEnv(env *Env) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	models, ok := r.models[env.env]
+	if !ok {
+		return false
+	}
+	delete(r.models, env.env)
+
+	for model := range models {
+		model.free()
+	}
+	atomic.StoreInt32(&env.freed, 1)
+	C.GRBfreeenv(env.env)
+	env.env = nil
+	return true
+}
+
+/*
+NumEnvs
+Description:
+
+	Returns the number of environments which were created with NewEnv and
+	have not been freed yet.
+*/
+func NumEnvs() int {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	return len(registry.models)
+}
+
+/*
+NumModels
+Description:
+
+	Returns the number of models of the environment which have not been
+	freed yet.
+*/
+func (env *Env) NumModels() int {
+	if env == nil {
+		return 0
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	return len(registry.models[env.env])
+}
@@ -0,0 +1,224 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import "fmt"
+
+/*
+remove.go
+Description:
+	Removal of variables, linear constraints and general constraints
+	selected by name.
+Notes:
+	Removing items renumbers the remaining ones. The Var, Constr and
+	GenConstr values stored in the model are updated in place, so pointers
+	returned by the Add* methods see the new indices (and an Index of -1 for
+	removed items) as long as they were not invalidated by a later append.
+*/
+
+/*
+ItemKind
+Description:
+
+	The kind of a model item which can be removed with RemoveWhere.
+*/
+type ItemKind int
+
+const (
+	ItemVar ItemKind = iota
+	ItemConstr
+	ItemGenConstr
+)
+
+func (kind ItemKind) String() string {
+	switch kind {
+	case ItemVar:
+		return "Var"
+	case ItemConstr:
+		return "Constr"
+	case ItemGenConstr:
+		return "GenConstr"
+	default:
+		return fmt.Sprintf("ItemKind(%v)", int(kind))
+	}
+}
+
+/*
+RemoveWhere
+Description:
+
+	Removes every variable, linear constraint and general constraint for
+	which remove(name, kind) returns true, with one deletion call per kind
+	and a single model update. Returns the number of removed items.
+*/
+func (model *Model) RemoveWhere(remove func(name string, kind ItemKind) bool) (int, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := model.Update(); err != nil {
+		return 0, err
+	}
+
+	// Algorithm
+	numVars, varInd, err := model.selectByName("VarName", INT_ATTR_NUMVARS, ItemVar, remove)
+	if err != nil {
+		return 0, err
+	}
+	numConstrs, constrInd, err := model.selectByName("ConstrName", INT_ATTR_NUMCONSTRS, ItemConstr, remove)
+	if err != nil {
+		return 0, err
+	}
+	numGenConstrs, genConstrInd, err := model.selectByName("GenConstrName", "NumGenConstrs", ItemGenConstr, remove)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(genConstrInd) > 0 {
+		done := traceCall("GRBdelgenconstrs", len(genConstrInd))
+		errCode := C.GRBdelgenconstrs(model.AsGRBModel, C.int(len(genConstrInd)), (*C.int)(&genConstrInd[0]))
+		done(errCode)
+		if errCode != 0 {
+			return 0, model.MakeError(errCode)
+		}
+	}
+
+	if len(constrInd) > 0 {
+		done := traceCall("GRBdelconstrs", len(constrInd))
+		errCode := C.GRBdelconstrs(model.AsGRBModel, C.int(len(constrInd)), (*C.int)(&constrInd[0]))
+		done(errCode)
+		if errCode != 0 {
+			return 0, model.MakeError(errCode)
+		}
+	}
+
+	if len(varInd) > 0 {
+		done := traceCall("GRBdelvars", len(varInd))
+		errCode := C.GRBdelvars(model.AsGRBModel, C.int(len(varInd)), (*C.int)(&varInd[0]))
+		done(errCode)
+		if errCode != 0 {
+			return 0, model.MakeError(errCode)
+		}
+	}
+
+	numRemoved := len(varInd) + len(constrInd) + len(genConstrInd)
+	if numRemoved == 0 {
+		return 0, nil
+	}
+
+	model.changes.removed += numRemoved
+	model.warm = nil
+
+	removals := []struct {
+		kind ModelEventKind
+		ind  []int32
+	}{
+		{EventGenConstrsRemoved, genConstrInd},
+		{EventConstrsRemoved, constrInd},
+		{EventVarsRemoved, varInd},
+	}
+	for _, r := range removals {
+		if len(r.ind) > 0 && len(model.listeners) > 0 {
+			model.emit(ModelEvent{Kind: r.kind, Indices: r.ind})
+		}
+	}
+
+	if err := model.Update(); err != nil {
+		return 0, err
+	}
+
+	varMap := newIndices(numVars, varInd)
+	constrMap := newIndices(numConstrs, constrInd)
+	genConstrMap := newIndices(numGenConstrs, genConstrInd)
+	model.vars = reindexVars(model.vars, varMap)
+	model.constrs = reindexConstrs(model.constrs, constrMap)
+	model.syncItems()
+	model.removeIndexed(varMap, constrMap)
+	model.GenConstrs = reindexGenConstrs(model.GenConstrs, genConstrMap)
+	model.reindexTags(varMap, constrMap, genConstrMap)
+
+	return numRemoved, nil
+}
+
+// selectByName returns the number of items of the given kind and the indices of
+// those whose name is selected by remove.
+func (model *Model) selectByName(nameAttr string, countAttr string, kind ItemKind, remove func(name string, kind ItemKind) bool) (int, []int32, error) {
+	count, err := model.GetIntAttr(countAttr)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	names, err := model.getStringAttrArray(nameAttr, 0, count)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	ind := []int32{}
+	for i, name := range names {
+		if remove(name, kind) {
+			ind = append(ind, int32(i))
+		}
+	}
+	return int(count), ind, nil
+}
+
+// newIndices maps every index below n to its index after removing the (sorted) indices in removed,
+// or to -1 if it was removed.
+func newIndices(n int, removed []int32) []int32 {
+	mapping := make([]int32, n)
+	next, k := int32(0), 0
+	for i := range mapping {
+		if k < len(removed) && removed[k] == int32(i) {
+			mapping[i] = -1
+			k++
+			continue
+		}
+		mapping[i] = next
+		next++
+	}
+	return mapping
+}
+
+func reindexVars(vars []*Var, mapping []int32) []*Var {
+	kept := make([]*Var, 0, len(vars))
+	for _, v := range vars {
+		if v.Index < 0 || int(v.Index) >= len(mapping) {
+			continue
+		}
+		v.Index = mapping[v.Index]
+		if v.Index >= 0 {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+func reindexConstrs(constrs []*Constr, mapping []int32) []*Constr {
+	kept := make([]*Constr, 0, len(constrs))
+	for _, c := range constrs {
+		if c.Index < 0 || int(c.Index) >= len(mapping) {
+			continue
+		}
+		c.Index = mapping[c.Index]
+		if c.Index >= 0 {
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}
+
+func reindexGenConstrs(genConstrs []GenConstr, mapping []int32) []GenConstr {
+	kept := make([]GenConstr, 0, len(genConstrs))
+	for i := range genConstrs {
+		if genConstrs[i].Index < 0 || int(genConstrs[i].Index) >= len(mapping) {
+			continue
+		}
+		genConstrs[i].Index = mapping[genConstrs[i].Index]
+		if genConstrs[i].Index >= 0 {
+			kept = append(kept, genConstrs[i])
+		}
+	}
+	return kept
+}
@@ -0,0 +1,189 @@
+package gurobi
+
+import (
+	"errors"
+	"time"
+)
+
+/*
+result.go
+Description:
+	Defines SolveResult, which collects everything that is usually read after
+	a solve in a single value that is easy to log or persist.
+*/
+
+/*
+SolveResult
+Description:
+
+	A summary of a single optimization.
+	- Termination: A human readable description of why the solve stopped.
+	- ObjVal, ObjBound and MIPGap are 0 when they are not available (e.g.,
+	  when no solution was found, or the gap of a continuous model).
+	- ObjCon is the constant term of the objective. It is included in ObjVal
+	  and ObjBound; ObjVal - ObjCon is the part which depends on the variables.
+	- Runtime is Gurobi's Runtime attribute; WallTime also includes the
+	  overhead of the call from Go.
+	- Timings breaks the runtime down into phases. The presolve and root
+	  relaxation times are only known to OptimizeWithResult, as are Root
+	  (the root relaxation of a MIP) and Stats (its cuts and heuristics).
+	- Params contains the parameters which differ from their defaults.
+*/
+type SolveResult struct {
+	Status       Status            `json:"status"`
+	StatusName   string            `json:"status_name"`
+	Termination  string            `json:"termination"`
+	IsMIP        bool              `json:"is_mip"`
+	SolCount     int32             `json:"sol_count"`
+	ObjVal       float64           `json:"obj_val"`
+	ObjBound     float64           `json:"obj_bound"`
+	ObjCon       float64           `json:"obj_con"`
+	MIPGap       float64           `json:"mip_gap"`
+	Runtime      float64           `json:"runtime"`
+	WallTime     float64           `json:"wall_time"`
+	IterCount    float64           `json:"iter_count"`
+	BarIterCount int32             `json:"bar_iter_count"`
+	NodeCount    float64           `json:"node_count"`
+	NumVars      int32             `json:"num_vars"`
+	NumConstrs   int32             `json:"num_constrs"`
+	Timings      SolveTimings      `json:"timings"`
+	Root         RootRelaxation    `json:"root"`
+	Stats        SolveStats        `json:"stats"`
+	Params       map[string]string `json:"params"`
+}
+
+/*
+HasSolution
+Description:
+
+	Returns true if at least one feasible solution is available.
+*/
+func (sr *SolveResult) HasSolution() bool {
+	return sr.SolCount > 0
+}
+
+/*
+VariableObjVal
+Description:
+
+	Returns the part of the objective value which depends on the variables
+	(ObjVal without the constant ObjCon), or 0 if there is no solution.
+*/
+func (sr *SolveResult) VariableObjVal() float64 {
+	if !sr.HasSolution() {
+		return 0
+	}
+	return sr.ObjVal - sr.ObjCon
+}
+
+/*
+OptimizeWithResult
+Description:
+
+	Optimizes the model and collects a SolveResult. An error is returned if
+	the optimization itself fails; attributes which are not available for the
+	final status are left at 0.
+*/
+func (model *Model) OptimizeWithResult() (*SolveResult, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	parser, err := model.optimizeWithTimings()
+	if err != nil {
+		return nil, err
+	}
+	wallTime := time.Since(start).Seconds()
+
+	result, err := model.Result()
+	if err != nil {
+		return nil, err
+	}
+	result.WallTime = wallTime
+	result.Timings.Presolve = parser.Timings.Presolve
+	result.Timings.RootRelaxation = parser.Timings.RootRelaxation
+	result.Root = parser.Root
+	result.Stats = parser.Stats
+	return result, nil
+}
+
+/*
+Result
+Description:
+
+	Collects the SolveResult of the most recent optimization of the model
+	(without the WallTime, the phase timings, the root relaxation and the
+	cut and heuristic statistics, which are only known to OptimizeWithResult).
+*/
+func (model *Model) Result() (*SolveResult, error) {
+	status, err := model.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SolveResult{
+		Status:      status,
+		StatusName:  status.String(),
+		Termination: status.Explain().Description,
+	}
+
+	isMIP, err := model.optionalIntAttr("IsMIP")
+	if err != nil {
+		return nil, err
+	}
+	result.IsMIP = isMIP != 0
+
+	if result.SolCount, err = model.optionalIntAttr("SolCount"); err != nil {
+		return nil, err
+	}
+	if result.NumVars, err = model.NumVars(); err != nil {
+		return nil, err
+	}
+	if result.NumConstrs, err = model.NumConstrs(); err != nil {
+		return nil, err
+	}
+	if result.BarIterCount, err = model.optionalIntAttr(INT_ATTR_BARITERCOUNT); err != nil {
+		return nil, err
+	}
+
+	doubles := []struct {
+		dst  *float64
+		attr string
+	}{
+		{&result.ObjVal, DBL_ATTR_OBJVAL},
+		{&result.ObjBound, DBL_ATTR_OBJBOUND},
+		{&result.ObjCon, DBL_ATTR_OBJCON},
+		{&result.MIPGap, DBL_ATTR_MIPGAP},
+		{&result.Runtime, DBL_ATTR_RUNTIME},
+		{&result.IterCount, DBL_ATTR_ITERCOUNT},
+		{&result.NodeCount, DBL_ATTR_NODECOUNT},
+	}
+	for _, d := range doubles {
+		if *d.dst, err = model.optionalDoubleAttr(d.attr); err != nil {
+			return nil, err
+		}
+	}
+
+	result.Timings.Total = result.Runtime
+
+	env, err := model.ModelEnv()
+	if err != nil {
+		return nil, err
+	}
+	if result.Params, err = env.ChangedParams(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// optionalIntAttr reads an integer attribute, treating an unavailable attribute as 0.
+func (model *Model) optionalIntAttr(attr string) (int32, error) {
+	value, err := model.GetIntAttr(attr)
+	if errors.Is(err, ErrDataNotAvailable) {
+		return 0, nil
+	}
+	return value, err
+}
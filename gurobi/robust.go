@@ -0,0 +1,203 @@
+package gurobi
+
+import "fmt"
+
+/*
+robust.go
+Description:
+	Contains an optimization routine which automatically retries a solve
+	with more conservative numerical settings when Gurobi reports numerical trouble.
+*/
+
+/*
+NumericConfig
+Description:
+
+	A combination of the parameters which control how carefully Gurobi handles
+	numerical issues.
+	- NumericFocus: 0 (automatic) to 3 (most careful)
+	- ScaleFlag: -1 (automatic) to 3 (most aggressive scaling); 0 disables scaling
+	- Quad: -1 (automatic), 0 (off) or 1 (on) for quad precision in simplex
+*/
+type NumericConfig struct {
+	NumericFocus int
+	ScaleFlag    int
+	Quad         int
+}
+
+/*
+RobustPolicy
+Description:
+
+	Describes how OptimizeRobust retries a solve.
+	- MaxRetries: The maximum number of re-solves after the first attempt.
+	- RetryStatuses: The statuses which trigger a retry (defaults to NUMERIC and SUBOPTIMAL).
+	- Escalation: The sequence of configurations to try on each retry (defaults to DefaultNumericEscalation).
+*/
+type RobustPolicy struct {
+	MaxRetries    int
+	RetryStatuses []Status
+	Escalation    []NumericConfig
+}
+
+/*
+RobustResult
+Description:
+
+	Reports the outcome of OptimizeRobust, including the configuration which was
+	active during the final attempt.
+*/
+type RobustResult struct {
+	Status    Status
+	Attempts  int
+	Config    NumericConfig
+	Succeeded bool
+}
+
+// DefaultNumericConfig is the configuration with all parameters at Gurobi's default values.
+var DefaultNumericConfig = NumericConfig{NumericFocus: 0, ScaleFlag: -1, Quad: -1}
+
+// DefaultNumericEscalation is the escalation used by OptimizeRobust when the policy does not specify one.
+var DefaultNumericEscalation = []NumericConfig{
+	{NumericFocus: 1, ScaleFlag: -1, Quad: -1},
+	{NumericFocus: 2, ScaleFlag: 2, Quad: -1},
+	{NumericFocus: 3, ScaleFlag: 0, Quad: 1},
+}
+
+/*
+DefaultRobustPolicy
+Description:
+
+	Returns a policy which retries on NUMERIC and SUBOPTIMAL statuses using each
+	step of DefaultNumericEscalation.
+*/
+func DefaultRobustPolicy() RobustPolicy {
+	return RobustPolicy{
+		MaxRetries:    len(DefaultNumericEscalation),
+		RetryStatuses: []Status{StatusNumeric, StatusSuboptimal},
+		Escalation:    DefaultNumericEscalation,
+	}
+}
+
+/*
+ShouldRetry
+Description:
+
+	Returns true if the given status is one of the policy's retry statuses.
+*/
+func (policy RobustPolicy) ShouldRetry(status Status) bool {
+	retryStatuses := policy.RetryStatuses
+	if len(retryStatuses) == 0 {
+		retryStatuses = []Status{StatusNumeric, StatusSuboptimal}
+	}
+
+	for _, retryStatus := range retryStatuses {
+		if status == retryStatus {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+GetNumericConfig
+Description:
+
+	Reads the current NumericFocus, ScaleFlag and Quad parameters of the model.
+*/
+func (model *Model) GetNumericConfig() (NumericConfig, error) {
+	var config NumericConfig
+	var err error
+
+	if config.NumericFocus, err = model.GetIntParam("NumericFocus"); err != nil {
+		return config, err
+	}
+	if config.ScaleFlag, err = model.GetIntParam("ScaleFlag"); err != nil {
+		return config, err
+	}
+	if config.Quad, err = model.GetIntParam("Quad"); err != nil {
+		return config, err
+	}
+
+	return config, nil
+}
+
+/*
+SetNumericConfig
+Description:
+
+	Applies the NumericFocus, ScaleFlag and Quad parameters of config to the model.
+*/
+func (model *Model) SetNumericConfig(config NumericConfig) error {
+	if err := model.SetIntParam("NumericFocus", config.NumericFocus); err != nil {
+		return err
+	}
+	if err := model.SetIntParam("ScaleFlag", config.ScaleFlag); err != nil {
+		return err
+	}
+	return model.SetIntParam("Quad", config.Quad)
+}
+
+/*
+OptimizeRobust
+Description:
+
+	Optimizes the model and, if the resulting status is one of the policy's
+	retry statuses (NUMERIC or SUBOPTIMAL by default), resets the model and
+	re-solves it with the next (more conservative) configuration of the
+	policy's escalation. At most policy.MaxRetries re-solves are performed.
+	The configuration used in the final attempt is reported in the result and
+	is left in place on the model's environment so that the solution can be reproduced.
+*/
+func (model *Model) OptimizeRobust(policy RobustPolicy) (RobustResult, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return RobustResult{}, err
+	}
+
+	if policy.MaxRetries < 0 {
+		return RobustResult{}, fmt.Errorf("the number of retries must be nonnegative; received %v", policy.MaxRetries)
+	}
+
+	escalation := policy.Escalation
+	if len(escalation) == 0 {
+		escalation = DefaultNumericEscalation
+	}
+
+	config, err := model.GetNumericConfig()
+	if err != nil {
+		return RobustResult{}, err
+	}
+
+	// Algorithm
+	result := RobustResult{Config: config}
+	for {
+		if err := model.Optimize(); err != nil {
+			return result, err
+		}
+		result.Attempts++
+
+		result.Status, err = model.Status()
+		if err != nil {
+			return result, err
+		}
+
+		retryIndex := result.Attempts - 1
+		if !policy.ShouldRetry(result.Status) || retryIndex >= policy.MaxRetries || retryIndex >= len(escalation) {
+			break
+		}
+
+		// Escalate the numerical settings and start over.
+		result.Config = escalation[retryIndex]
+		if err := model.SetNumericConfig(result.Config); err != nil {
+			return result, err
+		}
+		if err := model.Reset(false); err != nil {
+			return result, err
+		}
+	}
+
+	result.Succeeded = !policy.ShouldRetry(result.Status)
+	return result, nil
+}
@@ -0,0 +1,75 @@
+package gurobi
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+)
+
+/*
+rootrelax.go
+Description:
+	Information about the root relaxation of a MIP, which helps to monitor
+	the quality of the root bound (a large root gap often predicts a hard MIP).
+Notes:
+	Gurobi has no attributes for the root relaxation, so it is parsed from
+	the "Root relaxation:" line of the log by TimingLogParser.
+*/
+
+/*
+RootRelaxation
+Description:
+
+	The outcome of the root relaxation of a MIP. Available is false if the
+	log did not report a root relaxation (e.g., for an LP, or if presolve
+	solved the model). Status is "objective" if the relaxation was solved,
+	and otherwise the reason reported in the log (e.g., "infeasible" or
+	"cutoff"); Objective is only meaningful for "objective".
+*/
+type RootRelaxation struct {
+	Available  bool    `json:"available"`
+	Status     string  `json:"status"`
+	Objective  float64 `json:"objective"`
+	Iterations int64   `json:"iterations"`
+	Time       float64 `json:"time"`
+}
+
+var (
+	rootStatusPattern     = regexp.MustCompile(`^Root relaxation: (\w+)`)
+	rootObjectivePattern  = regexp.MustCompile(`^Root relaxation: objective ([0-9.eE+-]+)`)
+	rootIterationsPattern = regexp.MustCompile(`(\d+) iterations`)
+)
+
+/*
+Gap
+Description:
+
+	Returns the relative gap between the incumbent and the root bound for
+	the sense (see RelativeGap), or +Inf if the root relaxation was not solved.
+*/
+func (root RootRelaxation) Gap(incumbent float64, sense ObjSense) float64 {
+	if !root.Available || root.Status != "objective" {
+		return math.Inf(1)
+	}
+	return RelativeGap(incumbent, root.Objective, sense)
+}
+
+// parseRootRelaxation parses the "Root relaxation:" line of a MIP log.
+func parseRootRelaxation(line string) (RootRelaxation, bool) {
+	match := rootStatusPattern.FindStringSubmatch(line)
+	if match == nil {
+		return RootRelaxation{}, false
+	}
+
+	root := RootRelaxation{Available: true, Status: match[1]}
+	if match := rootObjectivePattern.FindStringSubmatch(line); match != nil {
+		root.Objective, _ = strconv.ParseFloat(match[1], 64)
+	}
+	if match := rootIterationsPattern.FindStringSubmatch(line); match != nil {
+		root.Iterations, _ = strconv.ParseInt(match[1], 10, 64)
+	}
+	if match := timingRootPattern.FindStringSubmatch(line); match != nil {
+		root.Time, _ = strconv.ParseFloat(match[1], 64)
+	}
+	return root, true
+}
@@ -0,0 +1,169 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"fmt"
+	"math"
+)
+
+/*
+scaling.go
+Description:
+	Row scaling for badly scaled models. Every linear constraint is
+	multiplied by a positive factor so that its largest coefficient has a
+	given magnitude. The factors are kept so that duals and slacks of the
+	scaled model can be mapped back to the original constraints.
+*/
+
+/*
+RowScaling
+Description:
+
+	The factors by which the linear constraints were multiplied, by constraint index.
+	A row that was multiplied by f has the dual pi * f and the slack s / f in
+	terms of the original constraint, where pi and s are its dual and slack
+	in the scaled model.
+*/
+type RowScaling struct {
+	Factors []float64
+}
+
+/*
+NormalizeRows
+Description:
+
+	Multiplies each linear constraint (coefficients and right-hand side) by
+	a positive factor so that its largest absolute coefficient is maxCoeff.
+	Empty rows and infinite right-hand sides are left unchanged. The
+	coefficients are changed with a single call to GRBchgcoeffs.
+*/
+func (model *Model) NormalizeRows(maxCoeff float64) (*RowScaling, error) {
+	// Input Processing
+	if maxCoeff <= 0 || math.IsInf(maxCoeff, 0) || math.IsNaN(maxCoeff) {
+		return nil, fmt.Errorf("the target coefficient magnitude must be positive and finite; received %v", maxCoeff)
+	}
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	data, err := model.LinearData()
+	if err != nil {
+		return nil, err
+	}
+
+	// Algorithm
+	numConstrs := len(data.RHS)
+	scaling := &RowScaling{Factors: make([]float64, numConstrs)}
+
+	cind := make([]int32, 0, len(data.Ind))
+	vind := make([]int32, 0, len(data.Ind))
+	vals := make([]float64, 0, len(data.Ind))
+	rhsInd := []int32{}
+	rhs := []float64{}
+	for i := 0; i < numConstrs; i++ {
+		scaling.Factors[i] = 1.0
+
+		largest := 0.0
+		for k := data.Beg[i]; k < data.Beg[i+1]; k++ {
+			largest = math.Max(largest, math.Abs(data.Val[k]))
+		}
+		if largest == 0 || largest == maxCoeff {
+			continue
+		}
+
+		factor := maxCoeff / largest
+		scaling.Factors[i] = factor
+		for k := data.Beg[i]; k < data.Beg[i+1]; k++ {
+			cind = append(cind, int32(i))
+			vind = append(vind, data.Ind[k])
+			vals = append(vals, data.Val[k]*factor)
+		}
+		if math.Abs(data.RHS[i]) < INFINITY {
+			rhsInd = append(rhsInd, int32(i))
+			rhs = append(rhs, data.RHS[i]*factor)
+		}
+	}
+
+	if len(vals) > 0 {
+		done := traceCall("GRBchgcoeffs", len(vals))
+		errCode := C.GRBchgcoeffs(model.AsGRBModel, C.int(len(vals)), (*C.int)(&cind[0]), (*C.int)(&vind[0]), (*C.double)(&vals[0]))
+		done(errCode)
+		if errCode != 0 {
+			return nil, model.MakeError(errCode)
+		}
+		model.changes.attr("A")
+	}
+
+	if err := model.setDoubleAttrList(DBL_ATTR_RHS, rhsInd, rhs); err != nil {
+		return nil, err
+	}
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	return scaling, nil
+}
+
+/*
+UnscaleDuals
+Description:
+
+	Maps the duals (Pi) of the scaled model back to the original constraints.
+*/
+func (rs *RowScaling) UnscaleDuals(pi []float64) ([]float64, error) {
+	if len(pi) != len(rs.Factors) {
+		return nil, MismatchedLengthError{
+			Length1: len(pi),
+			Name1:   "pi",
+			Length2: len(rs.Factors),
+			Name2:   "Factors",
+		}
+	}
+
+	out := make([]float64, len(pi))
+	for i := range pi {
+		out[i] = pi[i] * rs.Factors[i]
+	}
+	return out, nil
+}
+
+/*
+UnscaleSlacks
+Description:
+
+	Maps the slacks of the scaled model back to the original constraints.
+*/
+func (rs *RowScaling) UnscaleSlacks(slacks []float64) ([]float64, error) {
+	if len(slacks) != len(rs.Factors) {
+		return nil, MismatchedLengthError{
+			Length1: len(slacks),
+			Name1:   "slacks",
+			Length2: len(rs.Factors),
+			Name2:   "Factors",
+		}
+	}
+
+	out := make([]float64, len(slacks))
+	for i := range slacks {
+		out[i] = slacks[i] / rs.Factors[i]
+	}
+	return out, nil
+}
+
+/*
+OriginalDuals
+Description:
+
+	Returns the duals of the current solution of model in terms of the
+	constraints before they were scaled.
+*/
+func (rs *RowScaling) OriginalDuals(model *Model) ([]float64, error) {
+	pi, err := model.getDoubleAttrArray("Pi", 0, int32(len(rs.Factors)))
+	if err != nil {
+		return nil, err
+	}
+	return rs.UnscaleDuals(pi)
+}
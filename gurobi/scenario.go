@@ -0,0 +1,472 @@
+package gurobi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"text/tabwriter"
+)
+
+/*
+scenario.go
+Description:
+	A batch runner which solves a base model under a list of scenarios, each
+	of which overrides some parameters, bounds, objective coefficients or
+	right-hand sides, and collects the objective and key variable values of
+	every scenario in a comparison table. The base model is never changed:
+	every scenario is solved on a copy, or all of them together in a single
+	multi-scenario solve.
+*/
+
+/*
+VarOverride
+Description:
+
+	A new value for an attribute (a bound or the objective coefficient) of Var.
+*/
+type VarOverride struct {
+	Var   *Var
+	Value float64
+}
+
+/*
+ConstrOverride
+Description:
+
+	A new right-hand side for Constr.
+*/
+type ConstrOverride struct {
+	Constr *Constr
+	Value  float64
+}
+
+/*
+Scenario
+Description:
+
+	A named set of changes to the base model.
+	- Params: Parameter values in their string form (see Model.SetParam).
+	- LB, UB, Obj: New lower bounds, upper bounds and objective coefficients.
+	- RHS: New right-hand sides of linear constraints.
+*/
+type Scenario struct {
+	Name   string
+	Params map[string]string
+	LB     []VarOverride
+	UB     []VarOverride
+	Obj    []VarOverride
+	RHS    []ConstrOverride
+}
+
+/*
+ScenarioOptions
+Description:
+
+	Controls how RunScenarios solves the scenarios.
+	- Report: The key variables whose values are collected for every scenario.
+	- Parallel: The number of scenarios which are solved at the same time.
+	  Each of them is solved in an environment of its own (logging to
+	  LogFile), so Threads should usually be limited in the scenarios.
+	  0 and 1 solve the scenarios one after the other.
+	- MultiScenario: Solves all scenarios in a single multi-scenario solve
+	  (the NumScenarios attribute). Scenarios may then only change bounds,
+	  objective coefficients and right-hand sides, not parameters.
+*/
+type ScenarioOptions struct {
+	Report        []*Var
+	Parallel      int
+	MultiScenario bool
+	LogFile       string
+}
+
+/*
+ScenarioResult
+Description:
+
+	The outcome of one scenario. ObjVal and the entries of Values (one per
+	reported variable) are NaN if the scenario has no solution. Err is set
+	if the scenario could not be set up or solved. In a multi-scenario
+	solve, Status and Runtime are those of the whole solve.
+*/
+type ScenarioResult struct {
+	Scenario string
+	Status   Status
+	ObjVal   float64
+	Values   []float64
+	Runtime  float64
+	Err      error
+}
+
+/*
+ScenarioTable
+Description:
+
+	The results of RunScenarios, in the order of the scenarios, with the
+	names of the reported variables.
+*/
+type ScenarioTable struct {
+	VarNames []string
+	Results  []ScenarioResult
+}
+
+/*
+Check
+Description:
+
+	Checks that the scenario has a name and that its overrides refer to
+	variables and constraints.
+*/
+func (scenario Scenario) Check() error {
+	if scenario.Name == "" {
+		return errors.New("a scenario needs a name")
+	}
+
+	for _, overrides := range [][]VarOverride{scenario.LB, scenario.UB, scenario.Obj} {
+		for _, o := range overrides {
+			if o.Var == nil || o.Var.Index < 0 {
+				return fmt.Errorf("scenario %v: invalid variable", scenario.Name)
+			}
+			if math.IsNaN(o.Value) {
+				return fmt.Errorf("scenario %v: the value of variable %v must not be NaN", scenario.Name, o.Var.Index)
+			}
+		}
+	}
+	for _, o := range scenario.RHS {
+		if o.Constr == nil || o.Constr.Index < 0 {
+			return fmt.Errorf("scenario %v: invalid constraint", scenario.Name)
+		}
+		if math.IsNaN(o.Value) {
+			return fmt.Errorf("scenario %v: the right-hand side of constraint %v must not be NaN", scenario.Name, o.Constr.Index)
+		}
+	}
+
+	return nil
+}
+
+/*
+RunScenarios
+Description:
+
+	Solves the model under every scenario and returns the comparison table.
+	A failure to solve a single scenario is recorded in its result's Err
+	rather than stopping the run; an error is returned only for invalid
+	scenarios or options, or when a multi-scenario solve fails as a whole.
+*/
+func (model *Model) RunScenarios(scenarios []Scenario, opts ScenarioOptions) (*ScenarioTable, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{}
+	for _, scenario := range scenarios {
+		if err := scenario.Check(); err != nil {
+			return nil, err
+		}
+		if names[scenario.Name] {
+			return nil, fmt.Errorf("the scenario name \"%v\" is used more than once", scenario.Name)
+		}
+		names[scenario.Name] = true
+
+		if opts.MultiScenario && len(scenario.Params) > 0 {
+			return nil, fmt.Errorf("scenario %v: a multi-scenario solve cannot change parameters", scenario.Name)
+		}
+	}
+	if opts.MultiScenario && opts.Parallel > 1 {
+		return nil, errors.New("a multi-scenario solve cannot be run in parallel")
+	}
+
+	for _, v := range opts.Report {
+		if v == nil || v.Index < 0 {
+			return nil, errors.New("invalid reported variable")
+		}
+	}
+
+	table := &ScenarioTable{VarNames: make([]string, len(opts.Report))}
+	for i, v := range opts.Report {
+		if table.VarNames[i], err = v.GetString("VarName"); err != nil {
+			return nil, err
+		}
+	}
+
+	// Algorithm
+	switch {
+	case opts.MultiScenario:
+		table.Results, err = model.runMultiScenario(scenarios, opts.Report)
+	case opts.Parallel > 1:
+		table.Results = model.runScenariosParallel(scenarios, opts)
+	default:
+		table.Results = make([]ScenarioResult, len(scenarios))
+		for i, scenario := range scenarios {
+			table.Results[i] = model.runScenario(scenario, opts.Report, model.Copy)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return table, nil
+}
+
+// runScenariosParallel solves the scenarios with opts.Parallel workers, each with an environment of its own.
+func (model *Model) runScenariosParallel(scenarios []Scenario, opts ScenarioOptions) []ScenarioResult {
+	results := make([]ScenarioResult, len(scenarios))
+	jobs := make(chan int)
+
+	// The base model is only read by one worker at a time.
+	var copyMu sync.Mutex
+	var wg sync.WaitGroup
+	for w := 0; w < opts.Parallel && w < len(scenarios); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			env, err := NewEnv(opts.LogFile)
+			if err != nil {
+				for i := range jobs {
+					results[i] = newScenarioResult(scenarios[i].Name, len(opts.Report))
+					results[i].Err = err
+				}
+				return
+			}
+			defer env.Free()
+
+			copyModel := func() (*Model, error) {
+				copyMu.Lock()
+				defer copyMu.Unlock()
+				return model.CopyToEnv(env)
+			}
+			for i := range jobs {
+				results[i] = model.runScenario(scenarios[i], opts.Report, copyModel)
+			}
+		}()
+	}
+
+	for i := range scenarios {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// newScenarioResult returns the result of a scenario without a solution.
+func newScenarioResult(name string, numReported int) ScenarioResult {
+	result := ScenarioResult{Scenario: name, ObjVal: math.NaN(), Runtime: math.NaN(), Values: make([]float64, numReported)}
+	for j := range result.Values {
+		result.Values[j] = math.NaN()
+	}
+	return result
+}
+
+// runScenario solves scenario on a copy of the model made by copyModel.
+func (model *Model) runScenario(scenario Scenario, report []*Var, copyModel func() (*Model, error)) ScenarioResult {
+	result := newScenarioResult(scenario.Name, len(report))
+
+	scenarioModel, err := copyModel()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	defer scenarioModel.Free()
+
+	if result.Err = scenario.apply(scenarioModel); result.Err != nil {
+		return result
+	}
+	if result.Err = scenarioModel.Optimize(); result.Err != nil {
+		return result
+	}
+
+	if result.Status, result.Err = scenarioModel.Status(); result.Err != nil {
+		return result
+	}
+	if result.Runtime, result.Err = scenarioModel.Runtime(); result.Err != nil {
+		return result
+	}
+
+	solCount, err := scenarioModel.GetIntAttr(INT_ATTR_SOLCOUNT)
+	if err != nil || solCount == 0 {
+		result.Err = err
+		return result
+	}
+	if result.ObjVal, result.Err = scenarioModel.ObjVal(); result.Err != nil {
+		return result
+	}
+	for j, v := range report {
+		if result.Values[j], result.Err = scenarioModel.getDoubleAttrElement("X", v.Index); result.Err != nil {
+			return result
+		}
+	}
+
+	return result
+}
+
+// apply makes the changes of the scenario to model.
+func (scenario Scenario) apply(model *Model) error {
+	for name, value := range scenario.Params {
+		if err := model.SetParam(name, value); err != nil {
+			return err
+		}
+	}
+	return scenario.setAttrs(model, "LB", "UB", "Obj", "RHS")
+}
+
+// setAttrs sets the bounds, objective coefficients and right-hand sides of the
+// scenario on model, with the given attribute names.
+func (scenario Scenario) setAttrs(model *Model, lb string, ub string, obj string, rhs string) error {
+	varAttrs := []struct {
+		attr      string
+		overrides []VarOverride
+	}{
+		{lb, scenario.LB},
+		{ub, scenario.UB},
+		{obj, scenario.Obj},
+	}
+	for _, a := range varAttrs {
+		for _, o := range a.overrides {
+			if err := model.setDoubleAttrElement(a.attr, o.Var.Index, o.Value); err != nil {
+				return fmt.Errorf("scenario %v: %w", scenario.Name, err)
+			}
+		}
+	}
+
+	for _, o := range scenario.RHS {
+		if err := model.setDoubleAttrElement(rhs, o.Constr.Index, o.Value); err != nil {
+			return fmt.Errorf("scenario %v: %w", scenario.Name, err)
+		}
+	}
+
+	return model.Update()
+}
+
+/*
+runMultiScenario
+Description:
+
+	Solves all scenarios together on a copy of the model, with the scenario
+	attributes ScenNLB, ScenNUB, ScenNObj and ScenNRHS.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/multiple_scenarios.html
+*/
+func (model *Model) runMultiScenario(scenarios []Scenario, report []*Var) ([]ScenarioResult, error) {
+	scenarioModel, err := model.Copy()
+	if err != nil {
+		return nil, err
+	}
+	defer scenarioModel.Free()
+
+	if err := scenarioModel.SetNumScenarios(len(scenarios)); err != nil {
+		return nil, err
+	}
+	for i, scenario := range scenarios {
+		if err := scenarioModel.SetIntParam("ScenarioNumber", i); err != nil {
+			return nil, err
+		}
+		if err := scenario.setAttrs(scenarioModel, DBL_ATTR_SCENNLB, DBL_ATTR_SCENNUB, DBL_ATTR_SCENNOBJ, DBL_ATTR_SCENNRHS); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := scenarioModel.Optimize(); err != nil {
+		return nil, err
+	}
+	status, err := scenarioModel.Status()
+	if err != nil {
+		return nil, err
+	}
+	runtime, err := scenarioModel.Runtime()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ScenarioResult, len(scenarios))
+	for i, scenario := range scenarios {
+		results[i] = newScenarioResult(scenario.Name, len(report))
+		results[i].Status, results[i].Runtime = status, runtime
+		results[i].Err = scenarioModel.readScenario(i, report, &results[i])
+	}
+	return results, nil
+}
+
+// readScenario reads the objective value and reported values of scenario i into result.
+func (model *Model) readScenario(i int, report []*Var, result *ScenarioResult) error {
+	if err := model.SetIntParam("ScenarioNumber", i); err != nil {
+		return err
+	}
+
+	objVal, err := model.GetDoubleAttr(DBL_ATTR_SCENNOBJVAL)
+	if errors.Is(err, ErrDataNotAvailable) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	// A scenario without a solution has an infinite objective value.
+	if math.Abs(objVal) >= INFINITY {
+		return nil
+	}
+
+	result.ObjVal = objVal
+	for j, v := range report {
+		if result.Values[j], err = model.getDoubleAttrElement(DBL_ATTR_SCENNX, v.Index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+Best
+Description:
+
+	Returns the result with the best objective value for the given sense, or
+	nil if no scenario has a solution.
+*/
+func (table *ScenarioTable) Best(sense ObjSense) *ScenarioResult {
+	var best *ScenarioResult
+	for i := range table.Results {
+		result := &table.Results[i]
+		if result.Err != nil || math.IsNaN(result.ObjVal) {
+			continue
+		}
+		if best == nil || IsBetter(result.ObjVal, best.ObjVal, sense) {
+			best = result
+		}
+	}
+	return best
+}
+
+/*
+Write
+Description:
+
+	Writes the table as aligned columns: one row per scenario with its
+	status, objective value, runtime and the values of the reported
+	variables. Failed scenarios show their error instead.
+*/
+func (table *ScenarioTable) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprint(tw, "SCENARIO\tSTATUS\tOBJECTIVE\tRUNTIME")
+	for _, name := range table.VarNames {
+		fmt.Fprintf(tw, "\t%v", name)
+	}
+	fmt.Fprintln(tw)
+
+	for _, result := range table.Results {
+		if result.Err != nil {
+			fmt.Fprintf(tw, "%v\terror: %v\n", result.Scenario, result.Err)
+			continue
+		}
+		fmt.Fprintf(tw, "%v\t%v\t%.6g\t%.3f", result.Scenario, result.Status, result.ObjVal, result.Runtime)
+		for _, value := range result.Values {
+			fmt.Fprintf(tw, "\t%.6g", value)
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
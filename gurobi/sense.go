@@ -1,5 +1,9 @@
 package gurobi
 
+// #include <gurobi_passthrough.h>
+import "C"
+import "fmt"
+
 /*
 sense.go
 Descripiton:
@@ -10,3 +14,100 @@ Descripiton:
 const SenseGreaterThan = '>'
 const SenseLessThan = '<'
 const SenseEqual = '='
+
+/*
+Sense
+Description:
+
+	The sense of a linear (or quadratic) constraint.
+	Its underlying type is int8 so that the old char codes ('<', '>', '=')
+	and the constants above can still be used wherever a Sense is expected.
+*/
+type Sense int8
+
+const (
+	Le Sense = C.GRB_LESS_EQUAL
+	Ge Sense = C.GRB_GREATER_EQUAL
+	Eq Sense = C.GRB_EQUAL
+)
+
+/*
+Check
+Description:
+
+	Checks that the Sense is one of the three constraint senses that Gurobi recognizes.
+*/
+func (s Sense) Check() error {
+	switch s {
+	case Le, Ge, Eq:
+		return nil
+	default:
+		return InvalidSenseError{Sense: int8(s)}
+	}
+}
+
+/*
+String
+Description:
+
+	Returns the mathematical symbol associated with the constraint sense.
+*/
+func (s Sense) String() string {
+	switch s {
+	case Le:
+		return "<="
+	case Ge:
+		return ">="
+	case Eq:
+		return "=="
+	default:
+		return fmt.Sprintf("Sense(%v)", int8(s))
+	}
+}
+
+/*
+ObjSense
+Description:
+
+	The sense of the objective function (i.e., the ModelSense attribute).
+	Its underlying type is int32 so that the MINIMIZE and MAXIMIZE constants
+	can still be used wherever an ObjSense is expected.
+*/
+type ObjSense int32
+
+const (
+	Minimize ObjSense = C.GRB_MINIMIZE
+	Maximize ObjSense = C.GRB_MAXIMIZE
+)
+
+/*
+Check
+Description:
+
+	Checks that the ObjSense is either Minimize or Maximize.
+*/
+func (s ObjSense) Check() error {
+	switch s {
+	case Minimize, Maximize:
+		return nil
+	default:
+		return fmt.Errorf("the objective sense %v is not valid; expected %v or %v", int32(s), int32(Minimize), int32(Maximize))
+	}
+}
+
+/*
+String
+Description:
+
+	Returns the name of the objective sense.
+*/
+func (s ObjSense) String() string {
+	switch s {
+	case Minimize:
+		return "Minimize"
+	case Maximize:
+		return "Maximize"
+	default:
+		return fmt.Sprintf("ObjSense(%v)", int32(s))
+	}
+}
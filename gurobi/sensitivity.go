@@ -0,0 +1,184 @@
+package gurobi
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+/*
+sensitivity.go
+Description:
+	Builds a report of shadow prices, reduced costs and sensitivity ranges of
+	a solved LP, which can be written as JSON or CSV.
+*/
+
+/*
+VarSensitivity
+Description:
+
+	The solution information of a single variable:
+	its value (X), reduced cost (RC) and objective coefficient range (SAObjLow, SAObjUp).
+*/
+type VarSensitivity struct {
+	Name        string  `json:"name"`
+	Value       float64 `json:"value"`
+	Obj         float64 `json:"obj"`
+	ReducedCost float64 `json:"reduced_cost"`
+	ObjLow      float64 `json:"obj_low"`
+	ObjUp       float64 `json:"obj_up"`
+}
+
+/*
+ConstrSensitivity
+Description:
+
+	The solution information of a single linear constraint: its slack,
+	shadow price (Pi) and right-hand side range (SARHSLow, SARHSUp).
+*/
+type ConstrSensitivity struct {
+	Name        string  `json:"name"`
+	RHS         float64 `json:"rhs"`
+	Slack       float64 `json:"slack"`
+	ShadowPrice float64 `json:"shadow_price"`
+	RHSLow      float64 `json:"rhs_low"`
+	RHSUp       float64 `json:"rhs_up"`
+}
+
+// SensitivityReport collects the sensitivity information of all variables and constraints.
+type SensitivityReport struct {
+	Vars    []VarSensitivity    `json:"vars"`
+	Constrs []ConstrSensitivity `json:"constrs"`
+}
+
+/*
+SensitivityReport
+Description:
+
+	Reads the solution and sensitivity attributes of all variables and
+	constraints with bulk queries. Sensitivity information is only available
+	for continuous models that were solved to optimality.
+*/
+func (model *Model) SensitivityReport() (*SensitivityReport, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	numVars, err := model.NumVars()
+	if err != nil {
+		return nil, err
+	}
+
+	numConstrs, err := model.NumConstrs()
+	if err != nil {
+		return nil, err
+	}
+
+	// Variables
+	varNames, err := model.getStringAttrArray("VarName", 0, numVars)
+	if err != nil {
+		return nil, err
+	}
+
+	varAttrs := []string{DBL_ATTR_X, "Obj", "RC", "SAObjLow", "SAObjUp"}
+	varValues := make([][]float64, len(varAttrs))
+	for i, attr := range varAttrs {
+		if varValues[i], err = model.getDoubleAttrArray(attr, 0, numVars); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &SensitivityReport{
+		Vars:    make([]VarSensitivity, numVars),
+		Constrs: make([]ConstrSensitivity, numConstrs),
+	}
+	for j := range report.Vars {
+		report.Vars[j] = VarSensitivity{
+			Name:        varNames[j],
+			Value:       varValues[0][j],
+			Obj:         varValues[1][j],
+			ReducedCost: varValues[2][j],
+			ObjLow:      varValues[3][j],
+			ObjUp:       varValues[4][j],
+		}
+	}
+
+	// Constraints
+	constrNames, err := model.getStringAttrArray("ConstrName", 0, numConstrs)
+	if err != nil {
+		return nil, err
+	}
+
+	constrAttrs := []string{"RHS", "Slack", "Pi", "SARHSLow", "SARHSUp"}
+	constrValues := make([][]float64, len(constrAttrs))
+	for i, attr := range constrAttrs {
+		if constrValues[i], err = model.getDoubleAttrArray(attr, 0, numConstrs); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range report.Constrs {
+		report.Constrs[i] = ConstrSensitivity{
+			Name:        constrNames[i],
+			RHS:         constrValues[0][i],
+			Slack:       constrValues[1][i],
+			ShadowPrice: constrValues[2][i],
+			RHSLow:      constrValues[3][i],
+			RHSUp:       constrValues[4][i],
+		}
+	}
+
+	return report, nil
+}
+
+/*
+WriteJSON
+Description:
+
+	Writes the report to w as indented JSON.
+*/
+func (sr *SensitivityReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sr)
+}
+
+/*
+WriteVarsCSV
+Description:
+
+	Writes the variable part of the report to w as CSV (with a header row).
+*/
+func (sr *SensitivityReport) WriteVarsCSV(w io.Writer) error {
+	rows := [][]string{{"name", "value", "obj", "reduced_cost", "obj_low", "obj_up"}}
+	for _, v := range sr.Vars {
+		rows = append(rows, []string{
+			v.Name, formatFloat(v.Value), formatFloat(v.Obj),
+			formatFloat(v.ReducedCost), formatFloat(v.ObjLow), formatFloat(v.ObjUp),
+		})
+	}
+	return csv.NewWriter(w).WriteAll(rows)
+}
+
+/*
+WriteConstrsCSV
+Description:
+
+	Writes the constraint part of the report to w as CSV (with a header row).
+*/
+func (sr *SensitivityReport) WriteConstrsCSV(w io.Writer) error {
+	rows := [][]string{{"name", "rhs", "slack", "shadow_price", "rhs_low", "rhs_up"}}
+	for _, c := range sr.Constrs {
+		rows = append(rows, []string{
+			c.Name, formatFloat(c.RHS), formatFloat(c.Slack),
+			formatFloat(c.ShadowPrice), formatFloat(c.RHSLow), formatFloat(c.RHSUp),
+		})
+	}
+	return csv.NewWriter(w).WriteAll(rows)
+}
+
+func formatFloat(x float64) string {
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
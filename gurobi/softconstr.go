@@ -0,0 +1,226 @@
+package gurobi
+
+import (
+	"fmt"
+	"math"
+)
+
+/*
+softconstr.go
+Description:
+	Soft constraints, which may be violated at a cost: each one gets slack
+	variables whose objective coefficients penalize the violation, so that
+	goal-programming models need no manual slack bookkeeping. The model
+	keeps track of its soft constraints to report their violations.
+Notes:
+	The penalties are added to the objective through the Obj attribute of
+	the slack variables, which SetObjective leaves untouched. Their signs
+	follow the ModelSense attribute, so that violations are always
+	penalized when minimizing and when maximizing.
+*/
+
+/*
+SoftConstr
+Description:
+
+	A linear constraint whose violation is penalized with Weight per unit.
+	- Over: The slack by which the left-hand side may exceed the right-hand
+	  side (nil for a >= constraint).
+	- Under: The slack by which the left-hand side may fall short of the
+	  right-hand side (nil for a <= constraint).
+*/
+type SoftConstr struct {
+	Name   string
+	Constr *Constr
+	Weight float64
+	Over   *Var
+	Under  *Var
+}
+
+/*
+SoftConstrReport
+Description:
+
+	The violation of a soft constraint in the current solution and its
+	penalty (Weight * Violation). Over and Under are the values of the
+	slacks; Violation is their sum.
+*/
+type SoftConstrReport struct {
+	Name      string
+	Weight    float64
+	Over      float64
+	Under     float64
+	Violation float64
+	Penalty   float64
+}
+
+/*
+AddSoftConstr
+Description:
+
+	Adds tc as a soft constraint: LHS - over + under (sense) RHS, where the
+	slacks over (for <= and =) and under (for >= and =) are nonnegative
+	and penalized with weight per unit in the objective. The slacks are
+	named name_over and name_under.
+*/
+func (model *Model) AddSoftConstr(tc TempConstr, weight float64, name string) (*SoftConstr, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tc.Check(); err != nil {
+		return nil, err
+	}
+
+	if math.IsNaN(weight) || math.IsInf(weight, 0) || weight <= 0 {
+		return nil, fmt.Errorf("the penalty weight must be positive and finite; received %v", weight)
+	}
+
+	sense, err := model.GetModelSense()
+	if err != nil {
+		return nil, err
+	}
+
+	// Algorithm
+	sc := &SoftConstr{Name: name, Weight: weight}
+	vars := append([]*Var{}, tc.LHS.Ind...)
+	vals := append([]float64{}, tc.LHS.Val...)
+
+	if tc.Sense == Le || tc.Sense == Eq {
+		if sc.Over, err = model.AddVar(Continuous, sc.penalty(sense), 0.0, INFINITY, name+"_over", []*Constr{}, []float64{}); err != nil {
+			return nil, err
+		}
+		vars, vals = append(vars, sc.Over), append(vals, -1.0)
+	}
+	if tc.Sense == Ge || tc.Sense == Eq {
+		if sc.Under, err = model.AddVar(Continuous, sc.penalty(sense), 0.0, INFINITY, name+"_under", []*Constr{}, []float64{}); err != nil {
+			return nil, err
+		}
+		vars, vals = append(vars, sc.Under), append(vals, 1.0)
+	}
+
+	if sc.Constr, err = model.AddConstr(vars, vals, tc.Sense, tc.NormalizedRHS(), name); err != nil {
+		return nil, err
+	}
+
+	model.softConstrs = append(model.softConstrs, sc)
+	return sc, nil
+}
+
+/*
+SoftConstrs
+Description:
+
+	Returns the soft constraints of the model in the order in which they were added.
+*/
+func (model *Model) SoftConstrs() []*SoftConstr {
+	return append([]*SoftConstr{}, model.softConstrs...)
+}
+
+// penalty returns the objective coefficient of the slacks of sc for the given sense.
+func (sc *SoftConstr) penalty(sense ObjSense) float64 {
+	if sense == Maximize {
+		return -sc.Weight
+	}
+	return sc.Weight
+}
+
+// slacks returns the slack variables of sc.
+func (sc *SoftConstr) slacks() []*Var {
+	slacks := []*Var{}
+	for _, slack := range []*Var{sc.Over, sc.Under} {
+		if slack != nil {
+			slacks = append(slacks, slack)
+		}
+	}
+	return slacks
+}
+
+// signSoftPenalties sets the objective coefficients of all slacks for the given sense.
+func (model *Model) signSoftPenalties(sense ObjSense) error {
+	for _, sc := range model.softConstrs {
+		for _, slack := range sc.slacks() {
+			if err := model.setDoubleAttrElement(DBL_ATTR_OBJ, slack.Index, sc.penalty(sense)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+/*
+Violation
+Description:
+
+	Returns the amount by which the soft constraint is violated in the
+	current solution (the sum of its slacks).
+*/
+func (sc *SoftConstr) Violation() (float64, error) {
+	over, under, err := sc.slackValues()
+	return over + under, err
+}
+
+// slackValues returns the values of the slacks of sc in the current solution (0 for a missing slack).
+func (sc *SoftConstr) slackValues() (float64, float64, error) {
+	values := [2]float64{}
+	for i, slack := range []*Var{sc.Over, sc.Under} {
+		if slack == nil {
+			continue
+		}
+		value, err := slack.GetDouble(DBL_ATTR_X)
+		if err != nil {
+			return 0, 0, err
+		}
+		values[i] = value
+	}
+	return values[0], values[1], nil
+}
+
+/*
+SoftConstrReport
+Description:
+
+	Returns the violation and penalty of every soft constraint in the
+	current solution, and the total penalty.
+*/
+func (model *Model) SoftConstrReport() ([]SoftConstrReport, float64, error) {
+	reports := make([]SoftConstrReport, len(model.softConstrs))
+	total := 0.0
+	for i, sc := range model.softConstrs {
+		over, under, err := sc.slackValues()
+		if err != nil {
+			return nil, 0, err
+		}
+		reports[i] = SoftConstrReport{
+			Name:      sc.Name,
+			Weight:    sc.Weight,
+			Over:      over,
+			Under:     under,
+			Violation: over + under,
+			Penalty:   sc.Weight * (over + under),
+		}
+		total += reports[i].Penalty
+	}
+	return reports, total, nil
+}
+
+// copySoftConstrs returns the soft constraints of a copy of the model.
+func copySoftConstrs(softConstrs []*SoftConstr, copied *Model) []*SoftConstr {
+	if len(softConstrs) == 0 {
+		return nil
+	}
+
+	out := make([]*SoftConstr, len(softConstrs))
+	for i, sc := range softConstrs {
+		out[i] = &SoftConstr{Name: sc.Name, Weight: sc.Weight, Constr: &Constr{copied, sc.Constr.Index}}
+		if sc.Over != nil {
+			out[i].Over = &Var{copied, sc.Over.Index}
+		}
+		if sc.Under != nil {
+			out[i].Under = &Var{copied, sc.Under.Index}
+		}
+	}
+	return out
+}
@@ -0,0 +1,454 @@
+package gurobi
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+solution.go
+Description:
+	Functions for reading the solution of a model by variable name, so that
+	application code does not need to keep its own Var bookkeeping.
+*/
+
+/*
+VarNames
+Description:
+
+	Returns the names of all variables in the model (in index order) with a single bulk query.
+*/
+func (model *Model) VarNames() ([]string, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	numVars, err := model.NumVars()
+	if err != nil {
+		return nil, err
+	}
+
+	return model.getStringAttrArray("VarName", 0, numVars)
+}
+
+/*
+SolutionMap
+Description:
+
+	Returns the value of every variable in the current solution keyed by the
+	variable's name. An error is returned if two variables share a name.
+*/
+func (model *Model) SolutionMap() (map[string]float64, error) {
+	table, err := model.SolutionTable()
+	if err != nil {
+		return nil, err
+	}
+	return table.Values, nil
+}
+
+/*
+SolutionTable
+Description:
+
+	A solution keyed by variable name, with accessors for names of the form
+	base[i] and base[i,j] (the format written by most modeling layers).
+*/
+type SolutionTable struct {
+	Values map[string]float64
+}
+
+/*
+SolutionTable
+Description:
+
+	Reads the current solution (X) and the variable names of the model with
+	one bulk query each and returns them as a SolutionTable.
+*/
+func (model *Model) SolutionTable() (*SolutionTable, error) {
+	names, err := model.VarNames()
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := model.getDoubleAttrArray(DBL_ATTR_X, 0, int32(len(names)))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSolutionTable(names, values)
+}
+
+/*
+NewSolutionTable
+Description:
+
+	Creates a SolutionTable from parallel slices of names and values.
+*/
+func NewSolutionTable(names []string, values []float64) (*SolutionTable, error) {
+	if len(names) != len(values) {
+		return nil, MismatchedLengthError{
+			Length1: len(names),
+			Name1:   "names",
+			Length2: len(values),
+			Name2:   "values",
+		}
+	}
+
+	table := &SolutionTable{Values: make(map[string]float64, len(names))}
+	for i, name := range names {
+		if _, ok := table.Values[name]; ok {
+			return nil, fmt.Errorf("the variable name \"%v\" is used more than once", name)
+		}
+		table.Values[name] = values[i]
+	}
+	return table, nil
+}
+
+/*
+Get
+Description:
+
+	Returns the value of the variable with the given name.
+*/
+func (st *SolutionTable) Get(name string) (float64, error) {
+	value, ok := st.Values[name]
+	if !ok {
+		return 0, fmt.Errorf("there is no variable named \"%v\" in the solution", name)
+	}
+	return value, nil
+}
+
+/*
+Names
+Description:
+
+	Returns all variable names of the table in sorted order.
+*/
+func (st *SolutionTable) Names() []string {
+	names := make([]string, 0, len(st.Values))
+	for name := range st.Values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+WithPrefix
+Description:
+
+	Returns the values of all variables whose names start with prefix.
+*/
+func (st *SolutionTable) WithPrefix(prefix string) map[string]float64 {
+	out := map[string]float64{}
+	for name, value := range st.Values {
+		if strings.HasPrefix(name, prefix) {
+			out[name] = value
+		}
+	}
+	return out
+}
+
+/*
+Vector
+Description:
+
+	Returns the values of the variables named base[i], keyed by i.
+*/
+func (st *SolutionTable) Vector(base string) map[int]float64 {
+	out := map[int]float64{}
+	for name, value := range st.Values {
+		idx, ok := parseIndexedName(name, base)
+		if ok && len(idx) == 1 {
+			out[idx[0]] = value
+		}
+	}
+	return out
+}
+
+/*
+Matrix
+Description:
+
+	Returns the values of the variables named base[i,j], keyed by [i, j].
+*/
+func (st *SolutionTable) Matrix(base string) map[[2]int]float64 {
+	out := map[[2]int]float64{}
+	for name, value := range st.Values {
+		idx, ok := parseIndexedName(name, base)
+		if ok && len(idx) == 2 {
+			out[[2]int{idx[0], idx[1]}] = value
+		}
+	}
+	return out
+}
+
+/*
+At
+Description:
+
+	Returns the value of the variable named base[indices[0],indices[1],...].
+*/
+func (st *SolutionTable) At(base string, indices ...int) (float64, error) {
+	parts := make([]string, len(indices))
+	for i, idx := range indices {
+		parts[i] = strconv.Itoa(idx)
+	}
+	return st.Get(fmt.Sprintf("%v[%v]", base, strings.Join(parts, ",")))
+}
+
+// parseIndexedName parses names of the form base[i,j,...] into their integer indices.
+func parseIndexedName(name string, base string) ([]int, bool) {
+	if !strings.HasPrefix(name, base+"[") || !strings.HasSuffix(name, "]") {
+		return nil, false
+	}
+
+	inner := name[len(base)+1 : len(name)-1]
+	parts := strings.Split(inner, ",")
+	idx := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, false
+		}
+		idx[i] = n
+	}
+	return idx, true
+}
+
+/*
+SparseSolution
+Description:
+
+	The nonzero entries of a solution: variable Indices[k] has value Values[k].
+	Indices are in increasing order.
+*/
+type SparseSolution struct {
+	Indices []int32
+	Values  []float64
+}
+
+/*
+NonzeroSolution
+Description:
+
+	Returns the variables whose value in the current solution satisfies
+	|x| > tol. The solution is read with a single bulk X query.
+*/
+func (model *Model) NonzeroSolution(tol float64) (*SparseSolution, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if tol < 0 {
+		return nil, fmt.Errorf("the tolerance must be nonnegative; received %v", tol)
+	}
+
+	numVars, err := model.NumVars()
+	if err != nil {
+		return nil, err
+	}
+
+	x, err := model.getDoubleAttrArray(DBL_ATTR_X, 0, numVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return SparsifySolution(x, tol), nil
+}
+
+/*
+SparsifySolution
+Description:
+
+	Returns the entries of x with |x[i]| > tol.
+*/
+func SparsifySolution(x []float64, tol float64) *SparseSolution {
+	sparse := &SparseSolution{}
+	for i, value := range x {
+		if value > tol || value < -tol {
+			sparse.Indices = append(sparse.Indices, int32(i))
+			sparse.Values = append(sparse.Values, value)
+		}
+	}
+	return sparse
+}
+
+/*
+Len
+Description:
+
+	Returns the number of nonzero entries.
+*/
+func (ss *SparseSolution) Len() int {
+	return len(ss.Indices)
+}
+
+/*
+Get
+Description:
+
+	Returns the value of the variable with the given index (0 if it is not stored).
+*/
+func (ss *SparseSolution) Get(index int32) float64 {
+	k := sort.Search(len(ss.Indices), func(k int) bool { return ss.Indices[k] >= index })
+	if k < len(ss.Indices) && ss.Indices[k] == index {
+		return ss.Values[k]
+	}
+	return 0
+}
+
+/*
+Solution
+Description:
+
+	A dense solution: Values[i] is the value of variable i, whose type is
+	VTypes[i] and whose linear objective coefficient is Obj[i].
+*/
+type Solution struct {
+	Values []float64
+	VTypes []VarType
+	Obj    []float64
+}
+
+/*
+IntegralityViolation
+Description:
+
+	An integral variable whose value is further than the rounding tolerance
+	from the nearest integer.
+*/
+type IntegralityViolation struct {
+	Index int32
+	Value float64
+}
+
+/*
+Solution
+Description:
+
+	Reads the current solution (X), the variable types (VType) and the linear
+	objective coefficients (Obj) of the model with one bulk query each.
+*/
+func (model *Model) Solution() (*Solution, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	numVars, err := model.NumVars()
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := model.getDoubleAttrArray(DBL_ATTR_X, 0, numVars)
+	if err != nil {
+		return nil, err
+	}
+
+	rawTypes, err := model.getCharAttrArray("VType", 0, numVars)
+	if err != nil {
+		return nil, err
+	}
+
+	vtypes := make([]VarType, len(rawTypes))
+	for i, vt := range rawTypes {
+		vtypes[i] = VarType(vt)
+	}
+
+	obj, err := model.getDoubleAttrArray(DBL_ATTR_OBJ, 0, numVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Solution{Values: values, VTypes: vtypes, Obj: obj}, nil
+}
+
+/*
+Round
+Description:
+
+	Returns a copy of the solution in which the value of every integral
+	variable (Binary, Integer or SemiInt) that is within intTol of an integer
+	is snapped to that integer. Integral variables further away are left
+	unchanged and reported as IntegralityViolations.
+*/
+func (s *Solution) Round(intTol float64) (*Solution, []IntegralityViolation, error) {
+	if len(s.Values) != len(s.VTypes) {
+		return nil, nil, MismatchedLengthError{
+			Length1: len(s.Values),
+			Name1:   "Values",
+			Length2: len(s.VTypes),
+			Name2:   "VTypes",
+		}
+	}
+
+	if intTol < 0 {
+		return nil, nil, fmt.Errorf("the integrality tolerance must be nonnegative; received %v", intTol)
+	}
+
+	rounded := &Solution{
+		Values: append([]float64{}, s.Values...),
+		VTypes: append([]VarType{}, s.VTypes...),
+		Obj:    append([]float64{}, s.Obj...),
+	}
+
+	violations := []IntegralityViolation{}
+	for i, value := range s.Values {
+		if !s.VTypes[i].IsIntegral() {
+			continue
+		}
+
+		nearest := math.Round(value)
+		if math.Abs(value-nearest) > intTol {
+			violations = append(violations, IntegralityViolation{Index: int32(i), Value: value})
+			continue
+		}
+
+		// Adding 0 turns -0 into 0
+		rounded.Values[i] = nearest + 0
+	}
+
+	return rounded, violations, nil
+}
+
+/*
+ObjectiveBreakdown
+Description:
+
+	Returns the contribution of each group of variables to the objective
+	value, i.e. the sum of Obj[i] * Values[i] over the variables of the
+	group (e.g., "labor", "transport" and "penalties"). A variable which is
+	in several groups counts towards each of them. Only the linear part of
+	the objective is attributed, so the contributions add up to the
+	objective value minus its constant and quadratic parts when the groups
+	partition the variables.
+*/
+func (s *Solution) ObjectiveBreakdown(groups map[string][]*Var) (map[string]float64, error) {
+	if len(s.Obj) != len(s.Values) {
+		return nil, MismatchedLengthError{
+			Length1: len(s.Values),
+			Name1:   "Values",
+			Length2: len(s.Obj),
+			Name2:   "Obj",
+		}
+	}
+
+	breakdown := make(map[string]float64, len(groups))
+	for group, vars := range groups {
+		contribution := 0.0
+		for _, v := range vars {
+			if v == nil || v.Index < 0 || int(v.Index) >= len(s.Values) {
+				return nil, fmt.Errorf("group %v contains a variable which is not in the solution", group)
+			}
+			contribution += s.Obj[v.Index] * s.Values[v.Index]
+		}
+		breakdown[group] = contribution
+	}
+	return breakdown, nil
+}
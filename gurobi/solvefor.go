@@ -0,0 +1,138 @@
+package gurobi
+
+import (
+	"fmt"
+	"time"
+)
+
+/*
+solvefor.go
+Description:
+	Pause/resume style control of a solve for interactive applications: each
+	call to SolveFor runs the solver for a bounded time and reports the
+	incumbent and bound, and the next call continues the search.
+Notes:
+	If the model is unchanged between two calls, Gurobi continues the
+	interrupted search itself. If it was changed, the incumbent (MIP) or the
+	basis (LP) of the previous call is loaded as a warm start (see Reoptimize).
+	Use Checkpoint to keep the state of a paused solve across processes.
+*/
+
+/*
+SolveProgress
+Description:
+
+	The state of a solve after a call to SolveFor.
+	- Done: False if the solve was paused (by the time limit or Terminate)
+	  and can be continued with another call to SolveFor.
+	- Incumbent, Bound and Gap are only meaningful if SolCount > 0 (Gap and
+	  Bound only for MIPs).
+	- Runtime is the runtime of this call; CumulativeRuntime that of all
+	  calls since the solve was started.
+*/
+type SolveProgress struct {
+	Status            Status
+	Done              bool
+	SolCount          int32
+	Incumbent         float64
+	Bound             float64
+	Gap               float64
+	Runtime           float64
+	CumulativeRuntime float64
+	WarmStart         WarmStart
+}
+
+/*
+SolveFor
+Description:
+
+	Runs the solver for at most d and returns the progress. If the solve is
+	not done, calling SolveFor again continues it. The TimeLimit parameter
+	of the model is restored afterwards.
+*/
+func (model *Model) SolveFor(d time.Duration) (*SolveProgress, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if d <= 0 {
+		return nil, fmt.Errorf("the duration of a solve must be positive; received %v", d)
+	}
+
+	// Algorithm
+	if model.paused {
+		// Carry the runtime of the previous call over into CumulativeRuntime.
+		previous, err := model.optionalDoubleAttr(DBL_ATTR_RUNTIME)
+		if err != nil {
+			return nil, err
+		}
+		model.priorRuntime += previous
+		model.paused = false
+	}
+
+	var warmStart WarmStart
+	err = model.withTimeLimit(d, func() error {
+		warmStart, err = model.Reoptimize()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return model.solveProgress(warmStart)
+}
+
+// withTimeLimit runs solve with the TimeLimit parameter set to d and restores it afterwards.
+func (model *Model) withTimeLimit(d time.Duration, solve func() error) error {
+	timeLimit, err := model.GetDBLParam("TimeLimit")
+	if err != nil {
+		return err
+	}
+	if err := model.SetDBLParam("TimeLimit", d.Seconds()); err != nil {
+		return err
+	}
+
+	solveErr := solve()
+	if err := model.SetDBLParam("TimeLimit", timeLimit); err != nil && solveErr == nil {
+		solveErr = err
+	}
+	return solveErr
+}
+
+// solveProgress collects the SolveProgress after a call to SolveFor.
+func (model *Model) solveProgress(warmStart WarmStart) (*SolveProgress, error) {
+	status, err := model.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &SolveProgress{
+		Status:    status,
+		Done:      status != StatusTimeLimit && status != StatusInterrupted,
+		WarmStart: warmStart,
+	}
+	model.paused = !progress.Done
+
+	if progress.SolCount, err = model.optionalIntAttr(INT_ATTR_SOLCOUNT); err != nil {
+		return nil, err
+	}
+	if progress.SolCount > 0 {
+		if progress.Incumbent, err = model.optionalDoubleAttr(DBL_ATTR_OBJVAL); err != nil {
+			return nil, err
+		}
+	}
+	if progress.Bound, err = model.optionalDoubleAttr(DBL_ATTR_OBJBOUND); err != nil {
+		return nil, err
+	}
+	if progress.Gap, err = model.optionalDoubleAttr(DBL_ATTR_MIPGAP); err != nil {
+		return nil, err
+	}
+	if progress.Runtime, err = model.optionalDoubleAttr(DBL_ATTR_RUNTIME); err != nil {
+		return nil, err
+	}
+	progress.CumulativeRuntime = model.priorRuntime + progress.Runtime
+
+	return progress, nil
+}
@@ -0,0 +1,79 @@
+package gurobi
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+/*
+solvestats.go
+Description:
+	Statistics about the cuts and heuristic solutions of a MIP solve, for
+	users who tune the cut and heuristic parameters.
+Notes:
+	The statistics are parsed from the log by TimingLogParser: the
+	"Cutting planes:" summary, the "Found heuristic solution" lines and the
+	node log lines marked H/h (heuristic) or * (found at a node).
+*/
+
+/*
+SolveStats
+Description:
+
+	Cut and heuristic statistics of a MIP solve.
+	- Cuts: The number of cuts applied per cut family (e.g., "Gomory", "MIR").
+	- HeuristicSolutions: The number of improving solutions found by heuristics.
+	- NodeSolutions: The number of improving solutions found as integral
+	  node relaxations during branching.
+*/
+type SolveStats struct {
+	Cuts               map[string]int `json:"cuts"`
+	HeuristicSolutions int            `json:"heuristic_solutions"`
+	NodeSolutions      int            `json:"node_solutions"`
+}
+
+/*
+TotalCuts
+Description:
+
+	Returns the number of cuts of all families.
+*/
+func (stats SolveStats) TotalCuts() int {
+	total := 0
+	for _, n := range stats.Cuts {
+		total += n
+	}
+	return total
+}
+
+var (
+	statsCutPattern       = regexp.MustCompile(`^\s+([A-Za-z][\w -]*?): (\d+)\s*$`)
+	statsHeuristicPattern = regexp.MustCompile(`^(?:Found heuristic solution|[Hh]\s*\d+\s+\d+\s)`)
+	statsNodePattern      = regexp.MustCompile(`^\*\s*\d+\s+\d+\s`)
+)
+
+// parseStatsLine updates the statistics with a line of the log.
+func (parser *TimingLogParser) parseStatsLine(line string) {
+	if strings.HasPrefix(line, "Cutting planes:") {
+		parser.inCuts = true
+		parser.Stats.Cuts = map[string]int{}
+		return
+	}
+
+	if parser.inCuts {
+		if match := statsCutPattern.FindStringSubmatch(line); match != nil {
+			n, _ := strconv.Atoi(match[2])
+			parser.Stats.Cuts[match[1]] += n
+			return
+		}
+		parser.inCuts = false
+	}
+
+	switch {
+	case statsHeuristicPattern.MatchString(line):
+		parser.Stats.HeuristicSolutions++
+	case statsNodePattern.MatchString(line):
+		parser.Stats.NodeSolutions++
+	}
+}
@@ -0,0 +1,214 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"errors"
+	"fmt"
+)
+
+/*
+sos.go
+Description:
+	Functions for adding Special Ordered Set (SOS) constraints to a model.
+Notes:
+	https://www.gurobi.com/documentation/current/refman/constraints.html#subsubsection:SOSConstraints
+*/
+
+// Gurobi SOS constraint object
+type SOS struct {
+	Model *Model
+	Index int32
+}
+
+/*
+SOSType
+Description:
+
+	The type of an SOS constraint. At most one variable of an SOS1 set may be
+	nonzero; at most two consecutive variables (in weight order) of an SOS2
+	set may be nonzero.
+*/
+type SOSType int32
+
+const (
+	SOSType1 SOSType = C.GRB_SOS_TYPE1
+	SOSType2 SOSType = C.GRB_SOS_TYPE2
+)
+
+/*
+Check
+Description:
+
+	Returns an error if the SOSType is not one of SOSType1 or SOSType2.
+*/
+func (st SOSType) Check() error {
+	switch st {
+	case SOSType1, SOSType2:
+		return nil
+	default:
+		return fmt.Errorf("invalid SOS type %v", int32(st))
+	}
+}
+
+/*
+AddSOS
+Description:
+
+	Adds a single SOS constraint of type sosType over vars, ordered by weights.
+	The weights must be unique.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_addsos.html
+*/
+func (model *Model) AddSOS(vars []*Var, weights []float64, sosType SOSType) (*SOS, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sosType.Check(); err != nil {
+		return nil, err
+	}
+
+	if len(vars) != len(weights) {
+		return nil, MismatchedLengthError{
+			Length1: len(vars),
+			Name1:   "vars",
+			Length2: len(weights),
+			Name2:   "weights",
+		}
+	}
+
+	if len(vars) == 0 {
+		return nil, fmt.Errorf("an SOS constraint requires at least one variable")
+	}
+
+	ind := make([]int32, len(vars))
+	for i, v := range vars {
+		if v == nil || v.Index < 0 {
+			return nil, errors.New("Invalid vars")
+		}
+		ind[i] = v.Index
+	}
+
+	if model.dryRun != nil {
+		if err := model.dryRun.addSOS(vars, sosType); err != nil {
+			return nil, err
+		}
+		model.SOSs = append(model.SOSs, SOS{model, int32(len(model.SOSs))})
+		return &model.SOSs[len(model.SOSs)-1], nil
+	}
+
+	// Algorithm
+	types := []int32{int32(sosType)}
+	beg := []int32{0}
+	done := traceCall("GRBaddsos")
+	errCode := C.GRBaddsos(
+		model.AsGRBModel, 1, C.int(len(ind)),
+		(*C.int)(&types[0]), (*C.int)(&beg[0]),
+		(*C.int)(&ind[0]), (*C.double)(&weights[0]),
+	)
+	done(errCode)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	model.changes.newSOS++
+	model.emitItems(EventSOSAdded, len(model.SOSs), 1)
+
+	if err := model.Update(); err != nil {
+		return nil, err
+	}
+
+	model.SOSs = append(model.SOSs, SOS{model, int32(len(model.SOSs))})
+	return &model.SOSs[len(model.SOSs)-1], nil
+}
+
+/*
+SOSData
+Description:
+
+	The structure of an SOS constraint: its type and its variables, ordered
+	as in the model, together with their weights.
+*/
+type SOSData struct {
+	Type    SOSType
+	Vars    []*Var
+	Weights []float64
+}
+
+/*
+GetSOS
+Description:
+
+	Returns the structure of the SOS constraint with index i (e.g., of a
+	model read from a file).
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_getsos.html
+*/
+func (model *Model) GetSOS(i int32) (*SOSData, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := model.checkFor("GetSOS"); err != nil {
+		return nil, err
+	}
+
+	if model.dryRun != nil {
+		return nil, fmt.Errorf("GetSOS: %w", ErrDryRun)
+	}
+
+	numSOS, err := model.NumSOS()
+	if err != nil {
+		return nil, err
+	}
+	if i < 0 || i >= numSOS {
+		return nil, fmt.Errorf("the SOS index %v is out of range; the model has %v SOS constraints", i, numSOS)
+	}
+
+	// Algorithm
+	var nummembers int32
+	done := traceCall("GRBgetsos", i)
+	errCode := C.GRBgetsos(model.AsGRBModel, (*C.int)(&nummembers), nil, nil, nil, nil, C.int(i), 1)
+	done(errCode)
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	var sosType, beg int32
+	ind := make([]int32, nummembers)
+	weights := make([]float64, nummembers)
+	if nummembers > 0 {
+		done := traceCall("GRBgetsos", i, nummembers)
+		errCode := C.GRBgetsos(
+			model.AsGRBModel, (*C.int)(&nummembers),
+			(*C.int)(&sosType), (*C.int)(&beg),
+			(*C.int)(&ind[0]), (*C.double)(&weights[0]),
+			C.int(i), 1,
+		)
+		done(errCode)
+		if errCode != 0 {
+			return nil, model.MakeError(errCode)
+		}
+	}
+
+	return &SOSData{Type: SOSType(sosType), Vars: model.varsAt(ind), Weights: weights}, nil
+}
+
+/*
+Data
+Description:
+
+	Returns the structure of the SOS constraint.
+*/
+func (sos *SOS) Data() (*SOSData, error) {
+	return sos.Model.GetSOS(sos.Index)
+}
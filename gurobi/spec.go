@@ -0,0 +1,510 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/*
+spec.go
+Description:
+	A pure-Go description of a model (variables, linear constraints and a
+	linear or quadratic objective) which is independent of any GRBmodel.
+	A ModelSpec can be built, transformed and serialized without Gurobi and
+	then compiled into a GRBmodel with a single call to GRBloadmodel.
+*/
+
+/*
+VarSpec
+Description:
+
+	A variable of a ModelSpec. Obj is its linear objective coefficient.
+*/
+type VarSpec struct {
+	Name string  `json:"name,omitempty"`
+	Type VarType `json:"type"`
+	LB   float64 `json:"lb"`
+	UB   float64 `json:"ub"`
+	Obj  float64 `json:"obj,omitempty"`
+}
+
+/*
+ConstrSpec
+Description:
+
+	A linear constraint sum_i Val[i] * x[Ind[i]] (Sense) RHS of a ModelSpec.
+*/
+type ConstrSpec struct {
+	Name  string    `json:"name,omitempty"`
+	Ind   []int32   `json:"ind"`
+	Val   []float64 `json:"val"`
+	Sense Sense     `json:"sense"`
+	RHS   float64   `json:"rhs"`
+}
+
+/*
+QTermSpec
+Description:
+
+	A quadratic objective term Val * x[Row] * x[Col] of a ModelSpec.
+*/
+type QTermSpec struct {
+	Row int32   `json:"row"`
+	Col int32   `json:"col"`
+	Val float64 `json:"val"`
+}
+
+/*
+ModelSpec
+Description:
+
+	An in-memory model which is decoupled from the C API.
+*/
+type ModelSpec struct {
+	Name    string       `json:"name"`
+	Sense   ObjSense     `json:"sense"`
+	ObjCon  float64      `json:"obj_con,omitempty"`
+	Vars    []VarSpec    `json:"vars"`
+	Constrs []ConstrSpec `json:"constrs"`
+	QObj    []QTermSpec  `json:"q_obj,omitempty"`
+}
+
+/*
+NewModelSpec
+Description:
+
+	Creates an empty (minimization) ModelSpec with the given name.
+*/
+func NewModelSpec(name string) *ModelSpec {
+	return &ModelSpec{Name: name, Sense: Minimize}
+}
+
+/*
+AddVar
+Description:
+
+	Appends a variable to the spec and returns its index.
+*/
+func (spec *ModelSpec) AddVar(vtype VarType, obj float64, lb float64, ub float64, name string) int32 {
+	spec.Vars = append(spec.Vars, VarSpec{Name: name, Type: vtype, LB: lb, UB: ub, Obj: obj})
+	return int32(len(spec.Vars) - 1)
+}
+
+/*
+AddConstr
+Description:
+
+	Appends the linear constraint sum_i val[i] * x[ind[i]] (sense) rhs to the spec
+	and returns its index. The slices are copied.
+*/
+func (spec *ModelSpec) AddConstr(ind []int32, val []float64, sense Sense, rhs float64, name string) int32 {
+	spec.Constrs = append(spec.Constrs, ConstrSpec{
+		Name:  name,
+		Ind:   append([]int32{}, ind...),
+		Val:   append([]float64{}, val...),
+		Sense: sense,
+		RHS:   rhs,
+	})
+	return int32(len(spec.Constrs) - 1)
+}
+
+/*
+AddQTerm
+Description:
+
+	Adds the term val * x[row] * x[col] to the objective of the spec.
+*/
+func (spec *ModelSpec) AddQTerm(row int32, col int32, val float64) {
+	spec.QObj = append(spec.QObj, QTermSpec{Row: row, Col: col, Val: val})
+}
+
+/*
+NumNZs
+Description:
+
+	Returns the number of nonzero coefficients in the linear constraints of the spec.
+*/
+func (spec *ModelSpec) NumNZs() int {
+	numnz := 0
+	for _, constr := range spec.Constrs {
+		numnz += len(constr.Ind)
+	}
+	return numnz
+}
+
+/*
+Check
+Description:
+
+	Validates the spec: variable types and senses must be valid, bounds must be
+	consistent, every index must refer to a variable of the spec and no
+	coefficient may be NaN or infinite.
+*/
+func (spec *ModelSpec) Check() error {
+	if spec == nil {
+		return errors.New("the model spec is nil")
+	}
+
+	if err := spec.Sense.Check(); err != nil {
+		return err
+	}
+
+	numVars := int32(len(spec.Vars))
+	for i, v := range spec.Vars {
+		if err := v.Type.Check(); err != nil {
+			return fmt.Errorf("variable %v: %w", i, err)
+		}
+		if math.IsNaN(v.LB) || math.IsNaN(v.UB) || v.LB > v.UB {
+			return fmt.Errorf("variable %v: the bounds [%v, %v] are not valid", i, v.LB, v.UB)
+		}
+		if err := checkFinite(fmt.Sprintf("the objective coefficient of variable %v", i), v.Obj); err != nil {
+			return err
+		}
+	}
+
+	for i, constr := range spec.Constrs {
+		if len(constr.Ind) != len(constr.Val) {
+			return fmt.Errorf("constraint %v: %w", i, MismatchedLengthError{
+				Length1: len(constr.Ind),
+				Name1:   "Ind",
+				Length2: len(constr.Val),
+				Name2:   "Val",
+			})
+		}
+		if err := constr.Sense.Check(); err != nil {
+			return fmt.Errorf("constraint %v: %w", i, err)
+		}
+		for j, ind := range constr.Ind {
+			if ind < 0 || ind >= numVars {
+				return fmt.Errorf("constraint %v: the index %v at position %v is out of range", i, ind, j)
+			}
+			if err := checkFinite(fmt.Sprintf("constraint %v: the coefficient at position %v", i, j), constr.Val[j]); err != nil {
+				return err
+			}
+		}
+		if math.IsNaN(constr.RHS) {
+			return fmt.Errorf("constraint %v: the right-hand side must not be NaN", i)
+		}
+	}
+
+	for i, term := range spec.QObj {
+		if term.Row < 0 || term.Row >= numVars || term.Col < 0 || term.Col >= numVars {
+			return fmt.Errorf("quadratic term %v: the indices (%v, %v) are out of range", i, term.Row, term.Col)
+		}
+		if err := checkFinite(fmt.Sprintf("quadratic term %v", i), term.Val); err != nil {
+			return err
+		}
+	}
+
+	return checkFinite("the objective constant", spec.ObjCon)
+}
+
+/*
+Clone
+Description:
+
+	Returns a deep copy of the spec.
+*/
+func (spec *ModelSpec) Clone() *ModelSpec {
+	clone := *spec
+	clone.Vars = append([]VarSpec{}, spec.Vars...)
+	clone.QObj = append([]QTermSpec{}, spec.QObj...)
+	clone.Constrs = make([]ConstrSpec, len(spec.Constrs))
+	for i, constr := range spec.Constrs {
+		clone.Constrs[i] = constr
+		clone.Constrs[i].Ind = append([]int32{}, constr.Ind...)
+		clone.Constrs[i].Val = append([]float64{}, constr.Val...)
+	}
+	return &clone
+}
+
+/*
+Relax
+Description:
+
+	Returns a copy of the spec in which every variable is continuous.
+	Binary variables keep their [0, 1] domain through their bounds.
+*/
+func (spec *ModelSpec) Relax() *ModelSpec {
+	relaxed := spec.Clone()
+	for i := range relaxed.Vars {
+		if relaxed.Vars[i].Type == Binary {
+			relaxed.Vars[i].LB = math.Max(relaxed.Vars[i].LB, 0.0)
+			relaxed.Vars[i].UB = math.Min(relaxed.Vars[i].UB, 1.0)
+		}
+		relaxed.Vars[i].Type = Continuous
+	}
+	return relaxed
+}
+
+/*
+WriteJSON
+Description:
+
+	Writes the spec to w as JSON.
+*/
+func (spec *ModelSpec) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(spec)
+}
+
+/*
+ReadModelSpecJSON
+Description:
+
+	Reads a spec that was written with WriteJSON from r and validates it.
+*/
+func ReadModelSpecJSON(r io.Reader) (*ModelSpec, error) {
+	spec := &ModelSpec{}
+	if err := json.NewDecoder(r).Decode(spec); err != nil {
+		return nil, err
+	}
+
+	if err := spec.Check(); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+/*
+ReadModelSpecFile
+Description:
+
+	Reads a spec from a .mps, .lp or .json file, which may be compressed
+	with gzip (.gz) or bzip2 (.bz2), without a Gurobi environment.
+	The spec is named after the file if the file does not name it.
+*/
+func ReadModelSpecFile(path string) (*ModelSpec, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	base := path
+	switch {
+	case strings.HasSuffix(path, ".gz"):
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r, base = gz, strings.TrimSuffix(path, ".gz")
+	case strings.HasSuffix(path, ".bz2"):
+		r, base = bzip2.NewReader(file), strings.TrimSuffix(path, ".bz2")
+	}
+
+	var spec *ModelSpec
+	switch ext := filepath.Ext(base); ext {
+	case ".mps":
+		spec, err = ReadModelSpecMPS(r)
+	case ".lp":
+		spec, err = ReadModelSpecLP(r)
+	case ".json":
+		spec, err = ReadModelSpecJSON(r)
+	default:
+		return nil, fmt.Errorf("cannot read a model spec from a %q file: %v", ext, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", path, err)
+	}
+
+	if spec.Name == "" {
+		spec.Name = strings.TrimSuffix(filepath.Base(base), filepath.Ext(base))
+	}
+	return spec, nil
+}
+
+/*
+Compile
+Description:
+
+	Creates a new model in env which contains everything in the spec.
+	All variables and linear constraints are loaded with one call to GRBloadmodel.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/c_loadmodel.html
+*/
+func (spec *ModelSpec) Compile(env *Env) (*Model, error) {
+	// Input Processing
+	if err := env.Check(); err != nil {
+		return nil, err
+	}
+
+	if err := spec.Check(); err != nil {
+		return nil, err
+	}
+
+	// Transpose the constraints into the column-wise format of GRBloadmodel.
+	numVars := len(spec.Vars)
+	numConstrs := len(spec.Constrs)
+
+	vlen := make([]int32, numVars)
+	for _, constr := range spec.Constrs {
+		for _, ind := range constr.Ind {
+			vlen[ind]++
+		}
+	}
+
+	vbeg := make([]int32, numVars)
+	for j := 1; j < numVars; j++ {
+		vbeg[j] = vbeg[j-1] + vlen[j-1]
+	}
+
+	numnz := spec.NumNZs()
+	vind := make([]int32, numnz)
+	vval := make([]float64, numnz)
+	next := append([]int32{}, vbeg...)
+	for i, constr := range spec.Constrs {
+		for k, ind := range constr.Ind {
+			vind[next[ind]] = int32(i)
+			vval[next[ind]] = constr.Val[k]
+			next[ind]++
+		}
+	}
+
+	obj := make([]float64, numVars)
+	lb := make([]float64, numVars)
+	ub := make([]float64, numVars)
+	vtype := make([]int8, numVars)
+	varnames := make([]string, numVars)
+	for j, v := range spec.Vars {
+		obj[j], lb[j], ub[j], vtype[j], varnames[j] = v.Obj, v.LB, v.UB, int8(v.Type), v.Name
+	}
+
+	senses := make([]int8, numConstrs)
+	rhs := make([]float64, numConstrs)
+	constrnames := make([]string, numConstrs)
+	for i, constr := range spec.Constrs {
+		senses[i], rhs[i], constrnames[i] = int8(constr.Sense), constr.RHS, constr.Name
+	}
+
+	// Algorithm
+	args := &cgoArgs{}
+	defer args.free()
+
+	var grbModel *C.GRBmodel
+	done := traceCall("GRBloadmodel", spec.Name, numVars, numConstrs, numnz)
+	errCode := C.GRBloadmodel(
+		env.env, &grbModel, args.str(spec.Name),
+		C.int(numVars), C.int(numConstrs),
+		C.int(spec.Sense), C.double(spec.ObjCon), args.doubles(obj),
+		args.chars(senses), args.doubles(rhs),
+		args.ints(vbeg), args.ints(vlen), args.ints(vind), args.doubles(vval),
+		args.doubles(lb), args.doubles(ub), args.chars(vtype), args.strings(varnames), args.strings(constrnames),
+	)
+	done(errCode)
+	if errCode != 0 {
+		return nil, env.MakeError(errCode)
+	}
+
+	newenv := C.GRBgetenv(grbModel)
+	if newenv == nil {
+		C.GRBfreemodel(grbModel)
+		return nil, errors.New("Failed retrieve the environment")
+	}
+
+	model := &Model{AsGRBModel: grbModel, Env: Env{env: newenv}}
+	registry.addModel(env.env, model)
+	vars := model.appendVars(numVars, nil)
+	model.appendConstrs(numConstrs, nil)
+
+	if len(spec.QObj) > 0 {
+		if err := spec.addQObj(model, vars); err != nil {
+			model.Free()
+			return nil, err
+		}
+	}
+
+	if err := model.Update(); err != nil {
+		model.Free()
+		return nil, err
+	}
+
+	return model, nil
+}
+
+/*
+Build
+Description:
+
+	Adds everything in the spec to an existing (e.g., dry-run) model, through
+	the regular AddVars/AddConstrs calls, and sets its objective and sense.
+	Indices in the spec are relative to the variables added by this call.
+*/
+func (spec *ModelSpec) Build(model *Model) error {
+	if err := spec.Check(); err != nil {
+		return err
+	}
+
+	vtypes := make([]VarType, len(spec.Vars))
+	objs := make([]float64, len(spec.Vars))
+	lbs := make([]float64, len(spec.Vars))
+	ubs := make([]float64, len(spec.Vars))
+	names := make([]string, len(spec.Vars))
+	for j, v := range spec.Vars {
+		vtypes[j], objs[j], lbs[j], ubs[j], names[j] = v.Type, v.Obj, v.LB, v.UB, v.Name
+	}
+
+	vars, err := model.AddVars(vtypes, objs, lbs, ubs, names, [][]*Constr{}, [][]float64{})
+	if err != nil {
+		return err
+	}
+
+	cvars := make([][]*Var, len(spec.Constrs))
+	cvals := make([][]float64, len(spec.Constrs))
+	senses := make([]Sense, len(spec.Constrs))
+	rhs := make([]float64, len(spec.Constrs))
+	cnames := make([]string, len(spec.Constrs))
+	for i, constr := range spec.Constrs {
+		cvars[i] = make([]*Var, len(constr.Ind))
+		for k, ind := range constr.Ind {
+			cvars[i][k] = vars[ind]
+		}
+		cvals[i], senses[i], rhs[i], cnames[i] = constr.Val, constr.Sense, constr.RHS, constr.Name
+	}
+
+	if len(spec.Constrs) > 0 {
+		if _, err := model.AddConstrs(cvars, cvals, senses, rhs, cnames); err != nil {
+			return err
+		}
+	}
+
+	if len(spec.QObj) > 0 {
+		if err := spec.addQObj(model, vars); err != nil {
+			return err
+		}
+	}
+
+	if err := model.SetDoubleAttr(C.GRB_DBL_ATTR_OBJCON, spec.ObjCon); err != nil {
+		return err
+	}
+
+	return model.SetModelSense(spec.Sense)
+}
+
+// addQObj adds the quadratic objective terms of the spec to model, where vars
+// are the model's variables for the variables of the spec.
+func (spec *ModelSpec) addQObj(model *Model, vars []*Var) error {
+	qrow := make([]*Var, len(spec.QObj))
+	qcol := make([]*Var, len(spec.QObj))
+	qval := make([]float64, len(spec.QObj))
+	for i, term := range spec.QObj {
+		qrow[i], qcol[i], qval[i] = vars[term.Row], vars[term.Col], term.Val
+	}
+	return model.addQPTerms(qrow, qcol, qval)
+}
@@ -0,0 +1,217 @@
+package gurobi
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+status.go
+Description:
+	Defines the Status type, which represents the optimization status codes
+	that Gurobi reports through the Status attribute, along with explanations
+	of each status that can be shown to users.
+Notes:
+	The list of status codes is on Gurobi's website at:
+	https://www.gurobi.com/documentation/current/refman/optimization_status_codes.html
+*/
+
+// Status is the optimization status of a model (the "Status" attribute).
+type Status int32
+
+const (
+	StatusLoaded         Status = LOADED
+	StatusOptimal        Status = OPTIMAL
+	StatusInfeasible     Status = INFEASIBLE
+	StatusInfOrUnbd      Status = INF_OR_UNBD
+	StatusUnbounded      Status = UNBOUNDED
+	StatusCutoff         Status = CUTOFF
+	StatusIterationLimit Status = ITERATION_LIMIT
+	StatusNodeLimit      Status = NODE_LIMIT
+	StatusTimeLimit      Status = TIME_LIMIT
+	StatusSolutionLimit  Status = SOLUTION_LIMIT
+	StatusInterrupted    Status = INTERRUPTED
+	StatusNumeric        Status = NUMERIC
+	StatusSuboptimal     Status = SUBOPTIMAL
+	StatusInProgress     Status = INPROGRESS
+	StatusUserObjLimit   Status = USER_OBJ_LIMIT
+	StatusWorkLimit      Status = WORK_LIMIT
+	StatusMemLimit       Status = MEM_LIMIT
+)
+
+/*
+StatusExplanation
+Description:
+
+	A human-readable explanation of a Status, including common next steps
+	which a user can take to resolve (or improve upon) the status.
+*/
+type StatusExplanation struct {
+	Status      Status
+	Description string
+	NextSteps   []string
+}
+
+/*
+Status
+Description:
+
+	Returns the current optimization status of the model.
+*/
+func (model *Model) Status() (Status, error) {
+	status, err := model.GetIntAttr(INT_ATTR_STATUS)
+	if err != nil {
+		return Status(0), err
+	}
+
+	return Status(status), nil
+}
+
+/*
+String
+Description:
+
+	Returns the name of the status as it appears in Gurobi's documentation.
+*/
+func (s Status) String() string {
+	switch s {
+	case StatusLoaded:
+		return "LOADED"
+	case StatusOptimal:
+		return "OPTIMAL"
+	case StatusInfeasible:
+		return "INFEASIBLE"
+	case StatusInfOrUnbd:
+		return "INF_OR_UNBD"
+	case StatusUnbounded:
+		return "UNBOUNDED"
+	case StatusCutoff:
+		return "CUTOFF"
+	case StatusIterationLimit:
+		return "ITERATION_LIMIT"
+	case StatusNodeLimit:
+		return "NODE_LIMIT"
+	case StatusTimeLimit:
+		return "TIME_LIMIT"
+	case StatusSolutionLimit:
+		return "SOLUTION_LIMIT"
+	case StatusInterrupted:
+		return "INTERRUPTED"
+	case StatusNumeric:
+		return "NUMERIC"
+	case StatusSuboptimal:
+		return "SUBOPTIMAL"
+	case StatusInProgress:
+		return "INPROGRESS"
+	case StatusUserObjLimit:
+		return "USER_OBJ_LIMIT"
+	case StatusWorkLimit:
+		return "WORK_LIMIT"
+	case StatusMemLimit:
+		return "MEM_LIMIT"
+	default:
+		return fmt.Sprintf("Status(%v)", int32(s))
+	}
+}
+
+/*
+Explain
+Description:
+
+	Returns a human-readable description of the status and a list of common
+	next steps, so that services can return actionable messages to their users.
+*/
+func (s Status) Explain() StatusExplanation {
+	explanation := StatusExplanation{Status: s}
+
+	switch s {
+	case StatusLoaded:
+		explanation.Description = "The model is loaded, but no solution information is available."
+		explanation.NextSteps = []string{"Call Optimize() on the model."}
+	case StatusOptimal:
+		explanation.Description = "The model was solved to optimality (subject to tolerances) and an optimal solution is available."
+	case StatusInfeasible:
+		explanation.Description = "The model was proven to be infeasible."
+		explanation.NextSteps = []string{
+			"Compute an IIS to find a minimal set of conflicting constraints and bounds.",
+			"Use a feasibility relaxation to find the smallest violation of the constraints.",
+		}
+	case StatusInfOrUnbd:
+		explanation.Description = "The model was proven to be either infeasible or unbounded."
+		explanation.NextSteps = []string{
+			"Set the DualReductions parameter to 0 and re-solve to obtain a definitive status.",
+		}
+	case StatusUnbounded:
+		explanation.Description = "The model was proven to be unbounded; the objective can be improved without limit."
+		explanation.NextSteps = []string{
+			"Check for missing constraints or bounds on the variables in the objective.",
+			"Unboundedness does not imply feasibility; set an objective of 0 and re-solve to check feasibility.",
+		}
+	case StatusCutoff:
+		explanation.Description = "The optimal objective was proven to be worse than the value of the Cutoff parameter."
+		explanation.NextSteps = []string{"Relax (or remove) the Cutoff parameter and re-solve."}
+	case StatusIterationLimit:
+		explanation.Description = "Optimization terminated because the number of simplex or barrier iterations exceeded the limit."
+		explanation.NextSteps = []string{"Raise the IterationLimit (or BarIterLimit) parameter and re-solve."}
+	case StatusNodeLimit:
+		explanation.Description = "Optimization terminated because the number of branch-and-cut nodes exceeded the limit."
+		explanation.NextSteps = []string{"Raise the NodeLimit parameter and re-solve."}
+	case StatusTimeLimit:
+		explanation.Description = "Optimization terminated because the time expended exceeded the TimeLimit parameter."
+		explanation.NextSteps = []string{
+			"Raise the TimeLimit parameter and re-solve.",
+			"Inspect the best solution found so far (if any) and its MIPGap.",
+		}
+	case StatusSolutionLimit:
+		explanation.Description = "Optimization terminated because the number of solutions found reached the SolutionLimit parameter."
+		explanation.NextSteps = []string{"Raise the SolutionLimit parameter and re-solve."}
+	case StatusInterrupted:
+		explanation.Description = "Optimization was terminated by the user."
+	case StatusNumeric:
+		explanation.Description = "Optimization was terminated due to unrecoverable numerical difficulties."
+		explanation.NextSteps = []string{
+			"Increase the NumericFocus parameter and re-solve.",
+			"Rescale the model's coefficients so that they span fewer orders of magnitude.",
+			"Tighten big-M values and variable bounds.",
+		}
+	case StatusSuboptimal:
+		explanation.Description = "Unable to satisfy optimality tolerances; a sub-optimal solution is available."
+		explanation.NextSteps = []string{
+			"Increase the NumericFocus parameter and re-solve.",
+			"Try a different algorithm with the Method parameter.",
+		}
+	case StatusInProgress:
+		explanation.Description = "An asynchronous optimization call was made, but the optimization has not yet completed."
+		explanation.NextSteps = []string{"Wait for the optimization to finish before querying results."}
+	case StatusUserObjLimit:
+		explanation.Description = "The user-specified objective limit (BestObjStop or BestBdStop) has been reached."
+	case StatusWorkLimit:
+		explanation.Description = "Optimization terminated because the work expended exceeded the WorkLimit parameter."
+		explanation.NextSteps = []string{"Raise the WorkLimit parameter and re-solve."}
+	case StatusMemLimit:
+		explanation.Description = "Optimization terminated because the memory used exceeded the MemLimit parameter."
+		explanation.NextSteps = []string{
+			"Raise the MemLimit parameter and re-solve.",
+			"Reduce the number of threads or set NodefileStart to write nodes to disk.",
+		}
+	default:
+		explanation.Description = fmt.Sprintf("Unrecognized optimization status code %v.", int32(s))
+	}
+
+	return explanation
+}
+
+/*
+String
+Description:
+
+	Formats the explanation as a single message containing the status, its description
+	and any suggested next steps.
+*/
+func (se StatusExplanation) String() string {
+	message := fmt.Sprintf("%v: %v", se.Status, se.Description)
+	if len(se.NextSteps) > 0 {
+		message = fmt.Sprintf("%v Suggested next steps: %v", message, strings.Join(se.NextSteps, " "))
+	}
+	return message
+}
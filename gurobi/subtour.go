@@ -0,0 +1,182 @@
+package gurobi
+
+import (
+	"fmt"
+	"sort"
+)
+
+/*
+subtour.go
+Description:
+	A ready-made lazy-constraint callback for Dantzig-Fulkerson-Johnson (DFJ)
+	subtour elimination in routing models (TSP, VRP, ...).
+*/
+
+// Arc identifies the arc (or edge) from node From to node To.
+type Arc struct {
+	From int
+	To   int
+}
+
+/*
+SubtourEliminator
+Description:
+
+	Separates DFJ subtour elimination constraints for the binary arc variables
+	Arcs. Whenever an integer solution is found whose selected arcs form more
+	than one cycle, the constraint
+
+		sum_{i, j in S} x_ij <= |S| - 1
+
+	is added lazily for the node set S of every cycle. Arcs may be directed
+	(one variable per (i,j) and (j,i)) or undirected (one variable per edge).
+	- Tol: Arcs with values above 1 - Tol are considered selected (defaults to 1e-6).
+	- NumCuts: The number of lazy constraints added so far.
+*/
+type SubtourEliminator struct {
+	Arcs    map[Arc]*Var
+	Tol     float64
+	NumCuts int
+}
+
+/*
+NewSubtourEliminator
+Description:
+
+	Creates a SubtourEliminator for the given arc variables.
+*/
+func NewSubtourEliminator(arcs map[Arc]*Var) *SubtourEliminator {
+	return &SubtourEliminator{Arcs: arcs, Tol: 1e-6}
+}
+
+/*
+Install
+Description:
+
+	Sets the LazyConstraints parameter of the model and registers the
+	eliminator's callback, replacing any previous callback.
+*/
+func (se *SubtourEliminator) Install(model *Model) error {
+	if err := model.SetLazyConstraints(true); err != nil {
+		return err
+	}
+	return model.SetCallback(se.Callback())
+}
+
+/*
+Callback
+Description:
+
+	Returns the CallbackFunc which adds the subtour elimination constraints.
+	It can be called from a user's own callback to combine it with other logic.
+*/
+func (se *SubtourEliminator) Callback() CallbackFunc {
+	return func(cb *CallbackContext) error {
+		if cb.Where != WhereMIPSol {
+			return nil
+		}
+
+		sol, err := cb.MIPSolution()
+		if err != nil {
+			return err
+		}
+
+		tours, err := se.Subtours(sol)
+		if err != nil {
+			return err
+		}
+
+		if len(tours) <= 1 {
+			return nil
+		}
+
+		for _, tour := range tours {
+			vars, vals := se.cutFor(tour)
+			if err := cb.AddLazy(vars, vals, Le, float64(len(tour)-1)); err != nil {
+				return err
+			}
+			se.NumCuts++
+		}
+		return nil
+	}
+}
+
+/*
+Subtours
+Description:
+
+	Returns the connected components (as sorted node lists) formed by the
+	arcs which are selected in sol, where sol is indexed by variable index.
+	Every node which appears in Arcs belongs to exactly one component. A
+	solution without subtours has a single component.
+*/
+func (se *SubtourEliminator) Subtours(sol []float64) ([][]int, error) {
+	// Union-find over the nodes
+	parent := map[int]int{}
+	var find func(n int) int
+	find = func(n int) int {
+		if parent[n] != n {
+			parent[n] = find(parent[n])
+		}
+		return parent[n]
+	}
+
+	for arc, v := range se.Arcs {
+		if v == nil || v.Index < 0 || int(v.Index) >= len(sol) {
+			return nil, fmt.Errorf("the variable of arc %v is invalid", arc)
+		}
+		parent[arc.From] = arc.From
+		parent[arc.To] = arc.To
+	}
+
+	for arc, v := range se.Arcs {
+		if sol[v.Index] > 1-se.Tol {
+			parent[find(arc.From)] = find(arc.To)
+		}
+	}
+
+	// Collect the components
+	byRoot := map[int][]int{}
+	for n := range parent {
+		root := find(n)
+		byRoot[root] = append(byRoot[root], n)
+	}
+
+	tours := make([][]int, 0, len(byRoot))
+	for _, nodes := range byRoot {
+		sort.Ints(nodes)
+		tours = append(tours, nodes)
+	}
+	sort.Slice(tours, func(i, j int) bool { return tours[i][0] < tours[j][0] })
+
+	return tours, nil
+}
+
+// cutFor returns the variables (with unit coefficients) of the arcs inside the node set tour.
+func (se *SubtourEliminator) cutFor(tour []int) ([]*Var, []float64) {
+	inTour := map[int]bool{}
+	for _, n := range tour {
+		inTour[n] = true
+	}
+
+	arcs := []Arc{}
+	for arc := range se.Arcs {
+		if arc.From != arc.To && inTour[arc.From] && inTour[arc.To] {
+			arcs = append(arcs, arc)
+		}
+	}
+	sort.Slice(arcs, func(i, j int) bool {
+		if arcs[i].From != arcs[j].From {
+			return arcs[i].From < arcs[j].From
+		}
+		return arcs[i].To < arcs[j].To
+	})
+
+	vars := make([]*Var, len(arcs))
+	vals := make([]float64, len(arcs))
+	for i, arc := range arcs {
+		vars[i] = se.Arcs[arc]
+		vals[i] = 1.0
+	}
+	return vars, vals
+}
@@ -0,0 +1,83 @@
+package gurobi
+
+/*
+summary.go
+Description:
+	Typed getters for the attributes which summarize the result of a solve
+	(objective value, bound, gap, runtime and work counts).
+Notes:
+	The definition of each of these attributes is listed on Gurobi's website at:
+	https://www.gurobi.com/documentation/current/refman/attributes.html
+*/
+
+/*
+ObjVal
+Description:
+
+	Returns the objective value of the current solution (the ObjVal attribute).
+*/
+func (model *Model) ObjVal() (float64, error) {
+	return model.GetDoubleAttr(DBL_ATTR_OBJVAL)
+}
+
+/*
+ObjBound
+Description:
+
+	Returns the best known bound on the optimal objective value (the ObjBound attribute).
+*/
+func (model *Model) ObjBound() (float64, error) {
+	return model.GetDoubleAttr(DBL_ATTR_OBJBOUND)
+}
+
+/*
+MIPGap
+Description:
+
+	Returns the relative MIP optimality gap of the current solution (the MIPGap attribute).
+	This is only available for models with integer variables.
+*/
+func (model *Model) MIPGap() (float64, error) {
+	return model.GetDoubleAttr(DBL_ATTR_MIPGAP)
+}
+
+/*
+Runtime
+Description:
+
+	Returns the wall-clock time (in seconds) of the most recent optimization (the Runtime attribute).
+*/
+func (model *Model) Runtime() (float64, error) {
+	return model.GetDoubleAttr(DBL_ATTR_RUNTIME)
+}
+
+/*
+NodeCount
+Description:
+
+	Returns the number of branch-and-cut nodes explored in the most recent optimization.
+	Gurobi stores this as a double because the count can exceed the range of an int.
+*/
+func (model *Model) NodeCount() (float64, error) {
+	return model.GetDoubleAttr(DBL_ATTR_NODECOUNT)
+}
+
+/*
+IterCount
+Description:
+
+	Returns the number of simplex iterations performed in the most recent optimization.
+*/
+func (model *Model) IterCount() (float64, error) {
+	return model.GetDoubleAttr(DBL_ATTR_ITERCOUNT)
+}
+
+/*
+BarIterCount
+Description:
+
+	Returns the number of barrier iterations performed in the most recent optimization.
+*/
+func (model *Model) BarIterCount() (int32, error) {
+	return model.GetIntAttr(INT_ATTR_BARITERCOUNT)
+}
@@ -0,0 +1,296 @@
+package gurobi
+
+import (
+	"fmt"
+	"sort"
+)
+
+/*
+tag.go
+Description:
+	Tags group logically related variables and constraints of a model (e.g.,
+	"capacity" or "demand[week3]") so that they can be fetched, fixed,
+	relaxed or reported together.
+Notes:
+	Tags are kept on the Go side only; they are not written to model files.
+	Items removed with RemoveWhere are removed from their tags and the
+	remaining items keep their tags.
+*/
+
+// tagSet holds the indices of the items that carry one tag.
+type tagSet struct {
+	vars       map[int32]bool
+	constrs    map[int32]bool
+	genConstrs map[int32]bool
+}
+
+func newTagSet() *tagSet {
+	return &tagSet{
+		vars:       map[int32]bool{},
+		constrs:    map[int32]bool{},
+		genConstrs: map[int32]bool{},
+	}
+}
+
+/*
+TagGroup
+Description:
+
+	The items which carry a given tag, sorted by index.
+*/
+type TagGroup struct {
+	Model      *Model
+	Tag        string
+	Vars       []*Var
+	Constrs    []*Constr
+	GenConstrs []*GenConstr
+}
+
+/*
+Tag
+Description:
+
+	Adds tags to item, which may be a *Var, *Constr, *GenConstr or a slice
+	of one of these types.
+*/
+func (model *Model) Tag(item interface{}, tags ...string) error {
+	return model.updateTags(item, tags, true)
+}
+
+/*
+Untag
+Description:
+
+	Removes tags from item (see Tag for the supported item types).
+*/
+func (model *Model) Untag(item interface{}, tags ...string) error {
+	return model.updateTags(item, tags, false)
+}
+
+func (model *Model) updateTags(item interface{}, tags []string, add bool) error {
+	if model == nil {
+		return model.MakeUninitializedError()
+	}
+
+	var vars, constrs, genConstrs []int32
+	switch it := item.(type) {
+	case *Var:
+		vars = []int32{it.Index}
+	case []*Var:
+		for _, v := range it {
+			vars = append(vars, v.Index)
+		}
+	case *Constr:
+		constrs = []int32{it.Index}
+	case []*Constr:
+		for _, c := range it {
+			constrs = append(constrs, c.Index)
+		}
+	case *GenConstr:
+		genConstrs = []int32{it.Index}
+	case []*GenConstr:
+		for _, gc := range it {
+			genConstrs = append(genConstrs, gc.Index)
+		}
+	default:
+		return fmt.Errorf("cannot tag an item of type %T", item)
+	}
+
+	for _, ind := range append(append(append([]int32{}, vars...), constrs...), genConstrs...) {
+		if ind < 0 {
+			return fmt.Errorf("cannot tag an item with the invalid index %v", ind)
+		}
+	}
+
+	if model.tags == nil {
+		model.tags = map[string]*tagSet{}
+	}
+
+	for _, tag := range tags {
+		set := model.tags[tag]
+		if set == nil {
+			if !add {
+				continue
+			}
+			set = newTagSet()
+			model.tags[tag] = set
+		}
+
+		setMembers(set.vars, vars, add)
+		setMembers(set.constrs, constrs, add)
+		setMembers(set.genConstrs, genConstrs, add)
+	}
+
+	return nil
+}
+
+/*
+Tags
+Description:
+
+	Returns all tags of the model in sorted order.
+*/
+func (model *Model) Tags() []string {
+	tags := []string{}
+	for tag := range model.tags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+/*
+ByTag
+Description:
+
+	Returns the items which carry tag. The group is empty if no item carries it.
+*/
+func (model *Model) ByTag(tag string) *TagGroup {
+	group := &TagGroup{Model: model, Tag: tag}
+
+	set := model.tags[tag]
+	if set == nil {
+		return group
+	}
+
+	for _, i := range sortedIndices(set.vars) {
+		group.Vars = append(group.Vars, &Var{model, i})
+	}
+	for _, i := range sortedIndices(set.constrs) {
+		group.Constrs = append(group.Constrs, &Constr{model, i})
+	}
+	for _, i := range sortedIndices(set.genConstrs) {
+		group.GenConstrs = append(group.GenConstrs, &GenConstr{model, i})
+	}
+	return group
+}
+
+/*
+Size
+Description:
+
+	Returns the number of items in the group.
+*/
+func (group *TagGroup) Size() int {
+	return len(group.Vars) + len(group.Constrs) + len(group.GenConstrs)
+}
+
+/*
+Values
+Description:
+
+	Returns the values of the group's variables in the current solution.
+*/
+func (group *TagGroup) Values() ([]float64, error) {
+	return group.Model.GetDoubleAttrVars(DBL_ATTR_X, group.Vars)
+}
+
+/*
+Slacks
+Description:
+
+	Returns the slacks of the group's linear constraints in the current solution.
+*/
+func (group *TagGroup) Slacks() ([]float64, error) {
+	ind := make([]int32, len(group.Constrs))
+	for i, c := range group.Constrs {
+		ind[i] = c.Index
+	}
+	return group.Model.getDoubleAttrList("Slack", ind)
+}
+
+/*
+Fix
+Description:
+
+	Fixes each variable of the group to its value in the current solution
+	by setting both of its bounds to that value.
+*/
+func (group *TagGroup) Fix() error {
+	values, err := group.Values()
+	if err != nil {
+		return err
+	}
+
+	if err := group.Model.SetDoubleAttrVars(DBL_ATTR_LB, group.Vars, values); err != nil {
+		return err
+	}
+	return group.Model.SetDoubleAttrVars(DBL_ATTR_UB, group.Vars, values)
+}
+
+/*
+RelaxIntegrality
+Description:
+
+	Makes every variable of the group continuous.
+*/
+func (group *TagGroup) RelaxIntegrality() error {
+	ind := make([]int32, len(group.Vars))
+	vtypes := make([]int8, len(group.Vars))
+	for i, v := range group.Vars {
+		ind[i] = v.Index
+		vtypes[i] = int8(Continuous)
+	}
+	return group.Model.setCharAttrList("VType", ind, vtypes)
+}
+
+func setMembers(members map[int32]bool, ind []int32, add bool) {
+	for _, i := range ind {
+		if add {
+			members[i] = true
+		} else {
+			delete(members, i)
+		}
+	}
+}
+
+// reindexTags moves the tags to the new indices after items were removed.
+// The mappings are those of newIndices; items mapped to -1 lose their tags.
+func (model *Model) reindexTags(varMap []int32, constrMap []int32, genConstrMap []int32) {
+	for _, set := range model.tags {
+		set.vars = reindexTagMembers(set.vars, varMap)
+		set.constrs = reindexTagMembers(set.constrs, constrMap)
+		set.genConstrs = reindexTagMembers(set.genConstrs, genConstrMap)
+	}
+}
+
+func reindexTagMembers(members map[int32]bool, mapping []int32) map[int32]bool {
+	out := map[int32]bool{}
+	for i := range members {
+		if int(i) >= len(mapping) {
+			continue
+		}
+		if j := mapping[i]; j >= 0 {
+			out[j] = true
+		}
+	}
+	return out
+}
+
+// copyTags returns a deep copy of the tags of a model.
+func copyTags(tags map[string]*tagSet) map[string]*tagSet {
+	out := map[string]*tagSet{}
+	for tag, set := range tags {
+		copied := newTagSet()
+		for i := range set.vars {
+			copied.vars[i] = true
+		}
+		for i := range set.constrs {
+			copied.constrs[i] = true
+		}
+		for i := range set.genConstrs {
+			copied.genConstrs[i] = true
+		}
+		out[tag] = copied
+	}
+	return out
+}
+
+func sortedIndices(members map[int32]bool) []int32 {
+	ind := make([]int32, 0, len(members))
+	for i := range members {
+		ind = append(ind, i)
+	}
+	sort.Slice(ind, func(a, b int) bool { return ind[a] < ind[b] })
+	return ind
+}
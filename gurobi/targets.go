@@ -0,0 +1,104 @@
+package gurobi
+
+import (
+	"fmt"
+	"math"
+)
+
+/*
+targets.go
+Description:
+	Typed helpers for the parameters which end a solve (or discard solutions)
+	based on objective values: BestObjStop, BestBdStop and Cutoff.
+Notes:
+	All three parameters are expressed in terms of the model's objective, so
+	"better" means smaller when minimizing and larger when maximizing. Their
+	defaults also depend on the sense (e.g., the default Cutoff is INFINITY when
+	minimizing and -INFINITY when maximizing), which is what ClearObjTargets restores.
+*/
+
+/*
+SetBestObjStop
+Description:
+
+	Stops the solve as soon as an incumbent whose objective is at least as
+	good as value has been found (the BestObjStop parameter).
+*/
+func (model *Model) SetBestObjStop(value float64) error {
+	if math.IsNaN(value) {
+		return fmt.Errorf("the BestObjStop parameter must not be NaN")
+	}
+	return model.SetDBLParam("BestObjStop", value)
+}
+
+/*
+SetBestBdStop
+Description:
+
+	Stops the solve as soon as the best bound is at least as good as value,
+	i.e., once no solution better than value can exist (the BestBdStop parameter).
+*/
+func (model *Model) SetBestBdStop(value float64) error {
+	if math.IsNaN(value) {
+		return fmt.Errorf("the BestBdStop parameter must not be NaN")
+	}
+	return model.SetDBLParam("BestBdStop", value)
+}
+
+/*
+SetCutoff
+Description:
+
+	Discards every solution whose objective is worse than value (the Cutoff
+	parameter). If no solution is at least as good as value, the solve ends
+	with the status Cutoff.
+*/
+func (model *Model) SetCutoff(value float64) error {
+	if math.IsNaN(value) {
+		return fmt.Errorf("the Cutoff parameter must not be NaN")
+	}
+	return model.SetDBLParam("Cutoff", value)
+}
+
+/*
+StopWhenBetterThan
+Description:
+
+	Makes the solve stop at the first solution whose objective is at least as
+	good as value, and ignore solutions which are worse. This sets both
+	BestObjStop and Cutoff to value.
+*/
+func (model *Model) StopWhenBetterThan(value float64) error {
+	if err := model.SetBestObjStop(value); err != nil {
+		return err
+	}
+	return model.SetCutoff(value)
+}
+
+/*
+ClearObjTargets
+Description:
+
+	Restores BestObjStop, BestBdStop and Cutoff to their defaults for the
+	current sense of the model.
+*/
+func (model *Model) ClearObjTargets() error {
+	sense, err := model.GetModelSense()
+	if err != nil {
+		return err
+	}
+
+	// worst is the least attractive objective value for the sense.
+	worst := INFINITY
+	if sense == Maximize {
+		worst = -INFINITY
+	}
+
+	if err := model.SetBestObjStop(-worst); err != nil {
+		return err
+	}
+	if err := model.SetBestBdStop(worst); err != nil {
+		return err
+	}
+	return model.SetCutoff(worst)
+}
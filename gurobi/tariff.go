@@ -0,0 +1,367 @@
+package gurobi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+/*
+tariff.go
+Description:
+	Helpers for two cost structures which energy and logistics models use
+	all the time: a fixed charge (a cost fixed + rate * x which is only paid
+	when x > 0) and a multi-block tariff (a different rate, and possibly a
+	fee, for each block of consumption). The big-M constants of both are
+	derived from the bounds of x.
+*/
+
+/*
+FixedCharge
+Description:
+
+	The cost Fixed + Rate * x when x > 0, and 0 when x = 0.
+*/
+type FixedCharge struct {
+	Fixed float64
+	Rate  float64
+}
+
+/*
+FixedChargeVars
+Description:
+
+	The result of AddFixedCharge.
+	- Cost: The variable equal to the cost of X.
+	- On: The binary which is 1 when X may be positive.
+	- BigM: The upper bound of X which links it to On.
+*/
+type FixedChargeVars struct {
+	X    *Var
+	Cost *Var
+	On   *Var
+	BigM float64
+}
+
+/*
+Check
+Description:
+
+	Checks that the fixed charge is nonnegative and that both values are finite.
+*/
+func (fc FixedCharge) Check() error {
+	if err := checkFinite("the fixed charge", fc.Fixed); err != nil {
+		return err
+	}
+	if err := checkFinite("the rate", fc.Rate); err != nil {
+		return err
+	}
+	if fc.Fixed < 0 {
+		return fmt.Errorf("the fixed charge must be nonnegative; received %v", fc.Fixed)
+	}
+	return nil
+}
+
+/*
+Cost
+Description:
+
+	Evaluates the cost at x.
+*/
+func (fc FixedCharge) Cost(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return fc.Fixed + fc.Rate*x
+}
+
+/*
+AddFixedCharge
+Description:
+
+	Adds a cost variable with cost = Fixed * on + Rate * x and a binary on
+	with x <= UB(x) * on, so that the fixed charge is paid whenever x is
+	positive (as long as the cost is minimized). x must have a lower bound
+	of at least 0 and a finite upper bound, which is used as the big-M.
+*/
+func (model *Model) AddFixedCharge(x *Var, fc FixedCharge, name string) (*FixedChargeVars, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if x == nil || x.Index < 0 {
+		return nil, errors.New("Invalid x")
+	}
+
+	if err := fc.Check(); err != nil {
+		return nil, err
+	}
+
+	_, bigM, err := nonnegativeBounds(x, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Algorithm
+	on, err := model.AddVar(Binary, 0.0, 0.0, 1.0, name+"_on", []*Constr{}, []float64{})
+	if err != nil {
+		return nil, err
+	}
+	cost, err := model.AddVar(Continuous, 0.0, -INFINITY, INFINITY, name+"_cost", []*Constr{}, []float64{})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := model.AddConstr([]*Var{x, on}, []float64{1.0, -bigM}, Le, 0.0, name+"_link"); err != nil {
+		return nil, err
+	}
+	if _, err := model.AddConstr([]*Var{cost, x, on}, []float64{1.0, -fc.Rate, -fc.Fixed}, Eq, 0.0, name+"_def"); err != nil {
+		return nil, err
+	}
+
+	return &FixedChargeVars{X: x, Cost: cost, On: on, BigM: bigM}, nil
+}
+
+// nonnegativeBounds returns the bounds of x, which must be nonnegative. The
+// upper bound must also be finite unless infiniteUB is true.
+func nonnegativeBounds(x *Var, infiniteUB bool) (float64, float64, error) {
+	lb, err := x.GetDouble(DBL_ATTR_LB)
+	if err != nil {
+		return 0, 0, err
+	}
+	ub, err := x.GetDouble(DBL_ATTR_UB)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if lb < 0 {
+		return 0, 0, fmt.Errorf("the lower bound of x must be nonnegative; received %v", lb)
+	}
+	if ub >= INFINITY && !infiniteUB {
+		return 0, 0, errors.New("x needs a finite upper bound to derive the big-M")
+	}
+	return lb, ub, nil
+}
+
+/*
+TariffBlock
+Description:
+
+	A block of a Tariff.
+	- Width: The amount of consumption in the block. Only the last block
+	  may be unbounded (INFINITY).
+	- Rate: The cost per unit in the block.
+	- Fee: A cost which is paid once any consumption falls in the block.
+*/
+type TariffBlock struct {
+	Width float64
+	Rate  float64
+	Fee   float64
+}
+
+/*
+Tariff
+Description:
+
+	A multi-block tariff: the first Blocks[0].Width units cost Blocks[0].Rate
+	each, the next Blocks[1].Width units cost Blocks[1].Rate each, and so on.
+*/
+type Tariff struct {
+	Blocks []TariffBlock
+}
+
+/*
+TariffVars
+Description:
+
+	The result of AddTariff.
+	- Cost: The variable equal to the cost of X.
+	- Amounts: The consumption in each block; they sum up to X.
+	- Active: The binaries which are 1 when a block is used (nil for a
+	  convex tariff, which needs no binaries).
+*/
+type TariffVars struct {
+	X       *Var
+	Cost    *Var
+	Amounts []*Var
+	Active  []*Var
+}
+
+/*
+Check
+Description:
+
+	Checks that the tariff has at least one block, that the widths are
+	positive, that only the last block is unbounded and that the rates and
+	fees are finite (and the fees nonnegative).
+*/
+func (tariff Tariff) Check() error {
+	if len(tariff.Blocks) == 0 {
+		return errors.New("a tariff needs at least one block")
+	}
+
+	for k, block := range tariff.Blocks {
+		if math.IsNaN(block.Width) || block.Width <= 0 {
+			return fmt.Errorf("block %v: the width must be positive; received %v", k, block.Width)
+		}
+		if block.Width >= INFINITY && k < len(tariff.Blocks)-1 {
+			return fmt.Errorf("block %v: only the last block may be unbounded", k)
+		}
+		if err := checkFinite(fmt.Sprintf("block %v: the rate", k), block.Rate); err != nil {
+			return err
+		}
+		if err := checkFinite(fmt.Sprintf("block %v: the fee", k), block.Fee); err != nil {
+			return err
+		}
+		if block.Fee < 0 {
+			return fmt.Errorf("block %v: the fee must be nonnegative; received %v", k, block.Fee)
+		}
+	}
+
+	return nil
+}
+
+/*
+Convex
+Description:
+
+	Returns true if the tariff has no fees and nondecreasing rates, in which
+	case cheaper blocks are used first without any binaries.
+*/
+func (tariff Tariff) Convex() bool {
+	for k, block := range tariff.Blocks {
+		if block.Fee != 0 || (k > 0 && block.Rate < tariff.Blocks[k-1].Rate) {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+Cost
+Description:
+
+	Evaluates the tariff at x. Consumption beyond the last block is charged
+	at the last rate.
+*/
+func (tariff Tariff) Cost(x float64) float64 {
+	cost, start := 0.0, 0.0
+	for k, block := range tariff.Blocks {
+		if x <= start {
+			break
+		}
+		amount := x - start
+		if k < len(tariff.Blocks)-1 {
+			amount = math.Min(amount, block.Width)
+		}
+		cost += block.Fee + block.Rate*amount
+		start += block.Width
+	}
+	return cost
+}
+
+/*
+AddTariff
+Description:
+
+	Adds a cost variable equal to the tariff of x, with one amount variable
+	per block. A convex tariff is modeled as an LP. Otherwise, a binary per
+	block marks whether the block is used, and a block may only be used once
+	the previous block is full; the big-M of the last block is then derived
+	from the upper bound of x, which must be finite if the last block is
+	unbounded. In both cases, x must have a lower bound of at least 0 and
+	the cost must be minimized.
+*/
+func (model *Model) AddTariff(x *Var, tariff Tariff, name string) (*TariffVars, error) {
+	// Input Processing
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if x == nil || x.Index < 0 {
+		return nil, errors.New("Invalid x")
+	}
+
+	if err := tariff.Check(); err != nil {
+		return nil, err
+	}
+
+	convex := tariff.Convex()
+	_, ub, err := nonnegativeBounds(x, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// The width of each block within the bounds of x.
+	widths := make([]float64, len(tariff.Blocks))
+	start := 0.0
+	for k, block := range tariff.Blocks {
+		widths[k] = math.Max(math.Min(block.Width, ub-start), 0.0)
+		if widths[k] >= INFINITY {
+			if !convex {
+				return nil, errors.New("x needs a finite upper bound to derive the big-M of the last block")
+			}
+			widths[k] = INFINITY
+		}
+		start += block.Width
+	}
+
+	// Algorithm
+	vars := &TariffVars{X: x}
+	for k := range tariff.Blocks {
+		amount, err := model.AddVar(Continuous, 0.0, 0.0, widths[k], fmt.Sprintf("%v_block%v", name, k), []*Constr{}, []float64{})
+		if err != nil {
+			return nil, err
+		}
+		vars.Amounts = append(vars.Amounts, amount)
+	}
+
+	if vars.Cost, err = model.AddVar(Continuous, 0.0, -INFINITY, INFINITY, name+"_cost", []*Constr{}, []float64{}); err != nil {
+		return nil, err
+	}
+
+	// x = sum_k amount_k
+	sumVars, sumVals := []*Var{x}, []float64{1.0}
+	// cost = sum_k rate_k amount_k + fee_k active_k
+	costVars, costVals := []*Var{vars.Cost}, []float64{1.0}
+	for k, block := range tariff.Blocks {
+		sumVars, sumVals = append(sumVars, vars.Amounts[k]), append(sumVals, -1.0)
+		costVars, costVals = append(costVars, vars.Amounts[k]), append(costVals, -block.Rate)
+	}
+
+	if !convex {
+		for k, block := range tariff.Blocks {
+			active, err := model.AddVar(Binary, 0.0, 0.0, 1.0, fmt.Sprintf("%v_active%v", name, k), []*Constr{}, []float64{})
+			if err != nil {
+				return nil, err
+			}
+			vars.Active = append(vars.Active, active)
+			if block.Fee != 0 {
+				costVars, costVals = append(costVars, active), append(costVals, -block.Fee)
+			}
+
+			// amount_k <= width_k active_k
+			if _, err := model.AddConstr([]*Var{vars.Amounts[k], active}, []float64{1.0, -widths[k]}, Le, 0.0, fmt.Sprintf("%v_cap%v", name, k)); err != nil {
+				return nil, err
+			}
+			// amount_{k-1} >= width_{k-1} active_k
+			if k > 0 {
+				if _, err := model.AddConstr([]*Var{vars.Amounts[k-1], active}, []float64{1.0, -widths[k-1]}, Ge, 0.0, fmt.Sprintf("%v_fill%v", name, k)); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if _, err := model.AddConstr(sumVars, sumVals, Eq, 0.0, name+"_sum"); err != nil {
+		return nil, err
+	}
+	if _, err := model.AddConstr(costVars, costVals, Eq, 0.0, name+"_def"); err != nil {
+		return nil, err
+	}
+
+	return vars, nil
+}
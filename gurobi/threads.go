@@ -0,0 +1,105 @@
+package gurobi
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+/*
+threads.go
+Description:
+	Thread budgeting for solves. By default Gurobi uses every core of the
+	machine, which oversubscribes containers whose CPU quota is smaller than
+	the number of cores of the host (e.g., pods in Kubernetes).
+	AutoThreads picks the number of threads from runtime.NumCPU and the
+	cgroup CPU quota of the process instead.
+*/
+
+// AutoThreads makes SetThreads use the number of CPUs returned by DetectCPUs.
+const AutoThreads = -1
+
+// CgroupRoot is where the cgroup filesystem is mounted.
+var CgroupRoot = "/sys/fs/cgroup"
+
+/*
+SetThreads
+Description:
+
+	Sets the Threads parameter of the model to n. Use 0 to let Gurobi
+	decide (all cores) and AutoThreads to use the CPUs available to
+	this process (see DetectCPUs).
+*/
+func (model *Model) SetThreads(n int) error {
+	if n == AutoThreads {
+		n = DetectCPUs()
+	}
+
+	if n < 0 {
+		return fmt.Errorf("the number of threads must be non-negative or AutoThreads; received %v", n)
+	}
+
+	return model.SetIntParam("Threads", n)
+}
+
+/*
+DetectCPUs
+Description:
+
+	Returns the number of CPUs this process may use: runtime.NumCPU (which
+	respects the CPU affinity mask), further limited by the cgroup (v1 or v2)
+	CPU quota, rounded up. The result is at least 1.
+*/
+func DetectCPUs() int {
+	cpus := runtime.NumCPU()
+	if quota, ok := cgroupCPUQuota(CgroupRoot); ok {
+		if limit := int(math.Ceil(quota)); limit < cpus {
+			cpus = limit
+		}
+	}
+
+	if cpus < 1 {
+		return 1
+	}
+	return cpus
+}
+
+// cgroupCPUQuota returns the CPU quota (in CPUs) of the cgroup at root, and
+// false if there is none.
+func cgroupCPUQuota(root string) (float64, bool) {
+	// cgroup v2: "cpu.max" contains "<quota> <period>" or "max <period>".
+	if contents, err := os.ReadFile(filepath.Join(root, "cpu.max")); err == nil {
+		fields := strings.Fields(string(contents))
+		if len(fields) != 2 || fields[0] == "max" {
+			return 0, false
+		}
+		return quotaRatio(fields[0], fields[1])
+	}
+
+	// cgroup v1: the quota is -1 if there is no limit.
+	quota, err := os.ReadFile(filepath.Join(root, "cpu", "cpu.cfs_quota_us"))
+	if err != nil {
+		return 0, false
+	}
+	period, err := os.ReadFile(filepath.Join(root, "cpu", "cpu.cfs_period_us"))
+	if err != nil {
+		return 0, false
+	}
+	return quotaRatio(strings.TrimSpace(string(quota)), strings.TrimSpace(string(period)))
+}
+
+func quotaRatio(quota string, period string) (float64, bool) {
+	q, err := strconv.ParseFloat(quota, 64)
+	if err != nil || q <= 0 {
+		return 0, false
+	}
+	p, err := strconv.ParseFloat(period, 64)
+	if err != nil || p <= 0 {
+		return 0, false
+	}
+	return q / p, true
+}
@@ -0,0 +1,170 @@
+package gurobi
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+/*
+timeseries.go
+Description:
+	Variables on a regular time grid (e.g., the hourly output of a plant),
+	which can be looked up by time and summed over time windows.
+Notes:
+	The variables are named name[0], name[1], ..., so that their solution
+	can also be read with SolutionTable.Vector(name).
+*/
+
+/*
+TimeSeriesVar
+Description:
+
+	One variable per period of a time grid. Vars[i] belongs to the period
+	[Start + i*Step, Start + (i+1)*Step).
+*/
+type TimeSeriesVar struct {
+	Name  string
+	Start time.Time
+	Step  time.Duration
+	Vars  []*Var
+}
+
+/*
+AddTimeSeriesVars
+Description:
+
+	Adds horizon variables of type vtype with the bounds [lb, ub], one per
+	period of length step from start on, with a single call to AddVars.
+*/
+func (model *Model) AddTimeSeriesVars(horizon int, step time.Duration, start time.Time, vtype VarType, lb float64, ub float64, name string) (*TimeSeriesVar, error) {
+	// Input Processing
+	if horizon <= 0 {
+		return nil, fmt.Errorf("the horizon must be positive; received %v", horizon)
+	}
+	if step <= 0 {
+		return nil, fmt.Errorf("the step must be positive; received %v", step)
+	}
+
+	vtypes := make([]VarType, horizon)
+	objs := make([]float64, horizon)
+	lbs := make([]float64, horizon)
+	ubs := make([]float64, horizon)
+	names := make([]string, horizon)
+	for i := range names {
+		vtypes[i], lbs[i], ubs[i] = vtype, lb, ub
+		names[i] = fmt.Sprintf("%v[%v]", name, i)
+	}
+
+	// Algorithm
+	vars, err := model.AddVars(vtypes, objs, lbs, ubs, names, [][]*Constr{}, [][]float64{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TimeSeriesVar{Name: name, Start: start, Step: step, Vars: vars}, nil
+}
+
+/*
+Len
+Description:
+
+	Returns the number of periods.
+*/
+func (ts *TimeSeriesVar) Len() int {
+	return len(ts.Vars)
+}
+
+/*
+End
+Description:
+
+	Returns the end of the last period.
+*/
+func (ts *TimeSeriesVar) End() time.Time {
+	return ts.Time(len(ts.Vars))
+}
+
+/*
+Time
+Description:
+
+	Returns the start of period i.
+*/
+func (ts *TimeSeriesVar) Time(i int) time.Time {
+	return ts.Start.Add(time.Duration(i) * ts.Step)
+}
+
+/*
+Index
+Description:
+
+	Returns the index of the period which contains t.
+*/
+func (ts *TimeSeriesVar) Index(t time.Time) (int, error) {
+	if t.Before(ts.Start) || !t.Before(ts.End()) {
+		return 0, fmt.Errorf("%v is outside of the horizon [%v, %v) of %v", t, ts.Start, ts.End(), ts.Name)
+	}
+	return int(t.Sub(ts.Start) / ts.Step), nil
+}
+
+/*
+At
+Description:
+
+	Returns the variable of the period which contains t.
+*/
+func (ts *TimeSeriesVar) At(t time.Time) (*Var, error) {
+	i, err := ts.Index(t)
+	if err != nil {
+		return nil, err
+	}
+	return ts.Vars[i], nil
+}
+
+/*
+Sum
+Description:
+
+	Returns the sum of the variables of the periods which start in
+	[from, to). Periods outside of the horizon are ignored, so the sum may
+	be empty.
+*/
+func (ts *TimeSeriesVar) Sum(from time.Time, to time.Time) (*LinExpr, error) {
+	if to.Before(from) {
+		return nil, errors.New("the end of the window must not be before its start")
+	}
+
+	expr := &LinExpr{}
+	for i := ts.firstFrom(from); i < ts.firstFrom(to); i++ {
+		expr.AddTerm(ts.Vars[i], 1.0)
+	}
+	return expr, nil
+}
+
+// firstFrom returns the index of the first period which starts at or after t (Len() if there is none).
+func (ts *TimeSeriesVar) firstFrom(t time.Time) int {
+	d := t.Sub(ts.Start)
+	if d <= 0 {
+		return 0
+	}
+
+	i := int((d + ts.Step - 1) / ts.Step)
+	if i > len(ts.Vars) {
+		return len(ts.Vars)
+	}
+	return i
+}
+
+/*
+Values
+Description:
+
+	Returns the value (X) of every period in the current solution.
+*/
+func (ts *TimeSeriesVar) Values() ([]float64, error) {
+	if len(ts.Vars) == 0 {
+		return []float64{}, nil
+	}
+	return ts.Vars[0].Model.GetDoubleAttrVars(DBL_ATTR_X, ts.Vars)
+}
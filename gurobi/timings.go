@@ -0,0 +1,146 @@
+package gurobi
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+/*
+timings.go
+Description:
+	A breakdown of the runtime of a solve into its phases, so that
+	performance regressions can be attributed to the right phase.
+Notes:
+	Gurobi has no attributes for the presolve and root relaxation times, so
+	they are parsed from the log (from the MESSAGE callback during
+	OptimizeWithResult, or from a log file with ParseSolveTimings). The total
+	is Gurobi's Runtime attribute.
+*/
+
+/*
+SolveTimings
+Description:
+
+	The time (in seconds) spent in the phases of a solve. A phase which was
+	not run or not reported in the log (e.g., the root relaxation of an LP)
+	is 0.
+	- Presolve: The time until presolve finished.
+	- RootRelaxation: The time spent solving the root relaxation of a MIP.
+	- Total: The runtime of the whole solve.
+*/
+type SolveTimings struct {
+	Presolve       float64 `json:"presolve"`
+	RootRelaxation float64 `json:"root_relaxation"`
+	Total          float64 `json:"total"`
+}
+
+var (
+	timingPresolvePattern = regexp.MustCompile(`^Presolve time: ([0-9.eE+-]+)s`)
+	timingRootPattern     = regexp.MustCompile(`^Root relaxation: .*?([0-9.eE+-]+) seconds`)
+	timingTotalPattern    = regexp.MustCompile(`^(?:Solved|Explored) .*?([0-9.eE+-]+) seconds`)
+)
+
+/*
+TimingLogParser
+Description:
+
+	Incrementally parses log messages of a solve into SolveTimings, and the
+	RootRelaxation and SolveStats of a MIP.
+*/
+type TimingLogParser struct {
+	Timings SolveTimings
+	Root    RootRelaxation
+	Stats   SolveStats
+	partial string
+	inCuts  bool
+}
+
+/*
+Feed
+Description:
+
+	Consumes a chunk of log output (which may contain several lines, or only
+	part of a line) and updates Timings, Root and Stats.
+*/
+func (parser *TimingLogParser) Feed(message string) {
+	text := parser.partial + message
+	lines := strings.Split(text, "\n")
+	parser.partial = lines[len(lines)-1]
+
+	for _, line := range lines[:len(lines)-1] {
+		parser.parseLine(line)
+	}
+}
+
+func (parser *TimingLogParser) parseLine(line string) {
+	if root, ok := parseRootRelaxation(line); ok {
+		parser.Root = root
+	}
+	parser.parseStatsLine(line)
+
+	patterns := []struct {
+		pattern *regexp.Regexp
+		dst     *float64
+	}{
+		{timingPresolvePattern, &parser.Timings.Presolve},
+		{timingRootPattern, &parser.Timings.RootRelaxation},
+		{timingTotalPattern, &parser.Timings.Total},
+	}
+	for _, p := range patterns {
+		if match := p.pattern.FindStringSubmatch(line); match != nil {
+			if seconds, err := strconv.ParseFloat(match[1], 64); err == nil {
+				*p.dst = seconds
+			}
+		}
+	}
+}
+
+/*
+ParseSolveTimings
+Description:
+
+	Parses the SolveTimings of a solve from its log (e.g., the contents of
+	a per-solve log file). If the log contains several solves, the timings
+	of the last one are returned.
+*/
+func ParseSolveTimings(log string) SolveTimings {
+	parser := &TimingLogParser{}
+	parser.Feed(log + "\n")
+	return parser.Timings
+}
+
+// optimizeWithTimings optimizes the model while parsing the timings from its log messages.
+func (model *Model) optimizeWithTimings() (*TimingLogParser, error) {
+	parser := &TimingLogParser{}
+	if model.dryRun != nil {
+		return parser, model.Optimize()
+	}
+
+	previous, previousData := model.callbackFunc(), model.CallbackData()
+	err := model.SetCallbackWithData(func(cb *CallbackContext) error {
+		if previous != nil {
+			if err := previous(cb); err != nil {
+				return err
+			}
+		}
+		if cb.Where != WhereMessage {
+			return nil
+		}
+
+		message, err := cb.Message()
+		if err != nil {
+			return err
+		}
+		parser.Feed(message)
+		return nil
+	}, previousData)
+	if err != nil {
+		return nil, err
+	}
+	defer model.SetCallbackWithData(previous, previousData)
+
+	err = model.Optimize()
+	parser.Feed("\n")
+	return parser, err
+}
@@ -0,0 +1,99 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+trace.go
+Description:
+	A debug mode which logs every wrapped call into the Gurobi C library
+	(with its arguments, duration and return code) to a writer. This is useful
+	when diagnosing crashes or unexpected errors at the cgo boundary.
+Notes:
+	Tracing can also be enabled without code changes by setting the
+	GRBGO_TRACE environment variable to "stderr", "stdout" or a file path
+	(which is appended to).
+*/
+
+// TraceEnvVar is the OS environment variable which enables tracing at start-up.
+const TraceEnvVar = "GRBGO_TRACE"
+
+var (
+	traceEnabled atomic.Bool
+	traceMu      sync.Mutex
+	traceWriter  io.Writer
+)
+
+func init() {
+	target := os.Getenv(TraceEnvVar)
+	switch target {
+	case "":
+		return
+	case "stderr", "1":
+		SetTraceWriter(os.Stderr)
+	case "stdout":
+		SetTraceWriter(os.Stdout)
+	default:
+		f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "gurobi: could not open the trace file %v: %v\n", target, err)
+			return
+		}
+		SetTraceWriter(f)
+	}
+}
+
+/*
+SetTraceWriter
+Description:
+
+	Logs every wrapped C call to w. Passing nil turns tracing off.
+	It is safe to call this while other goroutines are using the package.
+*/
+func SetTraceWriter(w io.Writer) {
+	traceMu.Lock()
+	defer traceMu.Unlock()
+
+	traceWriter = w
+	traceEnabled.Store(w != nil)
+}
+
+// noopTrace is returned by traceCall when tracing is disabled.
+func noopTrace(C.int) {}
+
+// traceCall starts tracing the C function name; the returned function must be
+// called with the function's return code once it returns.
+func traceCall(name string, args ...interface{}) func(code C.int) {
+	if !traceEnabled.Load() {
+		return noopTrace
+	}
+
+	start := time.Now()
+	return func(code C.int) {
+		elapsed := time.Since(start)
+
+		parts := make([]string, len(args))
+		for i, arg := range args {
+			if s, ok := arg.(string); ok {
+				parts[i] = fmt.Sprintf("%q", s)
+			} else {
+				parts[i] = fmt.Sprintf("%v", arg)
+			}
+		}
+
+		traceMu.Lock()
+		defer traceMu.Unlock()
+		if traceWriter != nil {
+			fmt.Fprintf(traceWriter, "gurobi: %v(%v) = %v [%v]\n", name, strings.Join(parts, ", "), int(code), elapsed)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+/*
+tune.go
+Description:
+	Functions for running Gurobi's parameter tuning tool on a model while
+	streaming its progress to Go.
+Notes:
+	The tuner reports its progress through log messages, which are parsed
+	from the MESSAGE callback into one TuneTrial per candidate parameter set.
+	https://www.gurobi.com/documentation/current/refman/c_tunemodel.html
+*/
+
+const INT_ATTR_TUNE_RESULTCOUNT = C.GRB_INT_ATTR_TUNE_RESULTCOUNT
+
+/*
+TuneTrial
+Description:
+
+	The progress of the tuner after it finished testing one candidate
+	parameter set. MIPGap is negative if the tuner did not report a gap.
+*/
+type TuneTrial struct {
+	Candidate int
+	Params    map[string]string
+	Runtimes  []float64
+	MIPGap    float64
+}
+
+/*
+TuneResult
+Description:
+
+	One of the improved parameter sets found by the tuner. Index 0 is the best set.
+	Params only contains the parameters that differ from their default values.
+*/
+type TuneResult struct {
+	Index  int
+	Params map[string]string
+}
+
+// TuneTrialFunc is called after each trial of the tuner. Returning an error aborts tuning.
+type TuneTrialFunc func(trial TuneTrial) error
+
+/*
+Runtime
+Description:
+
+	Returns the mean runtime of the trial over all of its random seeds
+	(or -1 if no runtime was reported).
+*/
+func (trial TuneTrial) Runtime() float64 {
+	if len(trial.Runtimes) == 0 {
+		return -1
+	}
+
+	total := 0.0
+	for _, runtime := range trial.Runtimes {
+		total += runtime
+	}
+	return total / float64(len(trial.Runtimes))
+}
+
+var (
+	tuneCandidatePattern = regexp.MustCompile(`^Testing candidate parameter set (\d+)`)
+	tuneParamPattern     = regexp.MustCompile(`^\s+(\w+)\s+(\S+)\s*$`)
+	tuneRuntimePattern   = regexp.MustCompile(`runtime ([0-9.eE+-]+)s`)
+	tuneGapPattern       = regexp.MustCompile(`gap ([0-9.eE+-]+)%`)
+)
+
+/*
+TuneLogParser
+Description:
+
+	Incrementally parses the tuner's log messages into TuneTrials.
+*/
+type TuneLogParser struct {
+	current *TuneTrial
+	partial string
+}
+
+/*
+Feed
+Description:
+
+	Consumes a chunk of log output (which may contain several lines, or only
+	part of a line) and returns each trial which was completed by that chunk.
+*/
+func (parser *TuneLogParser) Feed(message string) []TuneTrial {
+	completed := []TuneTrial{}
+
+	text := parser.partial + message
+	lines := strings.Split(text, "\n")
+	parser.partial = lines[len(lines)-1]
+
+	for _, line := range lines[:len(lines)-1] {
+		if match := tuneCandidatePattern.FindStringSubmatch(line); match != nil {
+			if trial, ok := parser.Flush(); ok {
+				completed = append(completed, trial)
+			}
+			candidate, _ := strconv.Atoi(match[1])
+			parser.current = &TuneTrial{Candidate: candidate, Params: map[string]string{}, MIPGap: -1}
+			continue
+		}
+
+		if parser.current == nil {
+			continue
+		}
+
+		if strings.HasPrefix(line, "Progress so far") {
+			if trial, ok := parser.Flush(); ok {
+				completed = append(completed, trial)
+			}
+			continue
+		}
+
+		if match := tuneRuntimePattern.FindStringSubmatch(line); match != nil {
+			if runtime, err := strconv.ParseFloat(match[1], 64); err == nil {
+				parser.current.Runtimes = append(parser.current.Runtimes, runtime)
+			}
+		}
+
+		if match := tuneGapPattern.FindStringSubmatch(line); match != nil {
+			if gap, err := strconv.ParseFloat(match[1], 64); err == nil {
+				parser.current.MIPGap = gap / 100
+			}
+		}
+
+		if len(parser.current.Runtimes) == 0 {
+			if match := tuneParamPattern.FindStringSubmatch(line); match != nil {
+				parser.current.Params[match[1]] = match[2]
+			}
+		}
+	}
+
+	return completed
+}
+
+/*
+Flush
+Description:
+
+	Returns the trial currently being parsed (if any) and resets the parser.
+*/
+func (parser *TuneLogParser) Flush() (TuneTrial, bool) {
+	if parser.current == nil {
+		return TuneTrial{}, false
+	}
+
+	trial := *parser.current
+	parser.current = nil
+	return trial, true
+}
+
+/*
+Tune
+Description:
+
+	Runs the parameter tuning tool on the model. If onTrial is not nil, it is
+	called after each candidate parameter set has been tested; returning an
+	error from onTrial aborts tuning and that error is returned by Tune.
+	While tuning, the model's callback is temporarily replaced; it is restored
+	once tuning finishes.
+*/
+func (model *Model) Tune(onTrial TuneTrialFunc) ([]TuneResult, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if onTrial != nil {
+		previous, previousData := model.callbackFunc(), model.CallbackData()
+		parser := &TuneLogParser{}
+		err = model.SetCallbackWithData(func(cb *CallbackContext) error {
+			if previous != nil {
+				if err := previous(cb); err != nil {
+					return err
+				}
+			}
+			if cb.Where != WhereMessage {
+				return nil
+			}
+
+			message, err := cb.Message()
+			if err != nil {
+				return err
+			}
+			for _, trial := range parser.Feed(message) {
+				if err := onTrial(trial); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, previousData)
+		if err != nil {
+			return nil, err
+		}
+		defer model.SetCallbackWithData(previous, previousData)
+	}
+
+	endSolve, err := model.beginSolve("Tune")
+	if err != nil {
+		return nil, err
+	}
+	done := traceCall("GRBtunemodel")
+	errCode := C.GRBtunemodel(model.AsGRBModel)
+	done(errCode)
+	endSolve()
+	model.attrCache.clear()
+	if cbErr := model.takeCallbackError(); cbErr != nil {
+		return nil, cbErr
+	}
+	if errCode != 0 {
+		return nil, model.MakeError(errCode)
+	}
+
+	return model.TuneResults()
+}
+
+/*
+TuneResults
+Description:
+
+	Returns the improved parameter sets found by the most recent call to Tune,
+	best first. Retrieving a result loads it into the model's parameters, so
+	after this call the model's parameters are those of the best result.
+*/
+func (model *Model) TuneResults() ([]TuneResult, error) {
+	count, err := model.GetIntAttr(INT_ATTR_TUNE_RESULTCOUNT)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]TuneResult, count)
+	for i := int(count) - 1; i >= 0; i-- {
+		params, err := model.LoadTuneResult(i)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = TuneResult{Index: i, Params: params}
+	}
+
+	return results, nil
+}
+
+/*
+LoadTuneResult
+Description:
+
+	Loads the i-th parameter set found by the tuner into the model and returns
+	the parameters which differ from their default values.
+*/
+func (model *Model) LoadTuneResult(i int) (map[string]string, error) {
+	if i < 0 {
+		return nil, errors.New("the index of a tune result must be nonnegative")
+	}
+	if err := model.checkFor("LoadTuneResult"); err != nil {
+		return nil, err
+	}
+
+	done := traceCall("GRBgettuneresult", i)
+	errCode := C.GRBgettuneresult(model.AsGRBModel, C.int(i))
+	done(errCode)
+	if errCode != 0 {
+		return nil, fmt.Errorf("there was an issue retrieving tune result %v: %w", i, model.MakeError(errCode))
+	}
+
+	return model.Env.ChangedParams()
+}
@@ -1,5 +1,7 @@
 package gurobi
 
+import "fmt"
+
 /*
 var.go
 Description:
@@ -46,6 +48,29 @@ func (v *Var) SetString(attr string, value string) error {
 	return v.Model.setStringAttrElement(attr, v.Index, value)
 }
 
+/*
+GetAttr
+Description:
+
+	Returns the value of the variable attribute attr (e.g., "X", "VType" or
+	"VarName") as an int32, int8, float64 or string, depending on the
+	attribute's type.
+*/
+func (v *Var) GetAttr(attr string) (interface{}, error) {
+	return v.Model.getAttrElement(attr, v.Index, attrElemVar)
+}
+
+/*
+SetAttr
+Description:
+
+	Sets the variable attribute attr to value. Numeric values are converted
+	to the attribute's type, so v.SetAttr("UB", 1) sets the upper bound to 1.0.
+*/
+func (v *Var) SetAttr(attr string, value interface{}) error {
+	return v.Model.setAttrElement(attr, v.Index, attrElemVar, value)
+}
+
 func (v *Var) SetObj(value float64) error {
 	err := v.Model.setDoubleAttrElement("Obj", v.Index, value)
 	if err != nil {
@@ -55,3 +80,58 @@ func (v *Var) SetObj(value float64) error {
 	// Update model and return
 	return v.Model.Update()
 }
+
+/*
+IsBinary
+Description:
+
+	Returns true if the variable's type (the VType attribute) is Binary.
+*/
+func (v *Var) IsBinary() (bool, error) {
+	vtype, err := v.GetChar("VType")
+	if err != nil {
+		return false, err
+	}
+	return VarType(vtype) == Binary, nil
+}
+
+/*
+Implies
+Description:
+
+	Adds the indicator constraint (v == 1) -> tc, so that tc must hold whenever
+	the binary variable v is 1. For example:
+
+		open.Implies(flow.LessEq(capacity), "open_capacity")
+*/
+func (v *Var) Implies(tc TempConstr, name string) (*GenConstr, error) {
+	return v.addIndicator(1, tc, name)
+}
+
+/*
+ImpliesNot
+Description:
+
+	Adds the indicator constraint (v == 0) -> tc, so that tc must hold whenever
+	the binary variable v is 0.
+*/
+func (v *Var) ImpliesNot(tc TempConstr, name string) (*GenConstr, error) {
+	return v.addIndicator(0, tc, name)
+}
+
+// addIndicator adds the indicator constraint (v == binVal) -> tc.
+func (v *Var) addIndicator(binVal int, tc TempConstr, name string) (*GenConstr, error) {
+	if err := tc.Check(); err != nil {
+		return nil, err
+	}
+
+	isBinary, err := v.IsBinary()
+	if err != nil {
+		return nil, err
+	}
+	if !isBinary {
+		return nil, fmt.Errorf("only binary variables can imply a constraint")
+	}
+
+	return v.Model.AddGenConstrIndicator(v, binVal, tc.LHS.Ind, tc.LHS.Val, tc.Sense, tc.NormalizedRHS(), name)
+}
@@ -0,0 +1,87 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import "fmt"
+
+/*
+vartype.go
+Description:
+	Defines the VarType type which describes the domain of a gurobi variable
+	(continuous, binary, integer, semi-continuous or semi-integer).
+*/
+
+// VarType is the type of a Gurobi variable (the "VType" attribute).
+// Its underlying type is int8 so that the untyped constants BINARY, INTEGER
+// and CONTINUOUS (as well as char literals like 'C') can still be used anywhere
+// a VarType is expected.
+type VarType int8
+
+const (
+	Continuous VarType = C.GRB_CONTINUOUS
+	Binary     VarType = C.GRB_BINARY
+	Integer    VarType = C.GRB_INTEGER
+	SemiCont   VarType = C.GRB_SEMICONT
+	SemiInt    VarType = C.GRB_SEMIINT
+)
+
+/*
+ToVarType
+Description:
+
+	Converts a raw int8 vtype (as used by the C API) into a VarType,
+	returning an error if the value does not correspond to a valid variable type.
+*/
+func ToVarType(vtype int8) (VarType, error) {
+	vt := VarType(vtype)
+	return vt, vt.Check()
+}
+
+/*
+Check
+Description:
+
+	Checks that the VarType is one of the variable types that Gurobi recognizes.
+*/
+func (vt VarType) Check() error {
+	switch vt {
+	case Continuous, Binary, Integer, SemiCont, SemiInt:
+		return nil
+	default:
+		return InvalidVarTypeError{VType: int8(vt)}
+	}
+}
+
+/*
+String
+Description:
+
+	Returns a human-readable name for the variable type.
+*/
+func (vt VarType) String() string {
+	switch vt {
+	case Continuous:
+		return "Continuous"
+	case Binary:
+		return "Binary"
+	case Integer:
+		return "Integer"
+	case SemiCont:
+		return "SemiCont"
+	case SemiInt:
+		return "SemiInt"
+	default:
+		return fmt.Sprintf("VarType(%v)", int8(vt))
+	}
+}
+
+/*
+IsIntegral
+Description:
+
+	Returns true if the variable type requires integral values
+	(Binary, Integer or SemiInt).
+*/
+func (vt VarType) IsIntegral() bool {
+	return vt == Binary || vt == Integer || vt == SemiInt
+}
@@ -0,0 +1,268 @@
+package gurobi
+
+// #include <gurobi_passthrough.h>
+import "C"
+import (
+	"fmt"
+	"math"
+)
+
+/*
+verify.go
+Description:
+	Verifies the feasibility of a solution in Go, by reading the linear
+	constraint matrix, bounds and variable types of a model and recomputing
+	all residuals. Quadratic, SOS and general constraints are not checked.
+*/
+
+/*
+LinearData
+Description:
+
+	The linear part of a model in compressed sparse row format: the nonzeros
+	of constraint i are Ind[Beg[i]:Beg[i+1]] with values Val[Beg[i]:Beg[i+1]].
+*/
+type LinearData struct {
+	Beg    []int32
+	Ind    []int32
+	Val    []float64
+	Senses []Sense
+	RHS    []float64
+	LB     []float64
+	UB     []float64
+	VTypes []VarType
+}
+
+// ConstrViolation describes a linear constraint which is violated by more than the tolerance.
+type ConstrViolation struct {
+	Index     int32
+	Activity  float64
+	Sense     Sense
+	RHS       float64
+	Violation float64
+}
+
+// BoundViolation describes a variable which is outside of its bounds by more than the tolerance.
+type BoundViolation struct {
+	Index     int32
+	Value     float64
+	LB        float64
+	UB        float64
+	Violation float64
+}
+
+/*
+ViolationReport
+Description:
+
+	The result of verifying a solution. MaxViolation is the largest constraint,
+	bound or integrality violation found (even those within the tolerance).
+*/
+type ViolationReport struct {
+	Constraints  []ConstrViolation
+	Bounds       []BoundViolation
+	Integrality  []IntegralityViolation
+	MaxViolation float64
+}
+
+/*
+IsFeasible
+Description:
+
+	Returns true if no violations above the tolerance were found.
+*/
+func (vr *ViolationReport) IsFeasible() bool {
+	return len(vr.Constraints) == 0 && len(vr.Bounds) == 0 && len(vr.Integrality) == 0
+}
+
+/*
+LinearData
+Description:
+
+	Reads the linear constraint matrix (with GRBgetconstrs), the senses and
+	right-hand sides, and the bounds and types of all variables.
+*/
+func (model *Model) LinearData() (*LinearData, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	numVars, err := model.NumVars()
+	if err != nil {
+		return nil, err
+	}
+
+	numConstrs, err := model.NumConstrs()
+	if err != nil {
+		return nil, err
+	}
+
+	data := &LinearData{Beg: make([]int32, numConstrs+1)}
+
+	// Matrix (the first call only retrieves the number of nonzeros)
+	if numConstrs > 0 {
+		var numnz int32
+		done := traceCall("GRBgetconstrs", numConstrs)
+		errCode := C.GRBgetconstrs(model.AsGRBModel, (*C.int)(&numnz), nil, nil, nil, 0, C.int(numConstrs))
+		done(errCode)
+		if errCode != 0 {
+			return nil, model.MakeError(errCode)
+		}
+
+		data.Ind = make([]int32, numnz)
+		data.Val = make([]float64, numnz)
+		if numnz > 0 {
+			done := traceCall("GRBgetconstrs", numConstrs)
+			errCode = C.GRBgetconstrs(
+				model.AsGRBModel, (*C.int)(&numnz),
+				(*C.int)(&data.Beg[0]), (*C.int)(&data.Ind[0]), (*C.double)(&data.Val[0]),
+				0, C.int(numConstrs),
+			)
+			done(errCode)
+			if errCode != 0 {
+				return nil, model.MakeError(errCode)
+			}
+		}
+		data.Beg[numConstrs] = numnz
+	}
+
+	rawSenses, err := model.getCharAttrArray("Sense", 0, numConstrs)
+	if err != nil {
+		return nil, err
+	}
+	data.Senses = make([]Sense, len(rawSenses))
+	for i, s := range rawSenses {
+		data.Senses[i] = Sense(s)
+	}
+
+	if data.RHS, err = model.getDoubleAttrArray("RHS", 0, numConstrs); err != nil {
+		return nil, err
+	}
+	if data.LB, err = model.getDoubleAttrArray(DBL_ATTR_LB, 0, numVars); err != nil {
+		return nil, err
+	}
+	if data.UB, err = model.getDoubleAttrArray(DBL_ATTR_UB, 0, numVars); err != nil {
+		return nil, err
+	}
+
+	rawTypes, err := model.getCharAttrArray("VType", 0, numVars)
+	if err != nil {
+		return nil, err
+	}
+	data.VTypes = make([]VarType, len(rawTypes))
+	for i, vt := range rawTypes {
+		data.VTypes[i] = VarType(vt)
+	}
+
+	return data, nil
+}
+
+/*
+VerifySolution
+Description:
+
+	Checks x (one value per variable) against the linear constraints, bounds
+	and integrality requirements of the model and reports every violation
+	above tol.
+*/
+func (model *Model) VerifySolution(x []float64, tol float64) (*ViolationReport, error) {
+	data, err := model.LinearData()
+	if err != nil {
+		return nil, err
+	}
+	return data.Verify(x, tol)
+}
+
+/*
+Verify
+Description:
+
+	Checks x against the linear data and reports every violation above tol.
+	Semi-continuous and semi-integer variables may also take the value 0.
+*/
+func (data *LinearData) Verify(x []float64, tol float64) (*ViolationReport, error) {
+	// Input Processing
+	if len(x) != len(data.LB) || len(data.UB) != len(data.LB) || len(data.VTypes) != len(data.LB) {
+		return nil, MismatchedLengthError{
+			Length1: len(x),
+			Name1:   "x",
+			Length2: len(data.LB),
+			Name2:   "variables",
+		}
+	}
+
+	numConstrs := len(data.Senses)
+	if len(data.RHS) != numConstrs || len(data.Beg) != numConstrs+1 {
+		return nil, fmt.Errorf("the constraint data of the model is inconsistent")
+	}
+
+	if tol < 0 {
+		return nil, fmt.Errorf("the tolerance must be nonnegative; received %v", tol)
+	}
+
+	report := &ViolationReport{}
+
+	// Constraints
+	for i := 0; i < numConstrs; i++ {
+		activity := 0.0
+		for k := data.Beg[i]; k < data.Beg[i+1]; k++ {
+			activity += data.Val[k] * x[data.Ind[k]]
+		}
+
+		var violation float64
+		switch data.Senses[i] {
+		case Le:
+			violation = activity - data.RHS[i]
+		case Ge:
+			violation = data.RHS[i] - activity
+		case Eq:
+			violation = math.Abs(activity - data.RHS[i])
+		default:
+			return nil, InvalidSenseError{Sense: int8(data.Senses[i])}
+		}
+
+		report.MaxViolation = math.Max(report.MaxViolation, violation)
+		if violation > tol {
+			report.Constraints = append(report.Constraints, ConstrViolation{
+				Index:     int32(i),
+				Activity:  activity,
+				Sense:     data.Senses[i],
+				RHS:       data.RHS[i],
+				Violation: violation,
+			})
+		}
+	}
+
+	// Bounds and integrality
+	for j, value := range x {
+		vtype := data.VTypes[j]
+		isSemi := vtype == SemiCont || vtype == SemiInt
+
+		violation := math.Max(data.LB[j]-value, value-data.UB[j])
+		if isSemi && math.Abs(value) <= tol {
+			violation = math.Abs(value)
+		}
+
+		report.MaxViolation = math.Max(report.MaxViolation, violation)
+		if violation > tol {
+			report.Bounds = append(report.Bounds, BoundViolation{
+				Index:     int32(j),
+				Value:     value,
+				LB:        data.LB[j],
+				UB:        data.UB[j],
+				Violation: violation,
+			})
+		}
+
+		if vtype.IsIntegral() {
+			intViolation := math.Abs(value - math.Round(value))
+			report.MaxViolation = math.Max(report.MaxViolation, intViolation)
+			if intViolation > tol {
+				report.Integrality = append(report.Integrality, IntegralityViolation{Index: int32(j), Value: value})
+			}
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,158 @@
+package gurobi
+
+import (
+	"errors"
+	"fmt"
+)
+
+/*
+violation.go
+Description:
+	Summarizes the solution quality attributes (ConstrVio, BoundVio, IntVio and
+	friends) of a solved model so that numerically sloppy solutions can be
+	rejected automatically.
+*/
+
+/*
+Offender
+Description:
+
+	The constraint or variable with the largest violation of a given kind.
+	Index is -1 (and Name is empty) when there is no such element.
+*/
+type Offender struct {
+	Index     int32
+	Name      string
+	Violation float64
+}
+
+/*
+ViolationSummary
+Description:
+
+	The maximum and summed constraint, bound and integrality violations of the
+	current solution, together with the worst offender of each kind.
+	The integrality fields are zero for continuous models.
+*/
+type ViolationSummary struct {
+	ConstrVio    float64
+	ConstrVioSum float64
+	BoundVio     float64
+	BoundVioSum  float64
+	IntVio       float64
+	IntVioSum    float64
+
+	WorstConstr Offender
+	WorstBound  Offender
+	WorstInt    Offender
+}
+
+/*
+ViolationSummary
+Description:
+
+	Reads the solution quality attributes of the model after a solve.
+
+Link:
+
+	https://www.gurobi.com/documentation/current/refman/attributes.html#sec:Attributes
+*/
+func (model *Model) ViolationSummary() (*ViolationSummary, error) {
+	err := model.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	vs := &ViolationSummary{}
+
+	if vs.WorstConstr, err = model.readOffender("ConstrVio", "ConstrVioIndex", "ConstrName"); err != nil {
+		return nil, err
+	}
+	if vs.WorstBound, err = model.readOffender("BoundVio", "BoundVioIndex", "VarName"); err != nil {
+		return nil, err
+	}
+	if vs.WorstInt, err = model.readOffender("IntVio", "IntVioIndex", "VarName"); err != nil {
+		return nil, err
+	}
+
+	vs.ConstrVio = vs.WorstConstr.Violation
+	vs.BoundVio = vs.WorstBound.Violation
+	vs.IntVio = vs.WorstInt.Violation
+
+	if vs.ConstrVioSum, err = model.optionalDoubleAttr("ConstrVioSum"); err != nil {
+		return nil, err
+	}
+	if vs.BoundVioSum, err = model.optionalDoubleAttr("BoundVioSum"); err != nil {
+		return nil, err
+	}
+	if vs.IntVioSum, err = model.optionalDoubleAttr("IntVioSum"); err != nil {
+		return nil, err
+	}
+
+	return vs, nil
+}
+
+/*
+Check
+Description:
+
+	Returns an error naming the worst offender if any of the maximum
+	violations exceeds tol.
+*/
+func (vs *ViolationSummary) Check(tol float64) error {
+	checks := []struct {
+		kind     string
+		offender Offender
+	}{
+		{"constraint", vs.WorstConstr},
+		{"bound", vs.WorstBound},
+		{"integrality", vs.WorstInt},
+	}
+
+	for _, c := range checks {
+		if c.offender.Violation > tol {
+			return fmt.Errorf(
+				"the %v violation %v of \"%v\" (index %v) exceeds the tolerance %v",
+				c.kind, c.offender.Violation, c.offender.Name, c.offender.Index, tol,
+			)
+		}
+	}
+	return nil
+}
+
+// readOffender reads a violation attribute, the index attribute of its worst element and that element's name.
+func (model *Model) readOffender(vioAttr string, indexAttr string, nameAttr string) (Offender, error) {
+	offender := Offender{Index: -1}
+
+	vio, err := model.optionalDoubleAttr(vioAttr)
+	if err != nil {
+		return offender, err
+	}
+	offender.Violation = vio
+
+	index, err := model.GetIntAttr(indexAttr)
+	if errors.Is(err, ErrDataNotAvailable) {
+		return offender, nil
+	} else if err != nil {
+		return offender, err
+	}
+
+	if index < 0 {
+		return offender, nil
+	}
+
+	offender.Index = index
+	if offender.Name, err = model.getStringAttrElement(nameAttr, index); err != nil {
+		return offender, err
+	}
+	return offender, nil
+}
+
+// optionalDoubleAttr reads a double attribute, treating an unavailable attribute as 0.
+func (model *Model) optionalDoubleAttr(attr string) (float64, error) {
+	value, err := model.GetDoubleAttr(attr)
+	if errors.Is(err, ErrDataNotAvailable) {
+		return 0, nil
+	}
+	return value, err
+}
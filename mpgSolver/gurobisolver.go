@@ -207,7 +207,7 @@ func (gs *GurobiSolver) AddVariable(varIn optim.Variable) error {
 	}
 
 	// Add Variable to Current Model
-	tempVar, err := gs.CurrentModel.AddVar(int8(vType), 0.0, varIn.Lower, varIn.Upper, fmt.Sprintf("x%v", varIn.ID), []*gurobi.Constr{}, []float64{})
+	tempVar, err := gs.CurrentModel.AddVar(gurobi.VarType(vType), 0.0, varIn.Lower, varIn.Upper, fmt.Sprintf("x%v", varIn.ID), []*gurobi.Constr{}, []float64{})
 
 	fmt.Printf("%v: L=%v, U=%v, name=%v\n", int8(vType), varIn.Lower, varIn.Upper, fmt.Sprintf("x%v", varIn.ID))
 
@@ -298,7 +298,7 @@ func (gs *GurobiSolver) AddConstraint(constrIn optim.Constraint, errors ...error
 
 		// Call Gurobi library's AddConstr() function
 		_, err = gs.CurrentModel.AddConstr(
-			gurobiVarSlice, L, senseOut, C,
+			gurobiVarSlice, L, gurobi.Sense(senseOut), C,
 			fmt.Sprintf("goop Constraint #%v", len(gs.CurrentModel.Constraints)),
 		)
 		if err != nil {
@@ -364,7 +364,7 @@ func (gs *GurobiSolver) SetObjective(objIn optim.Objective) error {
 		fmt.Println(gurobiLE)
 
 		// Add linear expression to the objective.
-		err := gs.CurrentModel.SetLinearObjective(gurobiLE, int32(objIn.Sense))
+		err := gs.CurrentModel.SetLinearObjective(gurobiLE, gurobi.ObjSense(objIn.Sense))
 		if err != nil {
 			return fmt.Errorf("There was an issue setting the linear objective with SetLinearObjective(): %v", err)
 		}
@@ -414,7 +414,7 @@ func (gs *GurobiSolver) SetObjective(objIn optim.Objective) error {
 		// Return
 		fmt.Println(*gurobiQE)
 
-		err := gs.CurrentModel.SetQuadraticObjective(gurobiQE, int32(objIn.Sense))
+		err := gs.CurrentModel.SetQuadraticObjective(gurobiQE, gurobi.ObjSense(objIn.Sense))
 		if err != nil {
 			return fmt.Errorf("There was an issue setting the quadratic objective with SetQuadraticObjective(): %v", err)
 		}
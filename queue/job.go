@@ -0,0 +1,194 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+job.go
+Description:
+	The jobs and results of a Queue, and their JSON bundles. A job bundle
+	contains the model (in the JSON schema of gurobi.ModelSpec) and the
+	parameters to solve it with; a result bundle contains the SolveResult,
+	the values of the variables and the error of the last attempt, if any.
+*/
+
+// resultSuffix is appended to the ID of a job to name its result bundle.
+const resultSuffix = ".result.json"
+
+/*
+Job
+Description:
+
+	A model to solve, with parameters in their string form (see
+	gurobi.Model.SetParam).
+*/
+type Job struct {
+	ID     string            `json:"id"`
+	Spec   *gurobi.ModelSpec `json:"spec"`
+	Params map[string]string `json:"params,omitempty"`
+}
+
+/*
+Result
+Description:
+
+	The outcome of a job. Error is empty if the job was solved (which does
+	not mean that a solution was found; see SolveResult.Status). Attempts
+	is the number of times the job was tried.
+*/
+type Result struct {
+	JobID       string              `json:"job_id"`
+	Attempts    int                 `json:"attempts"`
+	SolveResult *gurobi.SolveResult `json:"solve_result,omitempty"`
+	X           []float64           `json:"x,omitempty"`
+	Error       string              `json:"error,omitempty"`
+}
+
+/*
+ReadJobFile
+Description:
+
+	Reads a job bundle. The ID of the job defaults to the name of the file
+	without its extension.
+*/
+func ReadJobFile(path string) (*Job, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &Job{}
+	if err := json.Unmarshal(data, job); err != nil {
+		return nil, fmt.Errorf("job %v: %w", path, err)
+	}
+	if job.Spec == nil {
+		return nil, fmt.Errorf("job %v: the bundle has no spec", path)
+	}
+	if job.ID == "" {
+		job.ID = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	if err := checkJobID(job.ID); err != nil {
+		return nil, fmt.Errorf("job %v: %w", path, err)
+	}
+	return job, nil
+}
+
+/*
+WriteJobFile
+Description:
+
+	Writes job as a bundle named after its ID into dir.
+*/
+func WriteJobFile(dir string, job *Job) error {
+	if job == nil {
+		return errors.New("a job needs an ID")
+	}
+	if err := checkJobID(job.ID); err != nil {
+		return err
+	}
+	return writeJSON(filepath.Join(dir, job.ID+".json"), job)
+}
+
+/*
+ReadJobDir
+Description:
+
+	Reads every job bundle (*.json, except result bundles) in dir, ordered
+	by file name.
+*/
+func ReadJobDir(dir string) ([]*Job, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	jobs := []*Job{}
+	for _, path := range paths {
+		if strings.HasSuffix(path, resultSuffix) {
+			continue
+		}
+		job, err := ReadJobFile(path)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+/*
+WriteResultFile
+Description:
+
+	Writes result as a bundle named after its job into dir.
+*/
+func WriteResultFile(dir string, result *Result) error {
+	if err := checkJobID(result.JobID); err != nil {
+		return err
+	}
+	return writeJSON(resultPath(dir, result.JobID), result)
+}
+
+/*
+ReadResultFile
+Description:
+
+	Reads a result bundle.
+*/
+func ReadResultFile(path string) (*Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, fmt.Errorf("result %v: %w", path, err)
+	}
+	return result, nil
+}
+
+/*
+checkJobID
+Description:
+
+	Returns an error if id cannot name a bundle, i.e., if it is empty or
+	could escape its directory (a path separator or "..").
+*/
+func checkJobID(id string) error {
+	if id == "" {
+		return errors.New("a job needs an ID")
+	}
+	if strings.ContainsAny(id, `/\`) || strings.Contains(id, "..") {
+		return fmt.Errorf("the job ID %q cannot contain a path separator or \"..\"", id)
+	}
+	return nil
+}
+
+func resultPath(dir string, jobID string) string {
+	return filepath.Join(dir, jobID+resultSuffix)
+}
+
+// writeJSON writes v to path through a temporary file, so that readers never see a partial bundle.
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,336 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+queue.go
+Description:
+	A job queue which solves models with a pool of workers. Jobs are taken
+	from a channel (Run) or from a directory of job bundles (RunDir), and
+	every worker solves its jobs one after the other in an environment of
+	its own, so that the solves of different workers do not share an
+	environment. Failed attempts are retried according to a RetryPolicy,
+	and every attempt is limited by Timeout.
+*/
+
+/*
+RetryPolicy
+Description:
+
+	Controls how often a job is attempted.
+	- MaxAttempts: The maximum number of attempts; values below 1 mean a
+	  single attempt.
+	- Backoff: The wait before the second attempt, which doubles for every
+	  further attempt.
+	- RetryIf: Decides whether a failed attempt is retried; nil retries
+	  every error except cancellations of the queue's context.
+*/
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	RetryIf     func(err error) bool
+}
+
+// retry returns true if the attempt which failed with err may be retried.
+func (p RetryPolicy) retry(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if p.RetryIf != nil {
+		return p.RetryIf(err)
+	}
+	return true
+}
+
+/*
+SolveFunc
+Description:
+
+	Solves a single attempt of job in env. The attempt must be abandoned
+	when ctx is done (e.g., when the attempt timed out).
+*/
+type SolveFunc func(ctx context.Context, env *gurobi.Env, job *Job) (*Result, error)
+
+/*
+Queue
+Description:
+
+	Solves jobs with Workers workers.
+	- NewEnv: Creates the environment of a worker; nil creates environments
+	  with gurobi.NewEnv(LogFile). The environments are freed when the
+	  queue stops.
+	- Solve: Solves a single attempt of a job; nil uses SolveJob.
+	- Timeout: Limits every attempt; 0 means no limit.
+*/
+type Queue struct {
+	Workers int
+	NewEnv  func() (*gurobi.Env, error)
+	LogFile string
+	Solve   SolveFunc
+	Retry   RetryPolicy
+	Timeout time.Duration
+}
+
+/*
+New
+Description:
+
+	Creates a queue with the given number of workers, which attempts every
+	job once without a time limit.
+*/
+func New(workers int) *Queue {
+	return &Queue{Workers: workers}
+}
+
+/*
+Run
+Description:
+
+	Solves the jobs received from jobs and sends their results to the
+	returned channel, in the order in which they finish. The channel is
+	closed once jobs is closed (or ctx is done) and all workers have
+	finished. Jobs which are still in jobs when ctx is done are not solved.
+*/
+func (q *Queue) Run(ctx context.Context, jobs <-chan *Job) <-chan *Result {
+	workers := q.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make(chan *Result)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.work(ctx, jobs, results)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	return results
+}
+
+// work solves jobs until jobs is closed or ctx is done.
+func (q *Queue) work(ctx context.Context, jobs <-chan *Job, results chan<- *Result) {
+	env, envErr := q.newEnv()
+	if env != nil {
+		defer env.Free()
+	}
+
+	for {
+		var job *Job
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return
+		case job, ok = <-jobs:
+			if !ok {
+				return
+			}
+		}
+
+		var result *Result
+		if envErr != nil {
+			result = &Result{JobID: job.ID, Error: fmt.Sprintf("cannot create the environment of the worker: %v", envErr)}
+		} else {
+			result = q.solve(ctx, env, job)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case results <- result:
+		}
+	}
+}
+
+func (q *Queue) newEnv() (*gurobi.Env, error) {
+	if q.NewEnv != nil {
+		return q.NewEnv()
+	}
+	return gurobi.NewEnv(q.LogFile)
+}
+
+// solve attempts job until it succeeds or the retry policy gives up.
+func (q *Queue) solve(ctx context.Context, env *gurobi.Env, job *Job) *Result {
+	solveFn := q.Solve
+	if solveFn == nil {
+		solveFn = SolveJob
+	}
+	maxAttempts := q.Retry.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	backoff := q.Retry.Backoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		var result *Result
+		result, err = q.attempt(ctx, solveFn, env, job)
+		if err == nil {
+			result.JobID, result.Attempts = job.ID, attempt
+			return result
+		}
+		if attempt >= maxAttempts || !q.Retry.retry(ctx, err) {
+			return &Result{JobID: job.ID, Attempts: attempt, Error: err.Error()}
+		}
+
+		if backoff > 0 {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return &Result{JobID: job.ID, Attempts: attempt, Error: err.Error()}
+			case <-timer.C:
+			}
+			backoff *= 2
+		}
+	}
+}
+
+// attempt runs a single attempt of job, limited by the timeout of the queue.
+func (q *Queue) attempt(ctx context.Context, solveFn SolveFunc, env *gurobi.Env, job *Job) (*Result, error) {
+	if job.Spec == nil {
+		return nil, errors.New("the job has no spec")
+	}
+	if q.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, q.Timeout)
+		defer cancel()
+	}
+
+	result, err := solveFn(ctx, env, job)
+	if err == nil && result == nil {
+		err = errors.New("the solve returned no result")
+	}
+	return result, err
+}
+
+/*
+SolveJob
+Description:
+
+	The default SolveFunc: compiles the spec of job in env, sets its
+	parameters and optimizes it. If ctx has a deadline, the TimeLimit
+	parameter is lowered to the remaining time; if ctx is done before the
+	solve finishes, the solve is terminated (and its result reports the
+	interruption).
+*/
+func SolveJob(ctx context.Context, env *gurobi.Env, job *Job) (*Result, error) {
+	model, err := job.Spec.Compile(env)
+	if err != nil {
+		return nil, err
+	}
+	defer model.Free()
+
+	for name, value := range job.Params {
+		if err := model.SetParam(name, value); err != nil {
+			return nil, err
+		}
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		remaining := time.Until(deadline).Seconds()
+		if remaining <= 0 {
+			return nil, ctx.Err()
+		}
+		limit, err := model.GetDBLParam("TimeLimit")
+		if err != nil {
+			return nil, err
+		}
+		if remaining < limit {
+			if err := model.SetDBLParam("TimeLimit", remaining); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Stop the solve when ctx is done. The watcher must be gone before the
+	// model is freed, so that it never terminates a freed model.
+	stop := make(chan struct{})
+	var watcher sync.WaitGroup
+	watcher.Add(1)
+	go func() {
+		defer watcher.Done()
+		select {
+		case <-ctx.Done():
+			model.Terminate()
+		case <-stop:
+		}
+	}()
+
+	solveResult, err := model.OptimizeWithResult()
+	close(stop)
+	watcher.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{SolveResult: solveResult}
+	if solveResult.HasSolution() {
+		result.X = make([]float64, len(model.Variables))
+		if err = model.GetDoubleAttrArrayInto(result.X, "X", 0); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+/*
+RunDir
+Description:
+
+	Solves every job bundle in inbox which does not have a result bundle in
+	outbox yet, and writes the result bundles to outbox. Jobs which were
+	already solved are skipped, so RunDir can be called repeatedly (e.g.,
+	whenever new bundles arrive) and can resume an interrupted run. Returns
+	the number of jobs which were solved.
+*/
+func (q *Queue) RunDir(ctx context.Context, inbox string, outbox string) (int, error) {
+	all, err := ReadJobDir(inbox)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(outbox, 0755); err != nil {
+		return 0, err
+	}
+
+	pending := []*Job{}
+	for _, job := range all {
+		if _, err := os.Stat(resultPath(outbox, job.ID)); errors.Is(err, os.ErrNotExist) {
+			pending = append(pending, job)
+		} else if err != nil {
+			return 0, err
+		}
+	}
+
+	jobs := make(chan *Job, len(pending))
+	for _, job := range pending {
+		jobs <- job
+	}
+	close(jobs)
+
+	solved := 0
+	var writeErr error
+	for result := range q.Run(ctx, jobs) {
+		if err := WriteResultFile(outbox, result); err != nil && writeErr == nil {
+			writeErr = err
+		}
+		solved++
+	}
+	if writeErr != nil {
+		return solved, writeErr
+	}
+	return solved, ctx.Err()
+}
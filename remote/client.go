@@ -0,0 +1,148 @@
+package remote
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+client.go
+Description:
+	The client side of remote model mirroring: a thin client builds a model
+	locally, without a Gurobi license, and each change is streamed to a
+	Server which holds the actual model.
+*/
+
+/*
+Client
+Description:
+
+	A connection to a remote model. If Listener is set, it is notified of
+	the events of the remote model after each operation. A Client may be
+	used from several goroutines; its requests are sent one at a time.
+*/
+type Client struct {
+	Listener gurobi.ModelListener
+
+	mu     sync.Mutex
+	conn   io.ReadWriter
+	enc    *json.Encoder
+	dec    *json.Decoder
+	nextID int64
+}
+
+/*
+NewClient
+Description:
+
+	Creates a Client which talks to a Server over conn.
+*/
+func NewClient(conn io.ReadWriter) *Client {
+	return &Client{conn: conn, enc: json.NewEncoder(conn), dec: json.NewDecoder(conn)}
+}
+
+/*
+Dial
+Description:
+
+	Connects to the Server at address (e.g., "tcp", "host:port").
+*/
+func Dial(network string, address string) (*Client, error) {
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(conn), nil
+}
+
+/*
+Close
+Description:
+
+	Closes the connection (if it can be closed), which frees the remote model.
+*/
+func (c *Client) Close() error {
+	if closer, ok := c.conn.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Load replaces the remote model with spec.
+func (c *Client) Load(spec *gurobi.ModelSpec) error {
+	_, err := c.do(Request{Op: OpLoad, Spec: spec})
+	return err
+}
+
+// AddVar adds a variable to the remote model and returns its index.
+func (c *Client) AddVar(vtype gurobi.VarType, obj float64, lb float64, ub float64, name string) (int32, error) {
+	reply, err := c.do(Request{Op: OpAddVar, Var: &gurobi.VarSpec{Name: name, Type: vtype, LB: lb, UB: ub, Obj: obj}})
+	return reply.Index, err
+}
+
+// AddConstr adds the linear constraint sum_i val[i] * x[ind[i]] (sense) rhs to the remote model and returns its index.
+func (c *Client) AddConstr(ind []int32, val []float64, sense gurobi.Sense, rhs float64, name string) (int32, error) {
+	reply, err := c.do(Request{Op: OpAddConstr, Constr: &gurobi.ConstrSpec{Name: name, Ind: ind, Val: val, Sense: sense, RHS: rhs}})
+	return reply.Index, err
+}
+
+// SetVarAttr sets the attribute attr of the remote variable with the given index.
+func (c *Client) SetVarAttr(index int32, attr string, value interface{}) error {
+	_, err := c.do(Request{Op: OpSetVarAttr, Index: index, Name: attr, Value: value})
+	return err
+}
+
+// SetConstrAttr sets the attribute attr of the remote constraint with the given index.
+func (c *Client) SetConstrAttr(index int32, attr string, value interface{}) error {
+	_, err := c.do(Request{Op: OpSetConstrAttr, Index: index, Name: attr, Value: value})
+	return err
+}
+
+// SetModelSense sets the sense of the objective of the remote model.
+func (c *Client) SetModelSense(sense gurobi.ObjSense) error {
+	_, err := c.do(Request{Op: OpSetSense, Sense: sense})
+	return err
+}
+
+// SetParam sets a parameter of the remote model from a string representation of its value.
+func (c *Client) SetParam(name string, value string) error {
+	_, err := c.do(Request{Op: OpSetParam, Name: name, Value: value})
+	return err
+}
+
+// Optimize optimizes the remote model and returns the result.
+func (c *Client) Optimize() (*Result, error) {
+	reply, err := c.do(Request{Op: OpOptimize})
+	return reply.Result, err
+}
+
+// do sends req, waits for its reply and forwards the reply's events to the Listener.
+func (c *Client) do(req Request) (Reply, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.nextID++
+	req.ID = c.nextID
+	if err := c.enc.Encode(req); err != nil {
+		return Reply{}, err
+	}
+
+	var reply Reply
+	if err := c.dec.Decode(&reply); err != nil {
+		return Reply{}, err
+	}
+
+	if c.Listener != nil {
+		for _, event := range reply.Events {
+			c.Listener.ModelChanged(event)
+		}
+	}
+	if reply.Error != "" {
+		return reply, RemoteError{Op: req.Op, Message: reply.Error}
+	}
+	return reply, nil
+}
@@ -0,0 +1,149 @@
+package remote
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+progress.go
+Description:
+	An http.Handler which streams the progress recorded by a gurobi.Monitor
+	as Server-Sent Events, so that a web frontend can show a live solve with
+	a plain EventSource:
+
+		source = new EventSource("/progress")
+		source.addEventListener("progress", e => show(JSON.parse(e.data)))
+		source.addEventListener("done", e => source.close())
+Notes:
+	The handler only reads snapshots of the Monitor, so any number of
+	clients can follow a solve without querying the model.
+*/
+
+// DefaultProgressInterval is the interval at which a ProgressHandler polls its Monitor.
+const DefaultProgressInterval = 500 * time.Millisecond
+
+/*
+ProgressEvent
+Description:
+
+	The data of a progress event. Where is empty until the first callback,
+	and Gap is null while it is infinite (e.g., before the first incumbent
+	is found).
+*/
+type ProgressEvent struct {
+	Where     string   `json:"where"`
+	Time      float64  `json:"time"`
+	Incumbent float64  `json:"incumbent"`
+	Bound     float64  `json:"bound"`
+	Gap       *float64 `json:"gap"`
+	Nodes     float64  `json:"nodes"`
+	Solving   bool     `json:"solving"`
+	Callbacks int      `json:"callbacks"`
+}
+
+// newProgressEvent converts a snapshot into the data of an event.
+func newProgressEvent(snapshot gurobi.MonitorSnapshot) ProgressEvent {
+	event := ProgressEvent{
+		Time:      snapshot.Time,
+		Incumbent: snapshot.Incumbent,
+		Bound:     snapshot.Bound,
+		Nodes:     snapshot.Nodes,
+		Solving:   snapshot.Solving,
+		Callbacks: snapshot.Callbacks,
+	}
+	if snapshot.Callbacks > 0 {
+		event.Where = snapshot.Where.String()
+	}
+	if gap := snapshot.Gap(); !math.IsInf(gap, 0) && !math.IsNaN(gap) {
+		event.Gap = &gap
+	}
+	return event
+}
+
+/*
+ProgressHandler
+Description:
+
+	Streams the snapshots of Monitor as Server-Sent Events. A "progress"
+	event is sent when a client connects and whenever the Monitor has seen
+	new callbacks; a final "done" event is sent (and the stream is closed)
+	once the solve has finished.
+*/
+type ProgressHandler struct {
+	Monitor  *gurobi.Monitor
+	Interval time.Duration
+}
+
+/*
+NewProgressHandler
+Description:
+
+	Creates a ProgressHandler which polls monitor every DefaultProgressInterval.
+*/
+func NewProgressHandler(monitor *gurobi.Monitor) *ProgressHandler {
+	return &ProgressHandler{Monitor: monitor, Interval: DefaultProgressInterval}
+}
+
+func (h *ProgressHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	interval := h.Interval
+	if interval <= 0 {
+		interval = DefaultProgressInterval
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastCallbacks := -1
+	for {
+		snapshot := h.Monitor.Snapshot()
+		finished := snapshot.Callbacks > 0 && !snapshot.Solving
+		if snapshot.Callbacks != lastCallbacks || finished {
+			lastCallbacks = snapshot.Callbacks
+			if err := writeEvent(w, "progress", newProgressEvent(snapshot)); err != nil {
+				return
+			}
+			if finished {
+				if err := writeEvent(w, "done", newProgressEvent(snapshot)); err != nil {
+					return
+				}
+			}
+			flusher.Flush()
+		}
+		if finished {
+			return
+		}
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// writeEvent writes a single Server-Sent Event with the JSON encoding of data.
+func writeEvent(w http.ResponseWriter, name string, data interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %v\ndata: %s\n\n", name, encoded)
+	return err
+}
@@ -0,0 +1,103 @@
+package remote
+
+import (
+	"fmt"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+protocol.go
+Description:
+	The wire format of remote model mirroring. A Client sends Requests, and
+	a Server answers each with a Reply, as newline-delimited JSON over any
+	stream (e.g., a TCP connection). Models are described with the JSON
+	schema of gurobi.ModelSpec (VarSpec, ConstrSpec), and each Reply carries
+	the gurobi.ModelEvents which the operation caused on the server's model
+	(see gurobi.AddListener), so that the client can track the remote model
+	with the same listener API as a local one.
+*/
+
+// OpKind is the operation requested from the server.
+type OpKind string
+
+const (
+	// OpLoad replaces the remote model with Spec.
+	OpLoad OpKind = "load"
+	// OpAddVar adds the variable Var.
+	OpAddVar OpKind = "add_var"
+	// OpAddConstr adds the linear constraint Constr.
+	OpAddConstr OpKind = "add_constr"
+	// OpSetVarAttr sets the attribute Name of the variable Index to Value.
+	OpSetVarAttr OpKind = "set_var_attr"
+	// OpSetConstrAttr sets the attribute Name of the constraint Index to Value.
+	OpSetConstrAttr OpKind = "set_constr_attr"
+	// OpSetSense sets the sense of the objective to Sense.
+	OpSetSense OpKind = "set_sense"
+	// OpSetParam sets the parameter Name to the string Value. Only numeric solver
+	// parameters (e.g., TimeLimit or MIPGap) may be set.
+	OpSetParam OpKind = "set_param"
+	// OpOptimize optimizes the model and returns the Result.
+	OpOptimize OpKind = "optimize"
+)
+
+/*
+Request
+Description:
+
+	An operation on the remote model. Only the fields used by Op are set.
+*/
+type Request struct {
+	ID     int64              `json:"id"`
+	Op     OpKind             `json:"op"`
+	Spec   *gurobi.ModelSpec  `json:"spec,omitempty"`
+	Var    *gurobi.VarSpec    `json:"var,omitempty"`
+	Constr *gurobi.ConstrSpec `json:"constr,omitempty"`
+	Index  int32              `json:"index,omitempty"`
+	Name   string             `json:"name,omitempty"`
+	Value  interface{}        `json:"value,omitempty"`
+	Sense  gurobi.ObjSense    `json:"sense,omitempty"`
+}
+
+/*
+Result
+Description:
+
+	The outcome of a remote optimization. X holds the value of each
+	variable if a solution was found.
+*/
+type Result struct {
+	*gurobi.SolveResult
+	X []float64 `json:"x,omitempty"`
+}
+
+/*
+Reply
+Description:
+
+	The answer to the Request with the same ID. Error is empty if the
+	operation succeeded. Index is the index of an added variable or
+	constraint.
+*/
+type Reply struct {
+	ID     int64               `json:"id"`
+	Error  string              `json:"error,omitempty"`
+	Index  int32               `json:"index,omitempty"`
+	Events []gurobi.ModelEvent `json:"events,omitempty"`
+	Result *Result             `json:"result,omitempty"`
+}
+
+/*
+RemoteError
+Description:
+
+	An error which occurred on the server while executing an operation.
+*/
+type RemoteError struct {
+	Op      OpKind
+	Message string
+}
+
+func (e RemoteError) Error() string {
+	return fmt.Sprintf("remote %v: %v", e.Op, e.Message)
+}
@@ -0,0 +1,310 @@
+package remote
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+server.go
+Description:
+	The server side of remote model mirroring: it runs in the process which
+	holds the Gurobi license, applies the operations of a Client to a real
+	model and sends back the results.
+*/
+
+// allowedParams are the parameters which a client may set. They are all numeric solver
+// settings, so that a client cannot make the server write files (e.g., with LogFile).
+var allowedParams = map[string]bool{
+	// Termination
+	"BarIterLimit": true, "BestBdStop": true, "BestObjStop": true, "Cutoff": true,
+	"IterationLimit": true, "NodeLimit": true, "SolutionLimit": true, "TimeLimit": true,
+	"WorkLimit": true,
+
+	// Tolerances
+	"FeasibilityTol": true, "IntFeasTol": true, "MIPGap": true, "MIPGapAbs": true,
+	"OptimalityTol": true,
+
+	// Algorithms
+	"Crossover": true, "Cuts": true, "Heuristics": true, "Method": true, "MIPFocus": true,
+	"NumericFocus": true, "Presolve": true, "Seed": true, "Threads": true,
+}
+
+/*
+Server
+Description:
+
+	Serves remote models. Each connection has its own model:
+	- If NewEnv is set, each connection also has its own environment, which
+	  is created by NewEnv and freed when the connection is closed.
+	- Otherwise the models are created in Env. As a Gurobi environment
+	  cannot be used by several goroutines at once, the connections then
+	  take turns to apply their operations.
+	- If both are nil, the models are dry-run models (gurobi.NewDryRunModel),
+	  which validate the operations but cannot be optimized.
+*/
+type Server struct {
+	Env    *gurobi.Env
+	NewEnv func() (*gurobi.Env, error)
+
+	envMu sync.Mutex
+}
+
+/*
+Serve
+Description:
+
+	Accepts connections from l and serves each of them in its own goroutine
+	until l is closed.
+*/
+func (s *Server) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			s.ServeConn(conn)
+		}()
+	}
+}
+
+/*
+ServeConn
+Description:
+
+	Answers the requests read from conn until it is closed, and frees the
+	connection's model (and environment) afterwards. Returns nil when conn
+	reaches EOF.
+*/
+func (s *Server) ServeConn(conn io.ReadWriter) error {
+	session := &session{server: s, env: s.Env}
+	if s.NewEnv != nil {
+		env, err := s.NewEnv()
+		if err != nil {
+			return err
+		}
+		defer env.Free()
+		session.env = env
+	}
+	defer func() {
+		unlock := session.lock()
+		session.free()
+		unlock()
+	}()
+
+	dec := json.NewDecoder(conn)
+	enc := json.NewEncoder(conn)
+	for {
+		var req Request
+		if err := dec.Decode(&req); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		unlock := session.lock()
+		reply := session.handle(req)
+		unlock()
+		if err := enc.Encode(reply); err != nil {
+			return err
+		}
+	}
+}
+
+// session is the environment and model of one connection and the events it emitted for the current request.
+type session struct {
+	server *Server
+	env    *gurobi.Env
+	model  *gurobi.Model
+	events []gurobi.ModelEvent
+}
+
+// lock waits for the session's turn on a shared environment and returns the function which ends it.
+func (s *session) lock() func() {
+	if s.env == nil || s.env != s.server.Env {
+		return func() {}
+	}
+	s.server.envMu.Lock()
+	return s.server.envMu.Unlock
+}
+
+func (s *session) handle(req Request) Reply {
+	s.events = nil
+	reply := Reply{ID: req.ID}
+
+	var err error
+	switch req.Op {
+	case OpLoad:
+		err = s.load(req.Spec)
+	case OpAddVar:
+		reply.Index, err = s.addVar(req.Var)
+	case OpAddConstr:
+		reply.Index, err = s.addConstr(req.Constr)
+	case OpSetVarAttr:
+		err = s.withModel(func(model *gurobi.Model) error {
+			return (&gurobi.Var{Model: model, Index: req.Index}).SetAttr(req.Name, req.Value)
+		})
+	case OpSetConstrAttr:
+		err = s.withModel(func(model *gurobi.Model) error {
+			return (&gurobi.Constr{Model: model, Index: req.Index}).SetAttr(req.Name, req.Value)
+		})
+	case OpSetSense:
+		err = s.withModel(func(model *gurobi.Model) error {
+			return model.SetModelSense(req.Sense)
+		})
+	case OpSetParam:
+		err = s.setParam(req.Name, fmt.Sprint(req.Value))
+	case OpOptimize:
+		reply.Result, err = s.optimize()
+	default:
+		err = fmt.Errorf("unknown operation %q", req.Op)
+	}
+
+	if err != nil {
+		reply.Error = err.Error()
+	}
+	reply.Events = s.events
+	return reply
+}
+
+// newModel creates an empty model (a dry-run model if the session has no environment).
+func (s *session) newModel(name string) (*gurobi.Model, error) {
+	if s.env == nil {
+		return gurobi.NewDryRunModel(name), nil
+	}
+	return gurobi.NewModel(name, s.env)
+}
+
+// withModel calls fn with the session's model, creating an empty one first if needed.
+func (s *session) withModel(fn func(model *gurobi.Model) error) error {
+	if s.model == nil {
+		model, err := s.newModel("remote")
+		if err != nil {
+			return err
+		}
+		s.attach(model)
+	}
+	return fn(s.model)
+}
+
+// attach makes model the session's model and records its events.
+func (s *session) attach(model *gurobi.Model) {
+	s.free()
+	s.model = model
+	model.AddListener(gurobi.ModelListenerFunc(func(event gurobi.ModelEvent) {
+		s.events = append(s.events, event)
+	}))
+}
+
+func (s *session) free() {
+	if s.model != nil {
+		s.model.Free()
+		s.model = nil
+	}
+}
+
+func (s *session) load(spec *gurobi.ModelSpec) error {
+	if spec == nil {
+		return errors.New("the load operation requires a spec")
+	}
+
+	if s.env != nil {
+		model, err := spec.Compile(s.env)
+		if err != nil {
+			return err
+		}
+		s.attach(model)
+		return nil
+	}
+
+	// A dry-run model cannot be compiled, so the spec is added to an empty one.
+	model, err := s.newModel(spec.Name)
+	if err != nil {
+		return err
+	}
+	s.attach(model)
+	return spec.Build(model)
+}
+
+func (s *session) setParam(name string, value string) error {
+	canonical, _, ok := gurobi.LookupParam(name)
+	if !ok || !allowedParams[canonical] {
+		return fmt.Errorf("a remote client may not set the parameter %v", name)
+	}
+	return s.withModel(func(model *gurobi.Model) error {
+		return model.SetParam(canonical, value)
+	})
+}
+
+func (s *session) addVar(v *gurobi.VarSpec) (int32, error) {
+	if v == nil {
+		return 0, errors.New("the add_var operation requires a var")
+	}
+
+	var index int32
+	err := s.withModel(func(model *gurobi.Model) error {
+		added, err := model.AddVar(v.Type, v.Obj, v.LB, v.UB, v.Name, []*gurobi.Constr{}, []float64{})
+		if err != nil {
+			return err
+		}
+		index = added.Index
+		return nil
+	})
+	return index, err
+}
+
+func (s *session) addConstr(c *gurobi.ConstrSpec) (int32, error) {
+	if c == nil {
+		return 0, errors.New("the add_constr operation requires a constr")
+	}
+
+	var index int32
+	err := s.withModel(func(model *gurobi.Model) error {
+		vars := make([]*gurobi.Var, len(c.Ind))
+		for i, j := range c.Ind {
+			vars[i] = &gurobi.Var{Model: model, Index: j}
+		}
+		added, err := model.AddConstr(vars, c.Val, c.Sense, c.RHS, c.Name)
+		if err != nil {
+			return err
+		}
+		index = added.Index
+		return nil
+	})
+	return index, err
+}
+
+func (s *session) optimize() (*Result, error) {
+	if s.model == nil {
+		return nil, errors.New("there is no model to optimize")
+	}
+
+	solveResult, err := s.model.OptimizeWithResult()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{SolveResult: solveResult}
+	if solveResult.HasSolution() {
+		vars := make([]*gurobi.Var, solveResult.NumVars)
+		for i := range vars {
+			vars[i] = &gurobi.Var{Model: s.model, Index: int32(i)}
+		}
+		if result.X, err = s.model.GetDoubleAttrVars("X", vars); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package bench_test
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/bench"
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+bench_test.go
+Description:
+	Tests the benchmark harness.
+*/
+
+/*
+TestHarness_Check1
+Description:
+
+	Verifies that Check() rejects empty harnesses and repeated configuration names.
+*/
+func TestHarness_Check1(t *testing.T) {
+	// Constants
+	h1 := bench.Harness{}
+	h2 := bench.Harness{
+		ModelFiles: []string{"a.lp"},
+		Configs:    []bench.Config{{Name: "default"}, {Name: "default"}},
+		Seeds:      []int{0},
+	}
+
+	// Test
+	if err := h1.Check(); err == nil {
+		t.Errorf("expected an error for an empty harness, but none were thrown!")
+	}
+
+	if err := h2.Check(); err == nil {
+		t.Errorf("expected an error for repeated configuration names, but none were thrown!")
+	}
+}
+
+/*
+TestSummarize1
+Description:
+
+	Verifies the aggregation of runs over seeds and the resulting table.
+*/
+func TestSummarize1(t *testing.T) {
+	// Constants
+	runs := []bench.Run{
+		{Model: "a.lp", Config: "fast", Seed: 0, Status: gurobi.StatusOptimal, Runtime: 1, MIPGap: 0, NodeCount: 10},
+		{Model: "a.lp", Config: "fast", Seed: 1, Status: gurobi.StatusOptimal, Runtime: 3, MIPGap: 0, NodeCount: 30},
+		{Model: "a.lp", Config: "default", Seed: 0, Err: errors.New("failed"), Runtime: math.NaN()},
+	}
+
+	// Algorithm
+	summaries := bench.Summarize(runs)
+
+	// Test
+	if len(summaries) != 2 || summaries[0].Config != "fast" {
+		t.Errorf("unexpected summaries: %+v", summaries)
+	}
+
+	if summaries[0].MeanRuntime != 2 || summaries[0].MeanNodeCount != 20 || summaries[0].Optimal != 2 {
+		t.Errorf("unexpected summary: %+v", summaries[0])
+	}
+
+	if summaries[1].Failed != 1 || !math.IsNaN(summaries[1].MeanRuntime) {
+		t.Errorf("unexpected summary: %+v", summaries[1])
+	}
+
+	var buf bytes.Buffer
+	if err := bench.WriteTable(&buf, summaries); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(buf.String(), "MODEL") || strings.Count(buf.String(), "\n") != 3 {
+		t.Errorf("unexpected table:\n%v", buf.String())
+	}
+}
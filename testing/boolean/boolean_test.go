@@ -0,0 +1,148 @@
+package boolean_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/boolean"
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+boolean_test.go
+Description:
+	Tests the boolean formula layer.
+*/
+
+/*
+TestFormula_String1
+Description:
+
+	Verifies that String() parenthesizes nested formulas.
+*/
+func TestFormula_String1(t *testing.T) {
+	// Constants
+	x := &gurobi.Var{Index: 0}
+	y := &gurobi.Var{Index: 1}
+	z := &gurobi.Var{Index: 2}
+
+	// Algorithm
+	f := boolean.Implies(
+		boolean.And(boolean.Lit(x), boolean.Not(boolean.Lit(y))),
+		boolean.Xor(boolean.Lit(y), boolean.Lit(z)),
+	)
+
+	// Test
+	expected := "(x0 AND NOT x1) => (x1 XOR x2)"
+	if f.String() != expected {
+		t.Errorf("expected %v; received %v", expected, f.String())
+	}
+}
+
+/*
+TestFormula_Check1
+Description:
+
+	Verifies that Check() rejects empty conjunctions and invalid literals.
+*/
+func TestFormula_Check1(t *testing.T) {
+	// Constants
+	x := &gurobi.Var{Index: 0}
+
+	// Test
+	if err := boolean.And().Check(); err == nil {
+		t.Errorf("expected an error for an empty And, but none were thrown!")
+	}
+
+	if err := boolean.Or(boolean.Lit(x), boolean.Lit(nil)).Check(); err == nil {
+		t.Errorf("expected an error for a nil literal, but none were thrown!")
+	}
+
+	if err := boolean.Not(boolean.Lit(x)).Check(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+/*
+TestCompiler_Assert1
+Description:
+
+	Verifies that Assert() throws an error when the compiler's model is not initialized.
+*/
+func TestCompiler_Assert1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+	c := boolean.NewCompiler(model0)
+
+	// Test
+	if err := c.Assert(boolean.Lit(&gurobi.Var{Index: 0})); err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
+
+/*
+TestCompiler_Assert2
+Description:
+
+	Asserts (x OR y) AND NOT x with both encodings, and with Tseitin auxiliaries,
+	and verifies that the optimal solution has x = 0 and y = 1.
+*/
+func TestCompiler_Assert2(t *testing.T) {
+	for _, tseitin := range []bool{false, true} {
+		for _, encoding := range []boolean.Encoding{boolean.LinearEncoding, boolean.GeneralEncoding} {
+			// Create environment.
+			env, err := gurobi.NewEnv("boolean2.log")
+			if err != nil {
+				t.Errorf("There was an issue creating the new Env: %v", err)
+			}
+			defer env.Free()
+			defer os.Remove("boolean2.log")
+
+			// Create an empty model.
+			model, err := gurobi.NewModel("boolean2", env)
+			if err != nil {
+				t.Errorf("There was an issue creating the new model: %v", err)
+			}
+			defer model.Free()
+
+			x, err := model.AddVar(gurobi.Binary, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+			if err != nil {
+				t.Errorf("There was an issue adding a variable to the model: %v", err)
+			}
+			y, err := model.AddVar(gurobi.Binary, 1.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+			if err != nil {
+				t.Errorf("There was an issue adding a variable to the model: %v", err)
+			}
+
+			// Algorithm
+			c := boolean.NewCompiler(model)
+			c.Encoding = encoding
+			c.Tseitin = tseitin
+			err = c.Assert(boolean.And(
+				boolean.Or(boolean.Lit(x), boolean.Lit(y)),
+				boolean.Not(boolean.Lit(x)),
+			))
+			if err != nil {
+				t.Errorf("unexpected error asserting the formula: %v", err)
+			}
+
+			if err := model.Optimize(); err != nil {
+				t.Errorf("There was an issue optimizing the model: %v", err)
+			}
+
+			// Test
+			xVal, err := x.GetDouble(gurobi.DBL_ATTR_X)
+			if err != nil {
+				t.Errorf("unexpected error retrieving x: %v", err)
+			}
+			yVal, err := y.GetDouble(gurobi.DBL_ATTR_X)
+			if err != nil {
+				t.Errorf("unexpected error retrieving y: %v", err)
+			}
+
+			if xVal != 0.0 || yVal != 1.0 {
+				t.Errorf("(tseitin=%v, encoding=%v) expected x = 0, y = 1; received x = %v, y = %v", tseitin, encoding, xVal, yVal)
+			}
+		}
+	}
+}
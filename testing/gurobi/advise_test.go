@@ -0,0 +1,94 @@
+package gurobi_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+advise_test.go
+Description:
+	Tests the structural parameter advisor.
+*/
+
+/*
+TestLinearData_Advise1
+Description:
+
+	Tests that identical binary columns and big-M coefficients are detected
+	and lead to Symmetry and Aggregate suggestions.
+*/
+func TestLinearData_Advise1(t *testing.T) {
+	// Constants
+	// x0 + x1 + x2 <= 1, y - 1e5 x2 <= 0
+	data := &gurobi.LinearData{
+		Beg:    []int32{0, 3, 5},
+		Ind:    []int32{0, 1, 2, 3, 2},
+		Val:    []float64{1, 1, 1, 1, -1e5},
+		Senses: []gurobi.Sense{gurobi.Le, gurobi.Le},
+		RHS:    []float64{1, 0},
+		LB:     []float64{0, 0, 0, 0},
+		UB:     []float64{1, 1, 1, 100},
+		VTypes: []gurobi.VarType{gurobi.Binary, gurobi.Binary, gurobi.Binary, gurobi.Continuous},
+	}
+
+	// Algorithm
+	advice := data.Advise([]float64{1, 1, 1, 0})
+
+	// Test
+	if advice.IdenticalColumns != 1 || advice.BigMRows != 1 || advice.DenseRows != 0 {
+		t.Errorf("unexpected findings: %+v", advice)
+	}
+
+	params := map[string]int{}
+	for _, s := range advice.Suggestions {
+		params[s.Param] = s.Value
+	}
+	if len(params) != 2 || params["Symmetry"] != 2 || params["Aggregate"] != 0 {
+		t.Errorf("unexpected suggestions: %+v", advice.Suggestions)
+	}
+}
+
+/*
+TestLinearData_Advise2
+Description:
+
+	Tests that PreDual is suggested for an LP with many more rows than columns.
+*/
+func TestLinearData_Advise2(t *testing.T) {
+	// Constants
+	// x0 >= 1, x1 >= 1, x0 + x1 >= 3, x0 - x1 <= 2, x1 - x0 <= 2
+	data := &gurobi.LinearData{
+		Beg:    []int32{0, 1, 2, 4, 6, 8},
+		Ind:    []int32{0, 1, 0, 1, 0, 1, 0, 1},
+		Val:    []float64{1, 1, 1, 1, 1, -1, -1, 1},
+		Senses: []gurobi.Sense{gurobi.Ge, gurobi.Ge, gurobi.Ge, gurobi.Le, gurobi.Le},
+		RHS:    []float64{1, 1, 3, 2, 2},
+		LB:     []float64{0, 0},
+		UB:     []float64{10, 10},
+		VTypes: []gurobi.VarType{gurobi.Continuous, gurobi.Continuous},
+	}
+
+	// Algorithm
+	advice := data.Advise([]float64{1, 2})
+
+	// Test
+	if len(advice.Suggestions) != 1 || advice.Suggestions[0].Param != "PreDual" || advice.Suggestions[0].Value != 1 {
+		t.Errorf("unexpected suggestions: %+v", advice.Suggestions)
+	}
+}
+
+/*
+TestModel_Advise1
+Description:
+
+	Tests that a dry-run model cannot be analyzed.
+*/
+func TestModel_Advise1(t *testing.T) {
+	// Test
+	if _, err := gurobi.NewDryRunModel("advise1").Advise(); !errors.Is(err, gurobi.ErrDryRun) {
+		t.Errorf("expected ErrDryRun; received %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package gurobi_test
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+anonymize_test.go
+Description:
+	Tests the anonymized export of models.
+*/
+
+/*
+TestModel_Anonymize1
+Description:
+
+	Tests that the written model does not contain the original names and
+	that the original model is unchanged.
+*/
+func TestModel_Anonymize1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("anonymize1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("anonymize1.log")
+
+	model, err := gurobi.NewModel("secret_model", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 3.0, 0.0, 10.0, "secret_var", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable: %v", err)
+	}
+	if _, err := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.Ge, 1.0, "secret_constr"); err != nil {
+		t.Errorf("There was an issue adding a constraint: %v", err)
+	}
+
+	// Algorithm
+	err = model.Anonymize("anonymize1.mps", gurobi.AnonymizeOptions{Perturbation: 0.1, Seed: 1})
+	if err != nil {
+		t.Errorf("There was an issue anonymizing the model: %v", err)
+	}
+	defer os.Remove("anonymize1.mps")
+
+	// Test
+	contents, err := os.ReadFile("anonymize1.mps")
+	if err != nil {
+		t.Fatalf("There was an issue reading the anonymized model: %v", err)
+	}
+	if strings.Contains(string(contents), "secret") {
+		t.Errorf("the anonymized model contains an original name:\n%s", contents)
+	}
+
+	name, err := x.GetAttr("VarName")
+	if err != nil || name != "secret_var" {
+		t.Errorf("expected the original model to keep its names; received %v (%v)", name, err)
+	}
+}
+
+/*
+TestModel_Anonymize2
+Description:
+
+	Tests that the file extension and perturbation are validated, and that a
+	dry-run model cannot be anonymized.
+*/
+func TestModel_Anonymize2(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("anonymize2")
+
+	// Test
+	if err := model.Anonymize("anonymize2.lp", gurobi.AnonymizeOptions{}); err == nil {
+		t.Errorf("expected an error for a file which is not .mps")
+	}
+	if err := model.Anonymize("anonymize2.mps", gurobi.AnonymizeOptions{Perturbation: 1.5}); err == nil {
+		t.Errorf("expected an error for a perturbation of 1.5")
+	}
+	if err := model.Anonymize("anonymize2.mps.gz", gurobi.AnonymizeOptions{}); !errors.Is(err, gurobi.ErrDryRun) {
+		t.Errorf("expected ErrDryRun; received %v", err)
+	}
+}
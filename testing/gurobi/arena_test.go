@@ -0,0 +1,65 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+arena_test.go
+Description:
+	Tests that the Var and Constr handles of a model stay valid as it grows.
+*/
+
+/*
+TestModel_Handles1
+Description:
+
+	Adds a variable and a constraint, grows the model past several storage
+	chunks and verifies that the first handles still match the items stored
+	in the model.
+*/
+func TestModel_Handles1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("handles1")
+	defer model.Free()
+	numVars := 10000
+
+	x, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	c, err := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.Le, 1.0, "c")
+	if err != nil {
+		t.Errorf("There was an issue adding c: %v", err)
+	}
+
+	// Algorithm
+	vars, err := model.AddVarsWithTypes(numVars, gurobi.Binary)
+	if err != nil {
+		t.Errorf("There was an issue adding the variables: %v", err)
+	}
+	for _, v := range vars[:100] {
+		if _, err := model.AddConstr([]*gurobi.Var{x, v}, []float64{1.0, 1.0}, gurobi.Le, 1.0, "link"); err != nil {
+			t.Errorf("There was an issue adding a constraint: %v", err)
+		}
+	}
+
+	// Test
+	if len(model.Variables) != numVars+1 || len(model.Constraints) != 101 {
+		t.Errorf("expected %v variables and 101 constraints; received %v and %v", numVars+1, len(model.Variables), len(model.Constraints))
+	}
+	if model.Variables[0] != *x || model.Constraints[0] != *c {
+		t.Errorf("expected the first handles to be stored in the model")
+	}
+	for i, v := range model.Variables {
+		if v.Index != int32(i) {
+			t.Errorf("expected variable %v to have the index %v; received %v", i, i, v.Index)
+			break
+		}
+	}
+	if model.Variables[numVars] != *vars[numVars-1] {
+		t.Errorf("expected the last handle to be stored in the model")
+	}
+}
@@ -0,0 +1,88 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+attrcache_test.go
+Description:
+	Tests the optional attribute cache of a model.
+*/
+
+/*
+TestModel_SetAttrCache1
+Description:
+
+	Verifies that a model has no cache statistics unless the cache is
+	enabled, and that disabling the cache drops them.
+*/
+func TestModel_SetAttrCache1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("attrcache1")
+	defer model.Free()
+
+	// Algorithm
+	model.SetAttrCache(true)
+	model.SetAttrCache(false)
+
+	// Test
+	if hits, misses := model.AttrCacheStats(); hits != 0 || misses != 0 {
+		t.Errorf("expected no cache statistics; received %v hits and %v misses", hits, misses)
+	}
+}
+
+/*
+TestModel_SetAttrCache2
+Description:
+
+	Reads NumVars and a variable name twice with the cache enabled and
+	checks that the second reads are answered by the cache, and that
+	adding a variable (which updates the model) clears it.
+*/
+func TestModel_SetAttrCache2(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("attrcache2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("attrcache2.log")
+
+	model, err := gurobi.NewModel("attrcache2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	model.SetAttrCache(true)
+
+	// Algorithm
+	for i := 0; i < 2; i++ {
+		if numVars, err := model.NumVars(); err != nil || numVars != 1 {
+			t.Errorf("expected 1 variable; received %v (%v)", numVars, err)
+		}
+		if name, err := x.GetString("VarName"); err != nil || name != "x" {
+			t.Errorf("expected the name x; received %q (%v)", name, err)
+		}
+	}
+	if _, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{}); err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+	numVars, err := model.NumVars()
+
+	// Test
+	if hits, misses := model.AttrCacheStats(); hits != 2 || misses != 3 {
+		t.Errorf("expected 2 hits and 3 misses; received %v and %v", hits, misses)
+	}
+	if err != nil || numVars != 2 {
+		t.Errorf("expected 2 variables after the update; received %v (%v)", numVars, err)
+	}
+}
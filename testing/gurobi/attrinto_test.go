@@ -0,0 +1,106 @@
+package gurobi_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+attrinto_test.go
+Description:
+	Tests the attribute getters which write into a buffer supplied by the caller.
+*/
+
+/*
+TestModel_GetDoubleAttrVarsInto1
+Description:
+
+	Verifies that the buffer must match the variables and that a dry-run
+	model returns ErrDryRun.
+*/
+func TestModel_GetDoubleAttrVarsInto1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("into1")
+	defer model.Free()
+
+	vars, err := model.AddVarsWithTypes(3, gurobi.Continuous)
+	if err != nil {
+		t.Errorf("There was an issue adding the variables: %v", err)
+	}
+
+	// Test
+	if err := model.GetDoubleAttrVarsInto(make([]float64, 3), gurobi.DBL_ATTR_X, vars); !errors.Is(err, gurobi.ErrDryRun) {
+		t.Errorf("expected ErrDryRun; received %v", err)
+	}
+	if err := model.GetDoubleAttrArrayInto(make([]float64, 3), gurobi.DBL_ATTR_X, 0); !errors.Is(err, gurobi.ErrDryRun) {
+		t.Errorf("expected ErrDryRun; received %v", err)
+	}
+}
+
+/*
+TestModel_GetDoubleAttrVarsInto2
+Description:
+
+	Solves a small LP and reads X and RC into preallocated buffers, checking
+	the values and that the reads do not allocate.
+*/
+func TestModel_GetDoubleAttrVarsInto2(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("into2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("into2.log")
+
+	model, err := gurobi.NewModel("into2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	// minimize x + 2y s.t. x + y >= 1
+	x, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	y, err := model.AddVar(gurobi.Continuous, 2.0, 0.0, 10.0, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+	if _, err := model.AddConstr([]*gurobi.Var{x, y}, []float64{1.0, 1.0}, gurobi.Ge, 1.0, "cover"); err != nil {
+		t.Errorf("There was an issue adding the constraint: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing: %v", err)
+	}
+
+	// Algorithm
+	vars := []*gurobi.Var{y, x}
+	values := make([]float64, 2)
+	rc := make([]float64, 2)
+	if err := model.GetDoubleAttrVarsInto(values, gurobi.DBL_ATTR_X, vars); err != nil {
+		t.Errorf("There was an issue reading X: %v", err)
+	}
+	if err := model.GetDoubleAttrArrayInto(rc, "RC", 0); err != nil {
+		t.Errorf("There was an issue reading RC: %v", err)
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		model.GetDoubleAttrVarsInto(values, gurobi.DBL_ATTR_X, vars)
+		model.GetDoubleAttrArrayInto(rc, "RC", 0)
+	})
+
+	// Test
+	if values[0] != 0.0 || values[1] != 1.0 {
+		t.Errorf("expected y = 0 and x = 1; received %v", values)
+	}
+	if rc[0] != 0.0 || rc[1] != 1.0 {
+		t.Errorf("expected the reduced costs 0 and 1; received %v", rc)
+	}
+	if allocs != 0 {
+		t.Errorf("expected no allocations; received %v per run", allocs)
+	}
+}
@@ -0,0 +1,74 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+autodump_test.go
+Description:
+	Tests the automatic dump of models after failed optimizations.
+*/
+
+/*
+TestModel_EnableAutoDump1
+Description:
+
+	Verifies that EnableAutoDump() throws an error for an uninitialized model.
+*/
+func TestModel_EnableAutoDump1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Test
+	if _, err := model0.EnableAutoDump(t.TempDir()); err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
+
+/*
+TestModel_DumpArtifacts1
+Description:
+
+	Verifies that an infeasible model is dumped to the configured directory.
+*/
+func TestModel_DumpArtifacts1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+
+	model, err := gurobi.NewModel("dump1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	config, err := model.EnableAutoDump(t.TempDir())
+	if err != nil {
+		t.Errorf("unexpected error enabling auto dump: %v", err)
+	}
+
+	// x >= 2 and x <= 1
+	x, err := model.AddVar(gurobi.Continuous, 0.0, 2.0, gurobi.INFINITY, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	if _, err := model.AddConstr([]*gurobi.Var{x}, []float64{1}, gurobi.Le, 1.0, "c0"); err != nil {
+		t.Errorf("There was an issue adding a constraint to the model: %v", err)
+	}
+
+	// Algorithm
+	if err := model.Optimize(); err != nil {
+		t.Errorf("unexpected error optimizing the model: %v", err)
+	}
+
+	// Test
+	if config.LastDump == "" {
+		t.Errorf("expected the infeasible model to be dumped")
+	}
+}
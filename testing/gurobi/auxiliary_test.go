@@ -0,0 +1,86 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+auxiliary_test.go
+Description:
+	Tests the Abs, Min and Max expression helpers of the gurobi package.
+*/
+
+/*
+TestModel_Abs1
+Description:
+
+	Minimizes |x - 4| with x in [0, 10] and verifies that x = 4 and the objective is 0.
+*/
+func TestModel_Abs1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("abs1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("abs1.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("abs1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+
+	// Algorithm
+	expr := gurobi.LinExpr{}
+	expr.AddTerm(x, 1.0).AddConstant(-4.0)
+	absVar, err := model.Abs(&expr, "dist")
+	if err != nil {
+		t.Errorf("unexpected error creating |x - 4|: %v", err)
+	}
+
+	obj := gurobi.LinExpr{}
+	obj.AddTerm(absVar, 1.0)
+	if err := model.SetObjective(&obj, gurobi.Minimize); err != nil {
+		t.Errorf("unexpected error setting the objective: %v", err)
+	}
+
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+
+	// Test
+	xVal, err := x.GetDouble(gurobi.DBL_ATTR_X)
+	if err != nil {
+		t.Errorf("unexpected error retrieving x: %v", err)
+	}
+
+	if xVal != 4.0 {
+		t.Errorf("expected x = %v; received %v", 4.0, xVal)
+	}
+}
+
+/*
+TestModel_Max1
+Description:
+
+	Verifies that Max() requires at least one expression.
+*/
+func TestModel_Max1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Test
+	if _, err := model0.Max("m"); err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
@@ -0,0 +1,79 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+barrier_test.go
+Description:
+	Tests the BarrierConfig type of the gurobi package.
+*/
+
+/*
+TestBarrierConfig_Check1
+Description:
+
+	Tests that the default configuration is valid.
+*/
+func TestBarrierConfig_Check1(t *testing.T) {
+	if err := gurobi.DefaultBarrierConfig().Check(); err != nil {
+		t.Errorf("unexpected error for the default configuration: %v", err)
+	}
+}
+
+/*
+TestBarrierConfig_Check2
+Description:
+
+	Tests that an invalid CrossoverBasis is rejected.
+*/
+func TestBarrierConfig_Check2(t *testing.T) {
+	// Constants
+	config := gurobi.DefaultBarrierConfig()
+	config.CrossoverBasis = 2
+
+	// Test
+	if err := config.Check(); err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
+
+/*
+TestEnv_ApplyBarrierConfig1
+Description:
+
+	Tests that a configuration can be applied to an environment and read back.
+*/
+func TestEnv_ApplyBarrierConfig1(t *testing.T) {
+	// Constants
+	config := gurobi.BarrierConfig{
+		BarConvTol:     1e-6,
+		BarIterLimit:   50,
+		Crossover:      gurobi.CrossoverOff,
+		CrossoverBasis: 1,
+		BarHomogeneous: 1,
+	}
+
+	env, err := gurobi.NewEnv("thomTide.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env variable: %v", err)
+	}
+	defer env.Free()
+
+	// Test
+	if err := env.ApplyBarrierConfig(config); err != nil {
+		t.Errorf("unexpected error applying the configuration: %v", err)
+	}
+
+	detected, err := env.GetBarrierConfig()
+	if err != nil {
+		t.Errorf("unexpected error reading the configuration: %v", err)
+	}
+
+	if detected != config {
+		t.Errorf("expected configuration %+v; received %+v", config, detected)
+	}
+}
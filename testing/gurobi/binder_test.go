@@ -0,0 +1,142 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+binder_test.go
+Description:
+	Tests binding struct fields to variables with grb tags.
+*/
+
+type bindPlant struct {
+	Name   string
+	Open   bool       `grb:"obj=10"`
+	Output float64    `grb:"lb=0,ub=100,obj=-1,name=output_{index}"`
+	Shifts int        `grb:"ub=3"`
+	Levels [2]float64 `grb:"ub=inf"`
+	Note   float64    `grb:"-"`
+}
+
+/*
+TestModel_Bind1
+Description:
+
+	Binds a slice of two plants and checks that every tagged field (and
+	every element of the array field) got its own variable.
+*/
+func TestModel_Bind1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("bind1")
+	plants := []bindPlant{{Name: "north"}, {Name: "south"}}
+
+	// Algorithm
+	binding, err := model.Bind(plants)
+	if err != nil {
+		t.Errorf("There was an issue binding the plants: %v", err)
+	}
+
+	// Test
+	if len(binding.Vars()) != 10 || len(model.Variables) != 10 {
+		t.Errorf("expected 5 variables per plant; received %v", len(binding.Vars()))
+	}
+
+	v, err := binding.Var(&plants[1].Levels[1])
+	if err != nil || v.Index != 9 {
+		t.Errorf("expected the last level of the second plant to be variable 9; received %v (%v)", v, err)
+	}
+	if _, err := binding.Var(&plants[0].Note); err == nil {
+		t.Errorf("expected an error for an unbound field, but none were thrown!")
+	}
+}
+
+/*
+TestModel_Bind2
+Description:
+
+	Verifies that invalid targets and tags are rejected.
+*/
+func TestModel_Bind2(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("bind2")
+	type continuousInt struct {
+		N int `grb:"type=C"`
+	}
+	type unknownKey struct {
+		X float64 `grb:"lb=0,step=2"`
+	}
+	type untagged struct {
+		X float64
+	}
+
+	// Algorithm
+	targets := map[string]interface{}{
+		"a struct value":                      bindPlant{},
+		"an int field with a continuous type": &continuousInt{},
+		"an unknown tag key":                  &unknownKey{},
+		"a struct without tags":               &untagged{},
+	}
+
+	// Test
+	for name, target := range targets {
+		if _, err := model.Bind(target); err == nil {
+			t.Errorf("expected an error for %v, but none were thrown!", name)
+		}
+	}
+	if len(model.Variables) != 0 {
+		t.Errorf("expected no variables to be added; received %v", len(model.Variables))
+	}
+}
+
+/*
+TestBinding_Load1
+Description:
+
+	Solves a model built from a bound plant and checks that the solution is
+	written back into its fields.
+*/
+func TestBinding_Load1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("bind3.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("bind3.log")
+
+	model, err := gurobi.NewModel("bind3", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	plant := &bindPlant{Name: "north"}
+	binding, err := model.Bind(plant)
+	if err != nil {
+		t.Errorf("There was an issue binding the plant: %v", err)
+	}
+
+	// Output <= 40 * Shifts
+	output, _ := binding.Var(&plant.Output)
+	shifts, _ := binding.Var(&plant.Shifts)
+	if _, err := model.AddConstr([]*gurobi.Var{output, shifts}, []float64{1.0, -40.0}, gurobi.Le, 0.0, "capacity"); err != nil {
+		t.Errorf("There was an issue adding the capacity: %v", err)
+	}
+
+	// Algorithm
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing: %v", err)
+	}
+	if err := binding.Load(); err != nil {
+		t.Errorf("There was an issue loading the solution: %v", err)
+	}
+
+	// Test
+	if plant.Output != 100.0 || plant.Shifts != 3 || plant.Open {
+		t.Errorf("unexpected solution: %+v", plant)
+	}
+}
@@ -0,0 +1,706 @@
+package gurobi_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+callback_test.go
+Description:
+	Tests the callback functions of the Model object.
+*/
+
+/*
+TestWhere_String1
+Description:
+
+	Tests that the where codes have the names used in Gurobi's documentation.
+*/
+func TestWhere_String1(t *testing.T) {
+	if gurobi.WhereMIPSol.String() != "MIPSOL" {
+		t.Errorf("unexpected name for WhereMIPSol: %v", gurobi.WhereMIPSol)
+	}
+
+	if gurobi.Where(42).String() != "Where(42)" {
+		t.Errorf("unexpected name for an unknown where code: %v", gurobi.Where(42))
+	}
+}
+
+/*
+TestModel_SetCallback1
+Description:
+
+	Verifies that SetCallback() returns an error when called on a nil model.
+*/
+func TestModel_SetCallback1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Test
+	err := model0.SetCallback(func(cb *gurobi.CallbackContext) error { return nil })
+	if err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
+
+/*
+TestModel_SetCallback2
+Description:
+
+	Verifies that an error returned from the callback stops the optimization
+	and is returned by Optimize().
+*/
+func TestModel_SetCallback2(t *testing.T) {
+	// Constants
+	callbackErr := errors.New("stop right there")
+
+	// Create environment.
+	env, err := gurobi.NewEnv("setcallback2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("setcallback2.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("setcallback2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	if _, err = model.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{}); err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+
+	// Test
+	err = model.SetCallback(func(cb *gurobi.CallbackContext) error {
+		return callbackErr
+	})
+	if err != nil {
+		t.Errorf("unexpected error setting the callback: %v", err)
+	}
+
+	err = model.Optimize()
+	if !errors.Is(err, callbackErr) {
+		t.Errorf("expected the callback's error to be returned by Optimize(); received %v", err)
+	}
+}
+
+/*
+TestCallbackContext_CanCall1
+Description:
+
+	Verifies that the CallbackContext methods are only available for their
+	where codes and return a CallbackWhereError otherwise.
+*/
+func TestCallbackContext_CanCall1(t *testing.T) {
+	cb := &gurobi.CallbackContext{Where: gurobi.WhereMIP}
+
+	if !cb.CanCall("MIPProgress") {
+		t.Errorf("expected MIPProgress to be available in the MIP callback")
+	}
+	if cb.CanCall("AddLazy") {
+		t.Errorf("expected AddLazy to not be available in the MIP callback")
+	}
+
+	_, err := cb.Message()
+	var whereErr gurobi.CallbackWhereError
+	if !errors.As(err, &whereErr) {
+		t.Fatalf("expected a CallbackWhereError; received %v", err)
+	}
+	if whereErr.Where != gurobi.WhereMIP || whereErr.Operation != "Message" {
+		t.Errorf("unexpected error: %+v", whereErr)
+	}
+}
+
+/*
+TestModel_InCallback1
+Description:
+
+	Verifies that adding a variable or a constraint, or reading an attribute
+	of a variable, from within a callback is refused with ErrInCallback
+	instead of reaching Gurobi.
+*/
+func TestModel_InCallback1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("incallback1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("incallback1.log")
+
+	model, err := gurobi.NewModel("incallback1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+
+	err = model.SetCallback(func(cb *gurobi.CallbackContext) error {
+		if !cb.Model.InCallback() {
+			t.Errorf("expected the model to be in a callback")
+		}
+		if _, err := cb.Model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.Le, 5.0, "c"); !errors.Is(err, gurobi.ErrInCallback) {
+			t.Errorf("expected ErrInCallback from AddConstr; received %v", err)
+		}
+		if _, err := x.GetAttr("VarName"); !errors.Is(err, gurobi.ErrInCallback) {
+			t.Errorf("expected ErrInCallback from Var.GetAttr; received %v", err)
+		}
+		_, err := cb.Model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+		return err
+	})
+	if err != nil {
+		t.Errorf("unexpected error setting the callback: %v", err)
+	}
+
+	if err := model.Optimize(); !errors.Is(err, gurobi.ErrInCallback) {
+		t.Errorf("expected ErrInCallback; received %v", err)
+	}
+	if model.InCallback() {
+		t.Errorf("expected the model to not be in a callback after Optimize")
+	}
+}
+
+/*
+TestModel_SetCallbackData1
+Description:
+
+	Verifies that SetCallbackData needs a callback to attach the data to.
+*/
+func TestModel_SetCallbackData1(t *testing.T) {
+	model := gurobi.NewDryRunModel("callbackdata1")
+	defer model.Free()
+
+	if err := model.SetCallbackData(42); err == nil {
+		t.Errorf("expected an error for a model without a callback, but none were thrown!")
+	}
+	if model.CallbackData() != nil {
+		t.Errorf("expected no callback data; received %v", model.CallbackData())
+	}
+}
+
+/*
+TestModel_SetCallbackWithData1
+Description:
+
+	Verifies that the data attached with SetCallbackWithData is passed to
+	every call of the callback.
+*/
+func TestModel_SetCallbackWithData1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("callbackdata2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("callbackdata2.log")
+
+	model, err := gurobi.NewModel("callbackdata2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	if _, err = model.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{}); err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+
+	calls := new(int)
+	err = model.SetCallbackWithData(func(cb *gurobi.CallbackContext) error {
+		counter, ok := cb.UserData().(*int)
+		if !ok {
+			return fmt.Errorf("unexpected user data %v", cb.UserData())
+		}
+		*counter++
+		return nil
+	}, calls)
+	if err != nil {
+		t.Errorf("unexpected error setting the callback: %v", err)
+	}
+	if model.CallbackData() != calls {
+		t.Errorf("expected the model to return the callback data")
+	}
+
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+	if *calls == 0 {
+		t.Errorf("expected the callback to be called at least once")
+	}
+}
+
+/*
+TestModel_SetCallbackData2
+Description:
+
+	Replaces the data of a registered callback and verifies that the
+	callback receives the new data during the next optimization.
+*/
+func TestModel_SetCallbackData2(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("callbackdata3.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("callbackdata3.log")
+
+	model, err := gurobi.NewModel("callbackdata3", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	if _, err = model.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{}); err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+
+	// Algorithm
+	seen := []string{}
+	err = model.SetCallbackWithData(func(cb *gurobi.CallbackContext) error {
+		label, ok := cb.UserData().(string)
+		if !ok {
+			return fmt.Errorf("unexpected user data %v", cb.UserData())
+		}
+		if len(seen) == 0 || seen[len(seen)-1] != label {
+			seen = append(seen, label)
+		}
+		return nil
+	}, "first")
+	if err != nil {
+		t.Errorf("unexpected error setting the callback: %v", err)
+	}
+	if err := model.SetCallbackData("second"); err != nil {
+		t.Errorf("There was an issue replacing the callback data: %v", err)
+	}
+
+	// Test
+	if data := model.CallbackData(); data != "second" {
+		t.Errorf("expected the replaced callback data; received %v", data)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+	if len(seen) != 1 || seen[0] != "second" {
+		t.Errorf("expected the callback to only see the replaced data; received %v", seen)
+	}
+}
+
+/*
+TestModel_SetCallbackWithData2
+Description:
+
+	Verifies that the callback state (and its data) is released when the
+	callback is replaced with nil and when the model is freed.
+*/
+func TestModel_SetCallbackWithData2(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("callbackdata4.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("callbackdata4.log")
+
+	model, err := gurobi.NewModel("callbackdata4", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+
+	noop := func(cb *gurobi.CallbackContext) error { return nil }
+
+	// Algorithm (replace the callback with nil)
+	if err := model.SetCallbackWithData(noop, 1); err != nil {
+		t.Errorf("unexpected error setting the callback: %v", err)
+	}
+	if err := model.SetCallbackWithData(nil, 2); err != nil {
+		t.Errorf("unexpected error removing the callback: %v", err)
+	}
+
+	// Test
+	if data := model.CallbackData(); data != nil {
+		t.Errorf("expected no callback data after removing the callback; received %v", data)
+	}
+	if err := model.SetCallbackData(3); err == nil {
+		t.Errorf("expected an error for a model without a callback, but none were thrown!")
+	}
+
+	// Algorithm (free the model)
+	if err := model.SetCallbackWithData(noop, 4); err != nil {
+		t.Errorf("unexpected error setting the callback again: %v", err)
+	}
+	model.Free()
+
+	// Test
+	if data := model.CallbackData(); data != nil {
+		t.Errorf("expected no callback data after freeing the model; received %v", data)
+	}
+}
+
+/*
+TestCallbackContext_GetInt1
+Description:
+
+	Verifies that callback information is only queried for its where code
+	and with the method of its type.
+*/
+func TestCallbackContext_GetInt1(t *testing.T) {
+	cb := &gurobi.CallbackContext{Where: gurobi.WhereMIP}
+
+	if !cb.Available(gurobi.WhatMIPCutCnt) || cb.Available(gurobi.WhatSpxItrCnt) || !cb.Available(gurobi.WhatRuntime) {
+		t.Errorf("unexpected availability in the MIP callback")
+	}
+	if !gurobi.WhatMIPSolCnt.IsInt() || gurobi.WhatMIPObjBst.IsInt() || gurobi.WhatMIPSolCnt.String() != "MIP_SOLCNT" {
+		t.Errorf("unexpected description of MIP_SOLCNT: %v", gurobi.WhatMIPSolCnt)
+	}
+
+	if _, err := cb.GetDouble(gurobi.WhatMIPSolCnt); err == nil {
+		t.Errorf("expected an error for querying an int with GetDouble, but none were thrown!")
+	}
+
+	_, err := cb.GetInt(gurobi.WhatBarrierItrCnt)
+	var whereErr gurobi.CallbackWhereError
+	if !errors.As(err, &whereErr) {
+		t.Fatalf("expected a CallbackWhereError; received %v", err)
+	}
+	if whereErr.Operation != "BARRIER_ITRCNT" || len(whereErr.Allowed) != 1 || whereErr.Allowed[0] != gurobi.WhereBarrier {
+		t.Errorf("unexpected error: %+v", whereErr)
+	}
+}
+
+/*
+TestCallbackContext_GetInt2
+Description:
+
+	Solves a small knapsack problem and checks that the solution count
+	reported in the MIPSOL callbacks never decreases.
+*/
+func TestCallbackContext_GetInt2(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("getint2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("getint2.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("getint2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	vars := []*gurobi.Var{}
+	for i, value := range []float64{5.0, 4.0, 3.0} {
+		x, err := model.AddVar(gurobi.Binary, -value, 0.0, 1.0, fmt.Sprintf("x%v", i), []*gurobi.Constr{}, []float64{})
+		if err != nil {
+			t.Errorf("There was an issue adding a variable to the model: %v", err)
+		}
+		vars = append(vars, x)
+	}
+	if _, err := model.AddConstr(vars, []float64{4.0, 3.0, 2.0}, gurobi.Le, 5.0, "capacity"); err != nil {
+		t.Errorf("There was an issue adding the capacity: %v", err)
+	}
+
+	// Test
+	solCounts := []int{}
+	err = model.SetCallback(func(cb *gurobi.CallbackContext) error {
+		if !cb.Available(gurobi.WhatMIPSolSolCnt) {
+			return nil
+		}
+		count, err := cb.GetInt(gurobi.WhatMIPSolSolCnt)
+		solCounts = append(solCounts, count)
+		return err
+	})
+	if err != nil {
+		t.Errorf("unexpected error setting the callback: %v", err)
+	}
+
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing: %v", err)
+	}
+	if len(solCounts) == 0 {
+		t.Errorf("expected at least one MIPSOL callback")
+	}
+	for i := 1; i < len(solCounts); i++ {
+		if solCounts[i] < solCounts[i-1] {
+			t.Errorf("expected nondecreasing solution counts; received %v", solCounts)
+		}
+	}
+}
+
+/*
+TestModel_SetLazyConstraints1
+Description:
+
+	Verifies that SetLazyConstraints sets the LazyConstraints parameter of a
+	dry-run model.
+*/
+func TestModel_SetLazyConstraints1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("lazy1")
+	defer model.Free()
+
+	// Algorithm
+	if err := model.SetLazyConstraints(true); err != nil {
+		t.Errorf("There was an issue enabling lazy constraints: %v", err)
+	}
+
+	// Test
+	if value := model.DryRun().Params["LazyConstraints"]; value != "1" {
+		t.Errorf("expected LazyConstraints to be 1; received %q", value)
+	}
+}
+
+/*
+TestCallbackContext_AddLazy1
+Description:
+
+	Minimizes -x0 - x1 over binaries and forbids choosing both with a lazy
+	constraint x0 + x1 <= 1 which is only added when a solution violates it.
+*/
+func TestCallbackContext_AddLazy1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("addlazy1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("addlazy1.log")
+
+	model, err := gurobi.NewModel("addlazy1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	x := []*gurobi.Var{}
+	for i := 0; i < 2; i++ {
+		xi, err := model.AddVar(gurobi.Binary, -1.0, 0.0, 1.0, fmt.Sprintf("x%v", i), []*gurobi.Constr{}, []float64{})
+		if err != nil {
+			t.Errorf("There was an issue adding x%v: %v", i, err)
+		}
+		x = append(x, xi)
+	}
+
+	// Algorithm
+	if err := model.SetLazyConstraints(true); err != nil {
+		t.Errorf("There was an issue enabling lazy constraints: %v", err)
+	}
+	err = model.SetCallback(func(cb *gurobi.CallbackContext) error {
+		if cb.Where != gurobi.WhereMIPSol {
+			return nil
+		}
+		sol, err := cb.MIPSolution()
+		if err != nil {
+			return err
+		}
+		if sol[x[0].Index]+sol[x[1].Index] > 1.5 {
+			return cb.AddLazy(x, []float64{1.0, 1.0}, gurobi.Le, 1.0)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("There was an issue setting the callback: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing: %v", err)
+	}
+
+	// Test
+	objval, err := model.GetDoubleAttr(gurobi.DBL_ATTR_OBJVAL)
+	if err != nil {
+		t.Errorf("There was an issue getting the objective value: %v", err)
+	}
+	if objval != -1.0 {
+		t.Errorf("expected an objective value of -1 with the lazy constraint; received %v", objval)
+	}
+}
+
+/*
+TestCallbackContext_AddCut1
+Description:
+
+	Verifies that AddCut and NodeRelaxation are only available in the
+	MIPNODE callback.
+*/
+func TestCallbackContext_AddCut1(t *testing.T) {
+	// Constants
+	cb := &gurobi.CallbackContext{Where: gurobi.WhereMIPSol}
+
+	// Test
+	var whereErr gurobi.CallbackWhereError
+	if err := cb.AddCut([]*gurobi.Var{}, []float64{}, gurobi.Le, 1.0); !errors.As(err, &whereErr) {
+		t.Errorf("expected a CallbackWhereError from AddCut; received %v", err)
+	}
+	if _, err := cb.NodeRelaxation(); !errors.As(err, &whereErr) {
+		t.Errorf("expected a CallbackWhereError from NodeRelaxation; received %v", err)
+	}
+	if !(&gurobi.CallbackContext{Where: gurobi.WhereMIPNode}).CanCall("AddCut") {
+		t.Errorf("expected AddCut to be available in the MIPNODE callback")
+	}
+}
+
+/*
+TestCallbackContext_AddCut2
+Description:
+
+	Separates the cover cut x0 + x1 <= 1 of a small knapsack from the node
+	relaxations and checks that the optimal value is unchanged.
+*/
+func TestCallbackContext_AddCut2(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("addcut2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("addcut2.log")
+
+	model, err := gurobi.NewModel("addcut2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	vars := []*gurobi.Var{}
+	for i, value := range []float64{5.0, 4.0, 3.0} {
+		x, err := model.AddVar(gurobi.Binary, -value, 0.0, 1.0, fmt.Sprintf("x%v", i), []*gurobi.Constr{}, []float64{})
+		if err != nil {
+			t.Errorf("There was an issue adding a variable to the model: %v", err)
+		}
+		vars = append(vars, x)
+	}
+	if _, err := model.AddConstr(vars, []float64{4.0, 3.0, 2.0}, gurobi.Le, 5.0, "capacity"); err != nil {
+		t.Errorf("There was an issue adding the capacity: %v", err)
+	}
+
+	// Algorithm
+	if err := model.SetIntParam("PreCrush", 1); err != nil {
+		t.Errorf("There was an issue setting PreCrush: %v", err)
+	}
+	err = model.SetCallback(func(cb *gurobi.CallbackContext) error {
+		if cb.Where != gurobi.WhereMIPNode {
+			return nil
+		}
+		if status, err := cb.GetInt(gurobi.WhatMIPNodeStatus); err != nil || gurobi.Status(status) != gurobi.StatusOptimal {
+			return err
+		}
+		rel, err := cb.NodeRelaxation()
+		if err != nil {
+			return err
+		}
+		if rel[vars[0].Index]+rel[vars[1].Index] > 1.0+1e-6 {
+			return cb.AddCut(vars[:2], []float64{1.0, 1.0}, gurobi.Le, 1.0)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("There was an issue setting the callback: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing: %v", err)
+	}
+
+	// Test
+	objval, err := model.GetDoubleAttr(gurobi.DBL_ATTR_OBJVAL)
+	if err != nil {
+		t.Errorf("There was an issue getting the objective value: %v", err)
+	}
+	if objval != -7.0 {
+		t.Errorf("expected an objective value of -7; received %v", objval)
+	}
+}
+
+/*
+TestCallbackContext_SetSolution1
+Description:
+
+	Verifies that SetSolution is not available in the MESSAGE callback.
+*/
+func TestCallbackContext_SetSolution1(t *testing.T) {
+	// Constants
+	cb := &gurobi.CallbackContext{Where: gurobi.WhereMessage}
+
+	// Test
+	var whereErr gurobi.CallbackWhereError
+	if _, err := cb.SetSolution([]*gurobi.Var{}, []float64{}); !errors.As(err, &whereErr) {
+		t.Errorf("expected a CallbackWhereError from SetSolution; received %v", err)
+	}
+}
+
+/*
+TestCallbackContext_SetSolution2
+Description:
+
+	Posts the optimal solution of a small knapsack (without presolve and
+	heuristics) from the first MIPNODE callback and checks the objective
+	value returned for it.
+*/
+func TestCallbackContext_SetSolution2(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("setsolution2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("setsolution2.log")
+
+	model, err := gurobi.NewModel("setsolution2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	vars := []*gurobi.Var{}
+	for i, value := range []float64{5.0, 4.0, 3.0} {
+		x, err := model.AddVar(gurobi.Binary, -value, 0.0, 1.0, fmt.Sprintf("x%v", i), []*gurobi.Constr{}, []float64{})
+		if err != nil {
+			t.Errorf("There was an issue adding a variable to the model: %v", err)
+		}
+		vars = append(vars, x)
+	}
+	if _, err := model.AddConstr(vars, []float64{4.0, 3.0, 2.0}, gurobi.Le, 5.0, "capacity"); err != nil {
+		t.Errorf("There was an issue adding the capacity: %v", err)
+	}
+	if err := model.SetIntParam("Presolve", 0); err != nil {
+		t.Errorf("There was an issue turning off presolve: %v", err)
+	}
+	if err := model.SetDBLParam("Heuristics", 0.0); err != nil {
+		t.Errorf("There was an issue turning off the heuristics: %v", err)
+	}
+
+	// Algorithm
+	posted := []float64{}
+	err = model.SetCallback(func(cb *gurobi.CallbackContext) error {
+		if cb.Where != gurobi.WhereMIPNode || len(posted) > 0 {
+			return nil
+		}
+		objval, err := cb.SetSolution(vars[1:], []float64{1.0, 1.0})
+		posted = append(posted, objval)
+		return err
+	})
+	if err != nil {
+		t.Errorf("There was an issue setting the callback: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing: %v", err)
+	}
+
+	// Test
+	if len(posted) != 1 || posted[0] != -7.0 {
+		t.Errorf("expected a single posted solution with the objective -7; received %v", posted)
+	}
+}
@@ -0,0 +1,123 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+cardinality_test.go
+Description:
+	Tests the cardinality constraint helpers and SOS constraints.
+*/
+
+/*
+TestModel_AddCardinality1
+Description:
+
+	Verifies that AddCardinality() throws an error for a negative k.
+*/
+func TestModel_AddCardinality1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("cardinality1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("cardinality1.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("cardinality1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	// Test
+	_, err = model.AddCardinality([]*gurobi.Var{}, -1, gurobi.CardinalityLinear, "card")
+	if err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
+
+/*
+TestModel_AddCardinality2
+Description:
+
+	Maximizes x0 + 2 x1 + 3 x2 with x in [0, 1]^3 and at most 2 nonzeros,
+	for both formulations, and verifies that the objective is 5.
+*/
+func TestModel_AddCardinality2(t *testing.T) {
+	for _, formulation := range []gurobi.CardinalityFormulation{gurobi.CardinalityLinear, gurobi.CardinalitySOS1} {
+		// Create environment.
+		env, err := gurobi.NewEnv("cardinality2.log")
+		if err != nil {
+			t.Errorf("There was an issue creating the new Env: %v", err)
+		}
+		defer env.Free()
+		defer os.Remove("cardinality2.log")
+
+		// Create an empty model.
+		model, err := gurobi.NewModel("cardinality2", env)
+		if err != nil {
+			t.Errorf("There was an issue creating the new model: %v", err)
+		}
+		defer model.Free()
+
+		vtype := gurobi.Binary
+		if formulation == gurobi.CardinalitySOS1 {
+			vtype = gurobi.Continuous
+		}
+
+		vars := []*gurobi.Var{}
+		for i := 0; i < 3; i++ {
+			v, err := model.AddVar(vtype, float64(i+1), 0.0, 1.0, "", []*gurobi.Constr{}, []float64{})
+			if err != nil {
+				t.Errorf("There was an issue adding a variable to the model: %v", err)
+			}
+			vars = append(vars, v)
+		}
+
+		// Algorithm
+		if _, err := model.AddCardinality(vars, 2, formulation, "card"); err != nil {
+			t.Errorf("unexpected error adding the cardinality constraint: %v", err)
+		}
+
+		if err := model.SetMaximize(); err != nil {
+			t.Errorf("unexpected error setting the model sense: %v", err)
+		}
+
+		if err := model.Optimize(); err != nil {
+			t.Errorf("There was an issue optimizing the model: %v", err)
+		}
+
+		// Test
+		obj, err := model.ObjVal()
+		if err != nil {
+			t.Errorf("unexpected error retrieving the objective: %v", err)
+		}
+
+		if obj != 5.0 {
+			t.Errorf("(formulation=%v) expected objective 5; received %v", formulation, obj)
+		}
+	}
+}
+
+/*
+TestSOSType_Check1
+Description:
+
+	Verifies that Check() rejects an unknown SOS type.
+*/
+func TestSOSType_Check1(t *testing.T) {
+	// Test
+	if err := gurobi.SOSType(3).Check(); err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+
+	if err := gurobi.SOSType1.Check(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+checkpoint_test.go
+Description:
+	Tests Checkpoint and ResumeFrom.
+*/
+
+/*
+TestModel_ResumeFrom1
+Description:
+
+	Verifies that ResumeFrom fails for a directory without a checkpoint.
+*/
+func TestModel_ResumeFrom1(t *testing.T) {
+	model := gurobi.NewDryRunModel("resume1")
+	defer model.Free()
+
+	dir, err := os.MkdirTemp("", "checkpoint")
+	if err != nil {
+		t.Errorf("There was an issue creating a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := model.ResumeFrom(dir); err == nil {
+		t.Errorf("expected an error for a directory without a checkpoint, but none were thrown!")
+	}
+}
+
+// buildCheckpointModel builds max x + y s.t. x + y <= 3 over two integer variables.
+func buildCheckpointModel(t *testing.T, env *gurobi.Env) *gurobi.Model {
+	model, err := gurobi.NewModel("checkpoint", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+
+	vars, err := model.AddVarsWithTypes(2, gurobi.Integer)
+	if err != nil {
+		t.Errorf("There was an issue adding variables to the model: %v", err)
+	}
+	for _, v := range vars {
+		if err := v.SetObj(1.0); err != nil {
+			t.Errorf("There was an issue setting the objective: %v", err)
+		}
+	}
+	if _, err := model.AddConstr(vars, []float64{1.0, 1.0}, gurobi.Le, 3.0, "c0"); err != nil {
+		t.Errorf("There was an issue adding a constraint to the model: %v", err)
+	}
+	if err := model.SetMaximize(); err != nil {
+		t.Errorf("There was an issue setting the model sense: %v", err)
+	}
+	return model
+}
+
+/*
+TestModel_Checkpoint1
+Description:
+
+	Solves a small MIP, checkpoints it and resumes a second copy of the
+	model from the checkpoint.
+*/
+func TestModel_Checkpoint1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("checkpoint1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("checkpoint1.log")
+
+	dir, err := os.MkdirTemp("", "checkpoint")
+	if err != nil {
+		t.Errorf("There was an issue creating a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	model := buildCheckpointModel(t, env)
+	defer model.Free()
+	if err := model.SetIntParam("Threads", 1); err != nil {
+		t.Errorf("There was an issue setting Threads: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+
+	cp, err := model.Checkpoint(dir)
+	if err != nil {
+		t.Errorf("There was an issue writing the checkpoint: %v", err)
+	}
+	if !cp.HasStart || cp.ObjVal != 3.0 {
+		t.Errorf("unexpected checkpoint: %+v", cp)
+	}
+
+	resumed := buildCheckpointModel(t, env)
+	defer resumed.Free()
+	if _, err := resumed.ResumeFrom(dir); err != nil {
+		t.Errorf("There was an issue resuming from the checkpoint: %v", err)
+	}
+
+	threads, err := resumed.GetIntParam("Threads")
+	if err != nil || threads != 1 {
+		t.Errorf("expected the Threads parameter to be restored; received %v (%v)", threads, err)
+	}
+	runtime, err := resumed.CumulativeRuntime()
+	if err != nil || runtime < cp.CumulativeRuntime {
+		t.Errorf("expected the runtime of the checkpoint to be carried over; received %v (%v)", runtime, err)
+	}
+}
@@ -0,0 +1,62 @@
+package gurobi_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+compare_test.go
+Description:
+	Tests the sense-aware objective comparisons.
+*/
+
+/*
+TestIsBetter1
+Description:
+
+	Tests that IsBetter respects the objective sense and ignores tiny differences.
+*/
+func TestIsBetter1(t *testing.T) {
+	if !gurobi.IsBetter(1.0, 2.0, gurobi.Minimize) || gurobi.IsBetter(2.0, 1.0, gurobi.Minimize) {
+		t.Errorf("expected smaller values to be better when minimizing")
+	}
+	if !gurobi.IsBetter(2.0, 1.0, gurobi.Maximize) || gurobi.IsBetter(1.0, 2.0, gurobi.Maximize) {
+		t.Errorf("expected larger values to be better when maximizing")
+	}
+	if gurobi.IsBetter(1.0-1e-12, 1.0, gurobi.Minimize) {
+		t.Errorf("expected values within the tolerance not to be better")
+	}
+	if !gurobi.IsBetter(5.0, gurobi.WorstObjective(gurobi.Maximize), gurobi.Maximize) {
+		t.Errorf("expected any value to be better than the worst objective")
+	}
+	if gurobi.Best(3.0, 4.0, gurobi.Maximize) != 4.0 {
+		t.Errorf("expected 4 to be the best value when maximizing")
+	}
+}
+
+/*
+TestRelativeGap1
+Description:
+
+	Tests the relative and absolute gaps for both senses and the special cases.
+*/
+func TestRelativeGap1(t *testing.T) {
+	if gap := gurobi.RelativeGap(10.0, 8.0, gurobi.Minimize); math.Abs(gap-0.2) > 1e-12 {
+		t.Errorf("expected a gap of 0.2; received %v", gap)
+	}
+	if gap := gurobi.RelativeGap(8.0, 10.0, gurobi.Maximize); math.Abs(gap-0.25) > 1e-12 {
+		t.Errorf("expected a gap of 0.25; received %v", gap)
+	}
+	if gap := gurobi.RelativeGap(8.0, 10.0, gurobi.Minimize); gap != 0 {
+		t.Errorf("expected a bound past the incumbent to give a gap of 0; received %v", gap)
+	}
+	if gap := gurobi.RelativeGap(gurobi.INFINITY, 3.0, gurobi.Minimize); !math.IsInf(gap, 1) {
+		t.Errorf("expected an infinite gap without an incumbent; received %v", gap)
+	}
+	if gap := gurobi.AbsoluteGap(-4.0, -1.0, gurobi.Maximize); gap != 3.0 {
+		t.Errorf("expected an absolute gap of 3; received %v", gap)
+	}
+}
@@ -0,0 +1,338 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+constr_test.go
+Description:
+	Tests the TempConstr type and the indicator constraint sugar of the gurobi package.
+*/
+
+/*
+TestTempConstr_NormalizedRHS1
+Description:
+
+	Tests that the constant of the left-hand side is moved to the right-hand side.
+*/
+func TestTempConstr_NormalizedRHS1(t *testing.T) {
+	// Constants
+	x := &gurobi.Var{Index: 0}
+	expr := gurobi.LinExpr{}
+	expr.AddTerm(x, 2.0).AddConstant(3.0)
+
+	// Algorithm
+	tc := expr.LessEq(10.0)
+
+	// Test
+	if tc.Sense != gurobi.Le {
+		t.Errorf("expected sense %v; received %v", gurobi.Le, tc.Sense)
+	}
+
+	if tc.NormalizedRHS() != 7.0 {
+		t.Errorf("expected normalized RHS %v; received %v", 7.0, tc.NormalizedRHS())
+	}
+
+	if err := tc.Check(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+/*
+TestTempConstr_Check1
+Description:
+
+	Tests that Check() rejects a constraint with mismatched variables and coefficients.
+*/
+func TestTempConstr_Check1(t *testing.T) {
+	// Constants
+	tc := gurobi.TempConstr{
+		LHS:   gurobi.LinExpr{Ind: []*gurobi.Var{{Index: 0}}, Val: []float64{}},
+		Sense: gurobi.Ge,
+	}
+
+	// Test
+	if err := tc.Check(); err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
+
+/*
+TestVar_Implies1
+Description:
+
+	Tests that a binary variable forced to 1 enforces the implied constraint.
+*/
+func TestVar_Implies1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("implies1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("implies1.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("implies1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	// Maximize x subject to (b == 1) -> x <= 3 with b fixed to 1.
+	x, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	b, err := model.AddVar(gurobi.Binary, 0.0, 1.0, 1.0, "b", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+
+	expr := gurobi.LinExpr{}
+	expr.AddTerm(x, 1.0)
+	if _, err := b.Implies(expr.LessEq(3.0), "b_implies_x"); err != nil {
+		t.Errorf("unexpected error adding the implication: %v", err)
+	}
+
+	if err := model.SetMaximize(); err != nil {
+		t.Errorf("unexpected error setting the model sense: %v", err)
+	}
+
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+
+	// Test
+	xVal, err := x.GetDouble(gurobi.DBL_ATTR_X)
+	if err != nil {
+		t.Errorf("unexpected error retrieving x: %v", err)
+	}
+
+	if xVal != 3.0 {
+		t.Errorf("expected x = %v; received %v", 3.0, xVal)
+	}
+
+	// A continuous variable cannot imply a constraint.
+	if _, err := x.Implies(expr.LessEq(3.0), "x_implies_x"); err == nil {
+		t.Errorf("expected an error when a continuous variable implies a constraint")
+	}
+}
+
+/*
+TestModel_SetRHSs1
+Description:
+
+	Verifies that SetRHSs() rejects slices of different lengths.
+*/
+func TestModel_SetRHSs1(t *testing.T) {
+	model := gurobi.NewDryRunModel("setrhss1")
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	c, err := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.Le, 1.0, "c0")
+	if err != nil {
+		t.Errorf("There was an issue adding a constraint to the model: %v", err)
+	}
+
+	if err := model.SetRHSs([]*gurobi.Constr{c}, []float64{1.0, 2.0}); err == nil {
+		t.Errorf("expected an error for mismatched lengths, but none were thrown!")
+	}
+}
+
+/*
+TestModel_SetRHSs2
+Description:
+
+	Maximizes x + y subject to x <= 1 and y <= 1, then moves both
+	right-hand sides to 3 with SetRHSs() and verifies that the objective is 6.
+*/
+func TestModel_SetRHSs2(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("setrhss2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("setrhss2.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("setrhss2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	vars, err := model.AddVarsWithoutTypes([]float64{0.0, 0.0}, []float64{10.0, 10.0})
+	if err != nil {
+		t.Errorf("There was an issue adding variables to the model: %v", err)
+	}
+	constrs := make([]*gurobi.Constr, len(vars))
+	for i, v := range vars {
+		if err := v.SetObj(1.0); err != nil {
+			t.Errorf("There was an issue setting the objective: %v", err)
+		}
+		constrs[i], err = model.AddConstr([]*gurobi.Var{v}, []float64{1.0}, gurobi.Le, 1.0, "")
+		if err != nil {
+			t.Errorf("There was an issue adding a constraint to the model: %v", err)
+		}
+	}
+	if err := model.SetMaximize(); err != nil {
+		t.Errorf("There was an issue setting the model sense: %v", err)
+	}
+
+	if err := model.SetRHSs(constrs, []float64{3.0, 3.0}); err != nil {
+		t.Errorf("There was an issue setting the right-hand sides: %v", err)
+	}
+
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+
+	obj, err := model.ObjVal()
+	if err != nil {
+		t.Errorf("There was an issue getting the objective value: %v", err)
+	}
+	if obj != 6.0 {
+		t.Errorf("expected an objective of 6; received %v", obj)
+	}
+}
+
+/*
+TestModel_SetSenses1
+Description:
+
+	Verifies that SetSenses() rejects an invalid sense.
+*/
+func TestModel_SetSenses1(t *testing.T) {
+	model := gurobi.NewDryRunModel("setsenses1")
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	c, err := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.Le, 1.0, "c0")
+	if err != nil {
+		t.Errorf("There was an issue adding a constraint to the model: %v", err)
+	}
+
+	if err := model.SetSenses([]*gurobi.Constr{c}, []int8{'x'}); err == nil {
+		t.Errorf("expected an error for an invalid sense, but none were thrown!")
+	}
+	if err := model.SetSenses([]*gurobi.Constr{c}, []int8{int8(gurobi.Ge)}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+/*
+TestModel_SetSenses2
+Description:
+
+	Minimizes x subject to x <= 4, then flips the constraint to x >= 4 with
+	SetSenses() and verifies that the objective is 4.
+*/
+func TestModel_SetSenses2(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("setsenses2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("setsenses2.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("setsenses2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	c, err := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.Le, 4.0, "c0")
+	if err != nil {
+		t.Errorf("There was an issue adding a constraint to the model: %v", err)
+	}
+
+	if err := model.SetSenses([]*gurobi.Constr{c}, []int8{int8(gurobi.Ge)}); err != nil {
+		t.Errorf("There was an issue setting the senses: %v", err)
+	}
+
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+
+	obj, err := model.ObjVal()
+	if err != nil {
+		t.Errorf("There was an issue getting the objective value: %v", err)
+	}
+	if obj != 4.0 {
+		t.Errorf("expected an objective of 4; received %v", obj)
+	}
+}
+
+/*
+TestConstr_SetAttr1
+Description:
+
+	Tests that Constr.SetAttr changes the right-hand side of a constraint
+	and that Constr.GetAttr reads it back.
+*/
+func TestConstr_SetAttr1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("constrsetattr1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("constrsetattr1.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("constrsetattr1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	c, err := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.Ge, 4.0, "c0")
+	if err != nil {
+		t.Errorf("There was an issue adding a constraint to the model: %v", err)
+	}
+
+	if err := c.SetAttr("RHS", 6); err != nil {
+		t.Errorf("There was an issue setting the right-hand side: %v", err)
+	}
+	if err := model.Update(); err != nil {
+		t.Errorf("There was an issue updating the model: %v", err)
+	}
+
+	rhs, err := c.GetAttr("RHS")
+	if err != nil {
+		t.Errorf("There was an issue getting the right-hand side: %v", err)
+	}
+	if rhs != 6.0 {
+		t.Errorf("expected a right-hand side of 6.0; received %v (%T)", rhs, rhs)
+	}
+
+	sense, err := c.GetAttr("Sense")
+	if err != nil {
+		t.Errorf("There was an issue getting the sense: %v", err)
+	}
+	if sense != int8(gurobi.Ge) {
+		t.Errorf("expected the sense %v; received %v", int8(gurobi.Ge), sense)
+	}
+}
@@ -0,0 +1,66 @@
+package gurobi_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+convergence_test.go
+Description:
+	Tests the ConvergenceHistory object.
+*/
+
+/*
+TestConvergencePoint_Gap1
+Description:
+
+	Verifies the gap of a point with and without an incumbent.
+*/
+func TestConvergencePoint_Gap1(t *testing.T) {
+	// Constants
+	p1 := gurobi.ConvergencePoint{Incumbent: gurobi.INFINITY, Bound: 10}
+	p2 := gurobi.ConvergencePoint{Incumbent: 20, Bound: 15}
+
+	// Test
+	if !math.IsInf(p1.Gap(), 1) {
+		t.Errorf("expected an infinite gap without incumbent; received %v", p1.Gap())
+	}
+
+	if p2.Gap() != 0.25 {
+		t.Errorf("expected a gap of 0.25; received %v", p2.Gap())
+	}
+}
+
+/*
+TestConvergenceHistory_Record1
+Description:
+
+	Verifies that unchanged points within MinInterval are skipped and that
+	TimeToGap() finds the first point below the gap.
+*/
+func TestConvergenceHistory_Record1(t *testing.T) {
+	// Constants
+	ch := gurobi.NewConvergenceHistory()
+
+	// Algorithm
+	ch.Record(gurobi.ConvergencePoint{Time: 0.0, Incumbent: 20, Bound: 10})
+	ch.Record(gurobi.ConvergencePoint{Time: 0.5, Incumbent: 20, Bound: 10})
+	ch.Record(gurobi.ConvergencePoint{Time: 0.7, Incumbent: 20, Bound: 18})
+	ch.Record(gurobi.ConvergencePoint{Time: 2.0, Incumbent: 20, Bound: 18})
+
+	// Test
+	if len(ch.Points) != 3 {
+		t.Errorf("expected 3 points; received %v", ch.Points)
+	}
+
+	if time, ok := ch.TimeToGap(0.1); !ok || time != 0.7 {
+		t.Errorf("expected the 10%% gap to be reached at 0.7; received %v (%v)", time, ok)
+	}
+
+	if _, ok := ch.TimeToGap(0.0); ok {
+		t.Errorf("expected a 0%% gap to never be reached")
+	}
+}
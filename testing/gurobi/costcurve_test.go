@@ -0,0 +1,66 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+costcurve_test.go
+Description:
+	Tests the CostCurve object.
+*/
+
+/*
+TestCostCurve_Check1
+Description:
+
+	Verifies that Check() rejects mismatched slopes and non-increasing breakpoints.
+*/
+func TestCostCurve_Check1(t *testing.T) {
+	// Constants
+	cc1 := gurobi.CostCurve{Breakpoints: []float64{0, 10}, Slopes: []float64{1, 2}}
+	cc2 := gurobi.CostCurve{Breakpoints: []float64{0, 10, 10}, Slopes: []float64{1, 2}}
+	cc3 := gurobi.CostCurve{Breakpoints: []float64{0, 10, 20}, Slopes: []float64{1, 2}, FixedCharge: 5}
+
+	// Test
+	if err := cc1.Check(); err == nil {
+		t.Errorf("expected an error for mismatched slopes, but none were thrown!")
+	}
+
+	if err := cc2.Check(); err == nil {
+		t.Errorf("expected an error for repeated breakpoints, but none were thrown!")
+	}
+
+	if err := cc3.Check(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+/*
+TestCostCurve_Cost1
+Description:
+
+	Verifies the breakpoints and evaluated costs of a curve with a fixed charge.
+*/
+func TestCostCurve_Cost1(t *testing.T) {
+	// Constants
+	cc := gurobi.CostCurve{Breakpoints: []float64{0, 10, 20}, Slopes: []float64{1, 2}, FixedCharge: 5}
+
+	// Algorithm
+	_, ypts := cc.Points()
+
+	// Test
+	if ypts[0] != 0 || ypts[1] != 10 || ypts[2] != 30 {
+		t.Errorf("unexpected y breakpoints: %v", ypts)
+	}
+
+	if cc.Cost(0) != 0 {
+		t.Errorf("expected Cost(0) = 0; received %v", cc.Cost(0))
+	}
+
+	if cc.Cost(15) != 25 {
+		t.Errorf("expected Cost(15) = 25; received %v", cc.Cost(15))
+	}
+}
@@ -0,0 +1,108 @@
+package gurobi_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+deterministic_test.go
+Description:
+	Tests the deterministic build mode.
+*/
+
+/*
+TestModel_SetDeterministic1
+Description:
+
+	Verifies that SetDeterministic fixes the seed and that repeated variables
+	of a constraint are merged in deterministic mode.
+*/
+func TestModel_SetDeterministic1(t *testing.T) {
+	model := gurobi.NewDryRunModel("deterministic1")
+	defer model.Free()
+
+	if model.IsDeterministic() {
+		t.Errorf("expected a new model to not be deterministic")
+	}
+
+	if err := model.SetDeterministic(7); err != nil {
+		t.Errorf("There was an issue making the model deterministic: %v", err)
+	}
+	if !model.IsDeterministic() {
+		t.Errorf("expected the model to be deterministic")
+	}
+	if seed := model.DryRun().Params["Seed"]; seed != "7" {
+		t.Errorf("expected the seed 7; received %v", seed)
+	}
+
+	vars, err := model.AddVarsWithTypes(2, gurobi.Continuous)
+	if err != nil {
+		t.Errorf("There was an issue adding variables: %v", err)
+	}
+	if _, err := model.AddConstr([]*gurobi.Var{vars[1], vars[0], vars[1]}, []float64{1, 2, 3}, gurobi.Le, 4.0, "c0"); err != nil {
+		t.Errorf("There was an issue adding a constraint: %v", err)
+	}
+	if nzs := model.DryRun().NumNZs; nzs != 2 {
+		t.Errorf("expected 2 nonzeros after merging; received %v", nzs)
+	}
+}
+
+/*
+TestModel_SetDeterministic2
+Description:
+
+	Builds the same model twice with the terms of its constraint in a
+	different order and verifies that the LP files are identical.
+*/
+func TestModel_SetDeterministic2(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("deterministic2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("deterministic2.log")
+
+	build := func(filename string, reversed bool) []byte {
+		model, err := gurobi.NewModel("deterministic2", env)
+		if err != nil {
+			t.Errorf("There was an issue creating the new model: %v", err)
+		}
+		defer model.Free()
+
+		if err := model.SetDeterministic(0); err != nil {
+			t.Errorf("There was an issue making the model deterministic: %v", err)
+		}
+		vars, err := model.AddVarsWithTypes(3, gurobi.Continuous)
+		if err != nil {
+			t.Errorf("There was an issue adding variables: %v", err)
+		}
+
+		terms, coeffs := []*gurobi.Var{vars[0], vars[1], vars[2]}, []float64{1, 2, 3}
+		if reversed {
+			terms, coeffs = []*gurobi.Var{vars[2], vars[1], vars[0]}, []float64{3, 2, 1}
+		}
+		if _, err := model.AddConstr(terms, coeffs, gurobi.Le, 4.0, "c0"); err != nil {
+			t.Errorf("There was an issue adding a constraint: %v", err)
+		}
+
+		if err := model.Write(filename); err != nil {
+			t.Errorf("There was an issue writing the model: %v", err)
+		}
+		defer os.Remove(filename)
+
+		contents, err := os.ReadFile(filename)
+		if err != nil {
+			t.Errorf("There was an issue reading the model: %v", err)
+		}
+		return contents
+	}
+
+	if !bytes.Equal(build("deterministic2a.lp", false), build("deterministic2b.lp", true)) {
+		t.Errorf("expected both builds to produce the same LP file")
+	}
+}
@@ -0,0 +1,107 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+dirty_test.go
+Description:
+	Tests the tracking of pending changes and Reoptimize.
+*/
+
+/*
+TestModel_PendingChanges1
+Description:
+
+	Verifies that added variables, constraints and bound changes are tracked
+	and that a successful Optimize clears them.
+*/
+func TestModel_PendingChanges1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("dirty1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("dirty1.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("dirty1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	if _, err := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.Ge, 1.0, "c0"); err != nil {
+		t.Errorf("There was an issue adding c0: %v", err)
+	}
+
+	pc := model.PendingChanges()
+	if pc.Optimized || pc.NewVars != 1 || pc.NewConstrs != 1 || !model.IsDirty() {
+		t.Errorf("unexpected pending changes before optimizing: %+v", pc)
+	}
+
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+	if model.IsDirty() {
+		t.Errorf("expected the model to be clean after Optimize; received %+v", model.PendingChanges())
+	}
+
+	if err := x.SetDouble(gurobi.DBL_ATTR_LB, 2.0); err != nil {
+		t.Errorf("There was an issue changing the bound: %v", err)
+	}
+	pc = model.PendingChanges()
+	if !model.IsDirty() || pc.IsStructural() || len(pc.Elements[gurobi.DBL_ATTR_LB]) != 1 {
+		t.Errorf("expected one pending LB change; received %+v", pc)
+	}
+}
+
+/*
+TestModel_Reoptimize1
+Description:
+
+	Solves a small MIP with Reoptimize, tightens a bound and verifies that the
+	second solve is warm started from the previous solution.
+*/
+func TestModel_Reoptimize1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("dirty2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("dirty2.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("dirty2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Integer, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+
+	if ws, err := model.Reoptimize(); err != nil || ws != gurobi.WarmStartNone {
+		t.Errorf("expected a cold first solve; received %v (%v)", ws, err)
+	}
+
+	if err := x.SetDouble(gurobi.DBL_ATTR_UB, 5.0); err != nil {
+		t.Errorf("There was an issue changing the bound: %v", err)
+	}
+
+	if ws, err := model.Reoptimize(); err != nil || ws != gurobi.WarmStartSolution {
+		t.Errorf("expected a warm start from the previous solution; received %v (%v)", ws, err)
+	}
+}
@@ -0,0 +1,81 @@
+package gurobi_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+diversity_test.go
+Description:
+	Tests the distances between solutions and the selection of diverse solutions.
+*/
+
+/*
+TestSolutionDistance1
+Description:
+
+	Tests the Hamming and L1 distances and their errors for different lengths.
+*/
+func TestSolutionDistance1(t *testing.T) {
+	// Constants
+	a := []float64{1.0, 0.0, 2.5, 1.0}
+	b := []float64{1.0, 1.0, 0.5, 1.0 + 1e-9}
+
+	// Test
+	if d, err := gurobi.SolutionHamming(a, b); err != nil || d != 2 {
+		t.Errorf("expected a Hamming distance of 2; received %v (%v)", d, err)
+	}
+	if d, err := gurobi.SolutionL1(a, b); err != nil || d < 3.0-1e-6 || d > 3.0+1e-6 {
+		t.Errorf("expected an L1 distance of 3; received %v (%v)", d, err)
+	}
+	if d, err := gurobi.L1Metric.Distance(a, b); err != nil || d < 3.0-1e-6 || d > 3.0+1e-6 {
+		t.Errorf("expected the L1 metric to give 3; received %v (%v)", d, err)
+	}
+	if _, err := gurobi.SolutionHamming(a, b[:2]); err == nil {
+		t.Errorf("expected an error for solutions of different lengths, but none were thrown!")
+	}
+}
+
+/*
+TestMaxDiverse1
+Description:
+
+	Tests that MaxDiverse starts with the first solution and then picks the
+	solutions which are farthest from those already selected.
+*/
+func TestMaxDiverse1(t *testing.T) {
+	// Constants
+	solutions := [][]float64{
+		{1, 1, 0, 0},
+		{1, 1, 0, 1},
+		{0, 0, 1, 1},
+		{1, 0, 1, 1},
+	}
+
+	// Algorithm
+	order, err := gurobi.MaxDiverse(solutions, 3, gurobi.HammingMetric)
+
+	// Test
+	if err != nil {
+		t.Errorf("There was an issue selecting the solutions: %v", err)
+	}
+	// Solution 2 is 4 away from solution 0; then 1 and 3 are both 1 away from
+	// a selected solution, so the earlier one wins.
+	if !reflect.DeepEqual(order, []int{0, 2, 1}) {
+		t.Errorf("expected the order [0 2 1]; received %v", order)
+	}
+
+	if diversity, err := gurobi.Diversity([][]float64{solutions[0], solutions[2], solutions[3]}, gurobi.HammingMetric); err != nil || diversity != 1 {
+		t.Errorf("expected a diversity of 1; received %v (%v)", diversity, err)
+	}
+
+	if all, err := gurobi.MaxDiverse(solutions, 10, gurobi.L1Metric); err != nil || len(all) != 4 {
+		t.Errorf("expected all 4 solutions; received %v (%v)", all, err)
+	}
+	if _, err := gurobi.MaxDiverse(solutions, -1, gurobi.L1Metric); err == nil {
+		t.Errorf("expected an error for a negative k, but none were thrown!")
+	}
+}
@@ -0,0 +1,130 @@
+package gurobi_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+dryrun_test.go
+Description:
+	Tests the dry-run model, which validates and records model building calls
+	without using the Gurobi C API. None of these tests need a Gurobi installation.
+*/
+
+/*
+TestDryRun_Statistics1
+Description:
+
+	Builds a small MIP on a dry-run model and verifies the recorded statistics.
+*/
+func TestDryRun_Statistics1(t *testing.T) {
+	model := gurobi.NewDryRunModel("dryrun1")
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	zs, err := model.AddVarsWithTypes(3, gurobi.Binary)
+	if err != nil {
+		t.Errorf("There was an issue adding zs: %v", err)
+	}
+
+	_, err = model.AddConstr([]*gurobi.Var{x, zs[0], zs[1]}, []float64{1.0, -2.0, 3.0}, gurobi.Le, 4.0, "c0")
+	if err != nil {
+		t.Errorf("There was an issue adding c0: %v", err)
+	}
+	_, err = model.AddConstrs(
+		[][]*gurobi.Var{{zs[0], zs[1], zs[2]}, {x}},
+		[][]float64{{1.0, 1.0, 1.0}, {1.0}},
+		[]gurobi.Sense{gurobi.Eq, gurobi.Ge},
+		[]float64{1.0, 2.0},
+		[]string{"c1", "c2"},
+	)
+	if err != nil {
+		t.Errorf("There was an issue adding c1 and c2: %v", err)
+	}
+
+	if err := model.SetMaximize(); err != nil {
+		t.Errorf("There was an issue setting the sense: %v", err)
+	}
+
+	dr := model.DryRun()
+	if dr.NumVars != 4 || dr.NumConstrs != 3 || dr.NumNZs != 7 {
+		t.Errorf("expected 4 vars, 3 constrs and 7 nonzeros; received %v", dr.Summary())
+	}
+	if dr.VarTypes[gurobi.Binary] != 3 || dr.VarTypes[gurobi.Continuous] != 1 {
+		t.Errorf("unexpected variable types: %v", dr.VarTypes)
+	}
+	if dr.Senses[gurobi.Le] != 1 || dr.Senses[gurobi.Eq] != 1 || dr.Senses[gurobi.Ge] != 1 {
+		t.Errorf("unexpected senses: %v", dr.Senses)
+	}
+	if sense, err := model.GetModelSense(); err != nil || sense != gurobi.Maximize {
+		t.Errorf("expected the model sense to be Maximize; received %v (%v)", sense, err)
+	}
+	if numVars, err := model.NumVars(); err != nil || numVars != 4 {
+		t.Errorf("expected NumVars to be 4; received %v (%v)", numVars, err)
+	}
+	if !strings.Contains(dr.Summary(), "4 vars") {
+		t.Errorf("unexpected summary: %v", dr.Summary())
+	}
+}
+
+/*
+TestDryRun_Validation1
+Description:
+
+	Verifies that a dry-run model rejects invalid bounds, non-finite
+	coefficients and variables which do not belong to the model.
+*/
+func TestDryRun_Validation1(t *testing.T) {
+	model := gurobi.NewDryRunModel("dryrun2")
+	defer model.Free()
+
+	if _, err := model.AddVar(gurobi.Continuous, 0.0, 1.0, 0.0, "x", []*gurobi.Constr{}, []float64{}); err == nil {
+		t.Errorf("expected an error for lb > ub, but none were thrown!")
+	}
+
+	vars, err := model.AddVarsWithoutTypes([]float64{0.0, 0.0}, []float64{1.0, 1.0})
+	if err != nil {
+		t.Errorf("There was an issue adding the vars: %v", err)
+	}
+
+	if _, err := model.AddConstr(vars, []float64{1.0}, gurobi.Le, 1.0, "c"); err == nil {
+		t.Errorf("expected an error for mismatched lengths, but none were thrown!")
+	}
+
+	foreign := &gurobi.Var{Model: model, Index: 5}
+	if _, err := model.AddConstr([]*gurobi.Var{foreign}, []float64{1.0}, gurobi.Le, 1.0, "c"); err == nil {
+		t.Errorf("expected an error for a variable outside of the model, but none were thrown!")
+	}
+
+	if err := model.SetIntParam("NotAParam", 1); err == nil {
+		t.Errorf("expected an error for an unknown parameter, but none were thrown!")
+	}
+	if err := model.SetDBLParam("TimeLimit", 10.0); err != nil {
+		t.Errorf("There was an issue setting TimeLimit: %v", err)
+	}
+	if model.DryRun().Params["TimeLimit"] != "10" {
+		t.Errorf("expected TimeLimit to be recorded; received %v", model.DryRun().Params)
+	}
+}
+
+/*
+TestDryRun_Optimize1
+Description:
+
+	Verifies that Optimize returns ErrDryRun on a dry-run model.
+*/
+func TestDryRun_Optimize1(t *testing.T) {
+	model := gurobi.NewDryRunModel("dryrun3")
+	defer model.Free()
+
+	if err := model.Optimize(); !errors.Is(err, gurobi.ErrDryRun) {
+		t.Errorf("expected ErrDryRun; received %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+emphasis_test.go
+Description:
+	Tests the MIP emphasis controls.
+*/
+
+/*
+TestModel_EmphasizeFeasibility1
+Description:
+
+	Verifies that EmphasizeFeasibility and EmphasizeBalance set MIPFocus,
+	Heuristics, RINS and PumpPasses.
+*/
+func TestModel_EmphasizeFeasibility1(t *testing.T) {
+	model := gurobi.NewDryRunModel("emphasis1")
+	defer model.Free()
+
+	if err := model.EmphasizeFeasibility(); err != nil {
+		t.Errorf("There was an issue emphasizing feasibility: %v", err)
+	}
+	params := model.DryRun().Params
+	if params["MIPFocus"] != "1" || params["Heuristics"] != "0.2" || params["RINS"] != "10" || params["PumpPasses"] != "20" {
+		t.Errorf("unexpected parameters for feasibility: %v", params)
+	}
+
+	if err := model.EmphasizeBalance(); err != nil {
+		t.Errorf("There was an issue restoring the balance: %v", err)
+	}
+	params = model.DryRun().Params
+	if params["MIPFocus"] != "0" || params["Heuristics"] != "0.05" || params["RINS"] != "-1" || params["PumpPasses"] != "-1" {
+		t.Errorf("unexpected parameters for balance: %v", params)
+	}
+}
+
+/*
+TestModel_SetHeuristicsFraction1
+Description:
+
+	Verifies that SetHeuristicsFraction rejects fractions outside of [0, 1].
+*/
+func TestModel_SetHeuristicsFraction1(t *testing.T) {
+	model := gurobi.NewDryRunModel("emphasis2")
+	defer model.Free()
+
+	if err := model.SetHeuristicsFraction(1.5); err == nil {
+		t.Errorf("expected an error for the fraction 1.5, but none were thrown!")
+	}
+	if err := model.SetHeuristicsFraction(0.5); err != nil {
+		t.Errorf("There was an issue setting the fraction: %v", err)
+	}
+	if heuristics := model.DryRun().Params["Heuristics"]; heuristics != "0.5" {
+		t.Errorf("expected Heuristics to be 0.5; received %v", heuristics)
+	}
+}
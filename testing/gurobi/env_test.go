@@ -214,3 +214,65 @@ func TestEnv_GetTimeLimit1(t *testing.T) {
 		}
 	}
 }
+
+/*
+TestEnv_LoadFromOSEnv1
+Description:
+
+	Verifies that LoadFromOSEnv() applies the parameters given by GRBGO_ environment variables.
+*/
+func TestEnv_LoadFromOSEnv1(t *testing.T) {
+	// Constants
+	logfilename1 := "thomTide.log"
+	t.Setenv("GRBGO_TIMELIMIT", "17.5")
+	t.Setenv("GRBGO_THREADS", "2")
+
+	// Algorithm
+	env, err := gurobi.NewEnv(logfilename1)
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env variable: %v", err)
+	}
+	defer env.Free()
+
+	applied, err := env.LoadFromOSEnv("")
+	if err != nil {
+		t.Errorf("unexpected error loading parameters from the OS environment: %v", err)
+	}
+
+	if len(applied) != 2 || applied[0] != "Threads" || applied[1] != "TimeLimit" {
+		t.Errorf("unexpected list of applied parameters: %v", applied)
+	}
+
+	detectedTimeLimit, err := env.GetDBLParam("TimeLimit")
+	if err != nil {
+		t.Errorf("There was an error getting the time limit of the environment! %v", err)
+	}
+
+	if detectedTimeLimit != 17.5 {
+		t.Errorf("The detected time limit (%v) was not equal to the expected time limit (%v s).", detectedTimeLimit, 17.5)
+	}
+}
+
+/*
+TestEnv_LoadFromOSEnv2
+Description:
+
+	Verifies that LoadFromOSEnv() rejects an environment variable that does not name a known parameter.
+*/
+func TestEnv_LoadFromOSEnv2(t *testing.T) {
+	// Constants
+	logfilename1 := "thomTide.log"
+	t.Setenv("GRBGOTEST_NOTAPARAM", "1")
+
+	// Algorithm
+	env, err := gurobi.NewEnv(logfilename1)
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env variable: %v", err)
+	}
+	defer env.Free()
+
+	_, err = env.LoadFromOSEnv("GRBGOTEST_")
+	if err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
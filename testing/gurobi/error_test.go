@@ -1,6 +1,8 @@
 package gurobi_test
 
 import (
+	"errors"
+	"fmt"
 	"github.com/MatProGo-dev/Gurobi.go/gurobi"
 	"testing"
 )
@@ -49,3 +51,86 @@ func TestError_Error2(t *testing.T) {
 	}
 
 }
+
+/*
+TestError_Is1
+Description:
+
+	Tests that errors.Is() matches a gurobi Error with the sentinel error of its category.
+*/
+func TestError_Is1(t *testing.T) {
+	// Constants
+	err1 := gurobi.Error{
+		ErrorCode: gurobi.ERROR_NO_LICENSE,
+		Message:   "No Gurobi license found",
+	}
+
+	// Test
+	if !errors.Is(err1, gurobi.ErrNoLicense) {
+		t.Errorf("expected %v to be classified as ErrNoLicense", err1)
+	}
+
+	if errors.Is(err1, gurobi.ErrNetwork) {
+		t.Errorf("did not expect %v to be classified as ErrNetwork", err1)
+	}
+}
+
+/*
+TestError_Is2
+Description:
+
+	Tests that errors.Is() can see through a wrapped gurobi Error.
+*/
+func TestError_Is2(t *testing.T) {
+	// Constants
+	err1 := fmt.Errorf(
+		"cannot create environment: %w",
+		gurobi.Error{ErrorCode: gurobi.ERROR_SIZE_LIMIT_EXCEEDED, Message: "Model too large for size-limited license"},
+	)
+
+	// Test
+	if !errors.Is(err1, gurobi.ErrSizeLimitExceeded) {
+		t.Errorf("expected %v to be classified as ErrSizeLimitExceeded", err1)
+	}
+}
+
+/*
+TestError_Category1
+Description:
+
+	Tests that the Category() of an unrecognized error code is nil.
+*/
+func TestError_Category1(t *testing.T) {
+	// Constants
+	err1 := gurobi.Error{ErrorCode: int32(2)}
+
+	// Test
+	if err1.Category() != nil {
+		t.Errorf("expected no category; received %v", err1.Category())
+	}
+
+	if errors.Is(err1, gurobi.ErrOutOfMemory) {
+		t.Errorf("did not expect %v to be classified as ErrOutOfMemory", err1)
+	}
+}
+
+/*
+TestIsRetryable1
+Description:
+
+	Tests that network failures are retryable but invalid arguments and
+	license errors are not.
+*/
+func TestIsRetryable1(t *testing.T) {
+	if !gurobi.IsRetryable(gurobi.Error{ErrorCode: gurobi.ERROR_NETWORK}) {
+		t.Errorf("expected a network error to be retryable")
+	}
+
+	if gurobi.IsRetryable(gurobi.Error{ErrorCode: gurobi.ERROR_INVALID_ARGUMENT}) {
+		t.Errorf("did not expect an invalid argument error to be retryable")
+	}
+
+	if gurobi.IsRetryable(gurobi.Error{ErrorCode: gurobi.ERROR_NO_LICENSE}) {
+		t.Errorf("did not expect a license error to be retryable")
+	}
+}
@@ -0,0 +1,80 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+expr_test.go
+Description:
+	Tests the construction of linear expressions.
+*/
+
+/*
+TestNewLinExprFromMap1
+Description:
+
+	Verifies that NewLinExprFromMap sorts the terms by variable index and
+	merges distinct pointers to the same variable.
+*/
+func TestNewLinExprFromMap1(t *testing.T) {
+	model := gurobi.NewDryRunModel("linexprmap1")
+	defer model.Free()
+
+	vars, err := model.AddVarsWithTypes(3, gurobi.Continuous)
+	if err != nil {
+		t.Errorf("There was an issue adding variables: %v", err)
+	}
+
+	alias := &gurobi.Var{Model: model, Index: vars[2].Index}
+	expr := gurobi.NewLinExprFromMap(map[*gurobi.Var]float64{
+		vars[2]: 1.0,
+		vars[0]: 2.0,
+		alias:   3.0,
+	}, 5.0)
+
+	if len(expr.Ind) != 2 {
+		t.Fatalf("expected 2 terms; received %v", len(expr.Ind))
+	}
+	if expr.Ind[0].Index != 0 || expr.Val[0] != 2.0 {
+		t.Errorf("expected the first term 2 * x0; received %v * x%v", expr.Val[0], expr.Ind[0].Index)
+	}
+	if expr.Ind[1].Index != 2 || expr.Val[1] != 4.0 {
+		t.Errorf("expected the second term 4 * x2; received %v * x%v", expr.Val[1], expr.Ind[1].Index)
+	}
+	if expr.Offset != 5.0 {
+		t.Errorf("expected the offset 5; received %v", expr.Offset)
+	}
+}
+
+/*
+TestLinExpr_ToMap1
+Description:
+
+	Verifies that ToMap sums the coefficients of repeated variables.
+*/
+func TestLinExpr_ToMap1(t *testing.T) {
+	model := gurobi.NewDryRunModel("linexprmap2")
+	defer model.Free()
+
+	vars, err := model.AddVarsWithTypes(2, gurobi.Continuous)
+	if err != nil {
+		t.Errorf("There was an issue adding variables: %v", err)
+	}
+
+	expr := &gurobi.LinExpr{}
+	expr.AddTerm(vars[0], 1.0).AddTerm(vars[1], 2.0).AddTerm(vars[0], -0.5).AddConstant(3.0)
+
+	coeffs := expr.ToMap()
+	if len(coeffs) != 2 {
+		t.Errorf("expected 2 variables; received %v", len(coeffs))
+	}
+	if coeffs[vars[0]] != 0.5 {
+		t.Errorf("expected the coefficient 0.5 for x0; received %v", coeffs[vars[0]])
+	}
+	if coeffs[vars[1]] != 2.0 {
+		t.Errorf("expected the coefficient 2 for x1; received %v", coeffs[vars[1]])
+	}
+}
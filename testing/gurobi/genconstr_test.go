@@ -0,0 +1,107 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+genconstr_test.go
+Description:
+	Tests the getters for the structure of general constraints.
+*/
+
+/*
+TestGenConstrType_String1
+Description:
+
+	Tests that the general constraint types have readable names.
+*/
+func TestGenConstrType_String1(t *testing.T) {
+	if gurobi.GenConstrIndicator.String() != "Indicator" || gurobi.GenConstrPWL.String() != "PWL" {
+		t.Errorf("unexpected names: %v, %v", gurobi.GenConstrIndicator, gurobi.GenConstrPWL)
+	}
+	if gurobi.GenConstrType(100).String() != "GenConstrType(100)" {
+		t.Errorf("unexpected name of an unknown type: %v", gurobi.GenConstrType(100))
+	}
+}
+
+/*
+TestGenConstr_Data1
+Description:
+
+	Tests that indicator, max and PWL constraints can be read back from a model.
+*/
+func TestGenConstr_Data1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("genconstr1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("genconstr1.log")
+
+	model, err := gurobi.NewModel("genconstr1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	z, err := model.AddVar(gurobi.Binary, 0.0, 0.0, 1.0, "z", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding z: %v", err)
+	}
+	x, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	y, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 10.0, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+
+	ind, err := model.AddGenConstrIndicator(z, 1, []*gurobi.Var{x, y}, []float64{1.0, 2.0}, gurobi.Le, 4.0, "ind")
+	if err != nil {
+		t.Errorf("There was an issue adding the indicator constraint: %v", err)
+	}
+	max, err := model.AddGenConstrMax(y, []*gurobi.Var{x}, 1.5, "max")
+	if err != nil {
+		t.Errorf("There was an issue adding the max constraint: %v", err)
+	}
+	pwl, err := model.AddGenConstrPWL(x, y, []float64{0.0, 5.0, 10.0}, []float64{0.0, 1.0, 4.0}, "pwl")
+	if err != nil {
+		t.Errorf("There was an issue adding the PWL constraint: %v", err)
+	}
+	if err := model.Update(); err != nil {
+		t.Errorf("There was an issue updating the model: %v", err)
+	}
+
+	// Test
+	indData, err := ind.Indicator()
+	if err != nil {
+		t.Errorf("There was an issue reading the indicator constraint: %v", err)
+	} else if indData.BinVar.Index != z.Index || indData.BinVal != 1 || len(indData.Vars) != 2 ||
+		indData.Vals[1] != 2.0 || indData.Sense != gurobi.Le || indData.RHS != 4.0 {
+		t.Errorf("unexpected indicator data: %+v", indData)
+	}
+
+	data, err := max.Data()
+	if err != nil {
+		t.Errorf("There was an issue reading the max constraint: %v", err)
+	} else if mm, ok := data.(*gurobi.MinMaxData); !ok || !mm.IsMax || mm.ResVar.Index != y.Index || mm.Constant != 1.5 {
+		t.Errorf("unexpected max data: %+v", data)
+	}
+
+	pwlData, err := pwl.PWL()
+	if err != nil {
+		t.Errorf("There was an issue reading the PWL constraint: %v", err)
+	} else if len(pwlData.XPts) != 3 || pwlData.YPts[2] != 4.0 {
+		t.Errorf("unexpected PWL data: %+v", pwlData)
+	}
+
+	if _, err := pwl.Indicator(); err == nil {
+		t.Errorf("expected an error when reading a PWL constraint as an indicator constraint")
+	}
+}
@@ -0,0 +1,140 @@
+package gurobi_test
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+goals_test.go
+Description:
+	Tests the goal report of soft constraints.
+*/
+
+/*
+TestGoalReport_Share1
+Description:
+
+	Tests the shares, the violated goals and the table of a report with one
+	met and two missed goals.
+*/
+func TestGoalReport_Share1(t *testing.T) {
+	// Constants
+	report := &gurobi.GoalReport{
+		Goals: []gurobi.SoftConstrReport{
+			{Name: "cap", Weight: 2.0, Over: 1.0, Violation: 1.0, Penalty: 2.0},
+			{Name: "target", Weight: 1.0, Under: 6.0, Violation: 6.0, Penalty: 6.0},
+			{Name: "floor", Weight: 3.0},
+		},
+		Duals:        []float64{-2.0, 1.0, math.NaN()},
+		TotalPenalty: 8.0,
+		ObjVal:       20.0,
+	}
+
+	// Algorithm
+	var buf bytes.Buffer
+	err := report.Write(&buf)
+
+	// Test
+	if report.Share(0) != 0.25 || report.Share(1) != 0.75 || report.Share(2) != 0 {
+		t.Errorf("unexpected shares: %v, %v, %v", report.Share(0), report.Share(1), report.Share(2))
+	}
+	if violated := report.Violated(1.0); len(violated) != 1 || violated[0].Name != "target" {
+		t.Errorf("expected only the target to be violated by more than 1; received %v", violated)
+	}
+	if err != nil {
+		t.Errorf("There was an issue writing the report: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 6 || !strings.HasPrefix(lines[0], "GOAL") || !strings.Contains(lines[2], "75.0%") || !strings.HasPrefix(lines[4], "TOTAL") {
+		t.Errorf("unexpected table:\n%v", buf.String())
+	}
+}
+
+/*
+TestModel_GoalReport1
+Description:
+
+	Tests that a model without soft constraints has no goal report.
+*/
+func TestModel_GoalReport1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("goals1")
+
+	// Algorithm
+	_, err := model.GoalReport()
+
+	// Test
+	if err == nil {
+		t.Errorf("expected an error for a model without soft constraints, but none were thrown!")
+	}
+}
+
+/*
+TestModel_GoalReport2
+Description:
+
+	Maximizes x + y with x, y <= 3, a soft cap x + y <= 4 of weight 0.5 and
+	a soft floor x >= 1 of weight 1, and checks that only the cap is
+	violated and accounts for the whole penalty.
+*/
+func TestModel_GoalReport2(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("goals2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("goals2.log")
+
+	model, err := gurobi.NewModel("goals2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 3.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	y, err := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 3.0, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+	sum := &gurobi.LinExpr{}
+	sum.AddTerm(x, 1.0).AddTerm(y, 1.0)
+	floor := &gurobi.LinExpr{}
+	floor.AddTerm(x, 1.0)
+
+	if _, err := model.AddSoftConstr(sum.LessEq(4.0), 0.5, "cap"); err != nil {
+		t.Errorf("There was an issue adding the cap: %v", err)
+	}
+	if _, err := model.AddSoftConstr(floor.GreaterEq(1.0), 1.0, "floor"); err != nil {
+		t.Errorf("There was an issue adding the floor: %v", err)
+	}
+
+	// Algorithm
+	if err := model.SetObjective(sum, gurobi.Maximize); err != nil {
+		t.Errorf("There was an issue setting the objective: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing: %v", err)
+	}
+	report, err := model.GoalReport()
+
+	// Test
+	if err != nil || len(report.Goals) != 2 || len(report.Duals) != 2 {
+		t.Fatalf("unexpected report: %+v (%v)", report, err)
+	}
+	if violated := report.Violated(1e-6); len(violated) != 1 || violated[0].Name != "cap" {
+		t.Errorf("expected only the cap to be violated; received %v", violated)
+	}
+	if math.Abs(report.Share(0)-1.0) > 1e-6 || math.Abs(report.ObjVal-5.0) > 1e-6 {
+		t.Errorf("expected the cap to account for the whole penalty and an objective of 5; received %+v", report)
+	}
+}
@@ -0,0 +1,222 @@
+package gurobi_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+iis_test.go
+Description:
+	Tests the IIS functions.
+*/
+
+/*
+TestIIS_Equal1
+Description:
+
+	Verifies that Equal() compares all member lists and that Size() counts them.
+*/
+func TestIIS_Equal1(t *testing.T) {
+	// Constants
+	iis1 := &gurobi.IIS{Constrs: []int32{0, 2}, LowerBounds: []int32{1}}
+	iis2 := &gurobi.IIS{Constrs: []int32{0, 2}, LowerBounds: []int32{1}}
+	iis3 := &gurobi.IIS{Constrs: []int32{0, 2}, UpperBounds: []int32{1}}
+
+	// Test
+	if !iis1.Equal(iis2) {
+		t.Errorf("expected %v and %v to be equal", iis1, iis2)
+	}
+
+	if iis1.Equal(iis3) {
+		t.Errorf("expected %v and %v to differ", iis1, iis3)
+	}
+
+	if iis1.Size() != 3 {
+		t.Errorf("expected size 3; received %v", iis1.Size())
+	}
+}
+
+/*
+TestModel_ComputeIISes1
+Description:
+
+	Verifies that ComputeIISes() throws an error when max is less than 1.
+*/
+func TestModel_ComputeIISes1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("iis1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+
+	model, err := gurobi.NewModel("iis1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	// Test
+	if _, err := model.ComputeIISes(0); err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
+
+/*
+TestModel_WriteIIS1
+Description:
+
+	Writes the IIS of x >= 2, x <= 1 and verifies that the .ilp file only
+	contains the conflicting constraints, and that other extensions are rejected.
+*/
+func TestModel_WriteIIS1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("writeiis1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("writeiis1.log")
+
+	model, err := gurobi.NewModel("writeiis1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	y, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 10.0, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	for _, c := range []struct {
+		vars  []*gurobi.Var
+		sense gurobi.Sense
+		rhs   float64
+		name  string
+	}{
+		{[]*gurobi.Var{x}, gurobi.Ge, 2.0, "low"},
+		{[]*gurobi.Var{x}, gurobi.Le, 1.0, "high"},
+		{[]*gurobi.Var{y}, gurobi.Le, 5.0, "unrelated"},
+	} {
+		if _, err := model.AddConstr(c.vars, []float64{1.0}, c.sense, c.rhs, c.name); err != nil {
+			t.Errorf("There was an issue adding a constraint to the model: %v", err)
+		}
+	}
+
+	if err := model.WriteIIS("writeiis1.lp"); err == nil {
+		t.Errorf("expected an error for a .lp file, but none were thrown!")
+	}
+
+	if err := model.WriteIIS("writeiis1.ilp"); err != nil {
+		t.Errorf("There was an issue writing the IIS: %v", err)
+	}
+	defer os.Remove("writeiis1.ilp")
+
+	contents, err := os.ReadFile("writeiis1.ilp")
+	if err != nil {
+		t.Errorf("There was an issue reading the IIS: %v", err)
+	}
+	if !strings.Contains(string(contents), "low") || !strings.Contains(string(contents), "high") {
+		t.Errorf("expected the IIS to contain both conflicting constraints:\n%s", contents)
+	}
+	if strings.Contains(string(contents), "unrelated") {
+		t.Errorf("expected the IIS to not contain the unrelated constraint:\n%s", contents)
+	}
+}
+
+/*
+TestIIS_Equal2
+Description:
+
+	Verifies that Equal() and Size() also cover the general, SOS and
+	quadratic constraints, and the string form of IIS members.
+*/
+func TestIIS_Equal2(t *testing.T) {
+	// Constants
+	iis1 := &gurobi.IIS{Constrs: []int32{0}, GenConstrs: []int32{1}, QConstrs: []int32{2}}
+	iis2 := &gurobi.IIS{Constrs: []int32{0}, SOS: []int32{1}, QConstrs: []int32{2}}
+
+	// Test
+	if iis1.Equal(iis2) {
+		t.Errorf("expected %v and %v to differ", iis1, iis2)
+	}
+	if iis1.Size() != 3 {
+		t.Errorf("expected size 3; received %v", iis1.Size())
+	}
+
+	named := gurobi.IISMember{Kind: gurobi.IISMemberLowerBound, Index: 4, Name: "x"}
+	if named.String() != "LowerBound x" {
+		t.Errorf("expected \"LowerBound x\"; received %q", named.String())
+	}
+	unnamed := gurobi.IISMember{Kind: gurobi.IISMemberSOS, Index: 1}
+	if unnamed.String() != "SOS 1" {
+		t.Errorf("expected \"SOS 1\"; received %q", unnamed.String())
+	}
+}
+
+/*
+TestModel_IISMembers1
+Description:
+
+	Computes the IIS of x >= 2, x <= 1 and verifies that its members are
+	listed with the names of the conflicting constraints.
+*/
+func TestModel_IISMembers1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("iismembers1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("iismembers1.log")
+
+	model, err := gurobi.NewModel("iismembers1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	if _, err := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.Ge, 2.0, "low"); err != nil {
+		t.Errorf("There was an issue adding a constraint to the model: %v", err)
+	}
+	if _, err := model.AddConstr([]*gurobi.Var{x}, []float64{1.0}, gurobi.Le, 1.0, "high"); err != nil {
+		t.Errorf("There was an issue adding a constraint to the model: %v", err)
+	}
+
+	// Algorithm
+	iis, err := model.ComputeIIS()
+	if err != nil {
+		t.Errorf("There was an issue computing the IIS: %v", err)
+	}
+	members, err := model.IISMembers(iis)
+	if err != nil {
+		t.Errorf("There was an issue listing the members of the IIS: %v", err)
+	}
+
+	// Test
+	if iis == nil || !iis.Minimal {
+		t.Errorf("expected a minimal IIS; received %v", iis)
+	}
+	names := []string{}
+	for _, member := range members {
+		if member.Kind != gurobi.IISMemberConstr {
+			t.Errorf("expected only constraints in the IIS; received %v", member)
+		}
+		names = append(names, member.Name)
+	}
+	if strings.Join(names, ",") != "low,high" {
+		t.Errorf("expected the constraints low and high; received %v", names)
+	}
+}
@@ -0,0 +1,41 @@
+package gurobi_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+incumbent_test.go
+Description:
+	Tests the incumbent history functions.
+*/
+
+/*
+TestIncumbentHistory_NewIncumbentHistory1
+Description:
+
+	Verifies that NewIncumbentHistory() creates the history directory.
+*/
+func TestIncumbentHistory_NewIncumbentHistory1(t *testing.T) {
+	// Constants
+	dir := filepath.Join(t.TempDir(), "history", "run1")
+
+	// Algorithm
+	ih, err := gurobi.NewIncumbentHistory(dir)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// Test
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		t.Errorf("expected the directory %v to be created", dir)
+	}
+
+	if len(ih.Records) != 0 {
+		t.Errorf("expected no records; received %v", ih.Records)
+	}
+}
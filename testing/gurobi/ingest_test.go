@@ -0,0 +1,141 @@
+package gurobi_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+ingest_test.go
+Description:
+	Tests building ModelSpecs from CSV rows and slices of structs.
+*/
+
+/*
+TestModelSpec_AddRows1
+Description:
+
+	Builds a diet problem from a CSV file with one food per row and checks
+	the variables and the nutrient constraint.
+*/
+func TestModelSpec_AddRows1(t *testing.T) {
+	// Constants
+	input := "food,cost,max,protein\n" +
+		"bread,2.0,10,4\n" +
+		"milk,3.5,,8\n" +
+		"apple,1.0,5,\n"
+	rowSpec := gurobi.RowSpec{
+		Name: "food",
+		UB:   "max",
+		Obj:  "cost",
+		Constrs: []gurobi.RowConstr{
+			{Name: "protein", Coef: "protein", Sense: gurobi.Ge, RHS: 50.0},
+		},
+	}
+
+	// Algorithm
+	rows, err := gurobi.ReadRowsCSV(strings.NewReader(input))
+	if err != nil {
+		t.Errorf("There was an issue reading the CSV input: %v", err)
+	}
+	spec := gurobi.NewModelSpec("diet")
+	indices, err := spec.AddRows(rows, rowSpec, "food")
+	if err != nil {
+		t.Errorf("There was an issue adding the rows: %v", err)
+	}
+
+	// Test
+	if len(indices) != 3 || len(spec.Vars) != 3 || len(spec.Constrs) != 1 {
+		t.Fatalf("unexpected spec: %+v", spec)
+	}
+	if milk := spec.Vars[1]; milk.Name != "milk" || milk.Obj != 3.5 || milk.UB != gurobi.INFINITY || milk.Type != gurobi.Continuous {
+		t.Errorf("unexpected variable for milk: %+v", milk)
+	}
+	if protein := spec.Constrs[0]; len(protein.Ind) != 2 || protein.Val[1] != 8.0 || protein.RHS != 50.0 {
+		t.Errorf("expected the apple to be left out of the protein constraint; received %+v", protein)
+	}
+	if err := spec.Check(); err != nil {
+		t.Errorf("There was an issue checking the spec: %v", err)
+	}
+}
+
+/*
+TestModelSpec_AddRows2
+Description:
+
+	Builds an assignment problem from a slice of structs, with one
+	"exactly one plant" constraint per customer.
+*/
+func TestModelSpec_AddRows2(t *testing.T) {
+	// Constants
+	type arc struct {
+		Customer string  `csv:"customer"`
+		Plant    string  `csv:"plant"`
+		Cost     float64 `csv:"cost"`
+		Note     string  `csv:"-"`
+	}
+	arcs := []arc{
+		{"a", "p1", 3.0, ""},
+		{"a", "p2", 1.0, ""},
+		{"b", "p1", 2.0, "far"},
+	}
+	rowSpec := gurobi.RowSpec{
+		Obj: "cost",
+		Constrs: []gurobi.RowConstr{
+			{Name: "assign", GroupBy: "customer", Sense: gurobi.Eq, RHS: 1.0},
+		},
+	}
+
+	// Algorithm
+	rows, err := gurobi.RowsFromStructs(arcs)
+	if err != nil {
+		t.Errorf("There was an issue converting the structs: %v", err)
+	}
+	spec := gurobi.NewModelSpec("assignment")
+	spec.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "other")
+	indices, err := spec.AddRows(rows, rowSpec, "arc")
+	if err != nil {
+		t.Errorf("There was an issue adding the rows: %v", err)
+	}
+
+	// Test
+	if _, ok := rows[2]["Note"]; ok {
+		t.Errorf("expected the skipped field to be left out of the rows; received %v", rows[2])
+	}
+	if len(indices) != 3 || indices[0] != 1 || spec.Vars[3].Name != "arc[2]" {
+		t.Errorf("unexpected variables: %v, %+v", indices, spec.Vars)
+	}
+	if len(spec.Constrs) != 2 || spec.Constrs[0].Name != "assign[a]" || len(spec.Constrs[0].Ind) != 2 || spec.Constrs[1].Ind[0] != 3 {
+		t.Errorf("unexpected constraints: %+v", spec.Constrs)
+	}
+}
+
+/*
+TestModelSpec_AddRows3
+Description:
+
+	Verifies that a missing column and an invalid type are rejected without
+	changing the spec.
+*/
+func TestModelSpec_AddRows3(t *testing.T) {
+	// Constants
+	rows := []gurobi.Row{{"name": "x", "kind": "Q"}}
+	spec := gurobi.NewModelSpec("invalid")
+
+	// Algorithm
+	_, errMissing := spec.AddRows(rows, gurobi.RowSpec{Name: "name", UB: "upper"}, "x")
+	_, errType := spec.AddRows(rows, gurobi.RowSpec{Name: "name", Type: "kind"}, "x")
+
+	// Test
+	if errMissing == nil {
+		t.Errorf("expected an error for a missing column, but none were thrown!")
+	}
+	if errType == nil {
+		t.Errorf("expected an error for an invalid variable type, but none were thrown!")
+	}
+	if len(spec.Vars) != 0 {
+		t.Errorf("expected the spec to be unchanged; received %+v", spec.Vars)
+	}
+}
@@ -0,0 +1,103 @@
+package gurobi_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+license_test.go
+Description:
+	Tests the license file inspection helpers in the gurobi package.
+*/
+
+/*
+TestParseLicense1
+Description:
+
+	Tests that a named-user license file is parsed correctly.
+*/
+func TestParseLicense1(t *testing.T) {
+	// Constants
+	contents := `# DO NOT EDIT THIS FILE
+TYPE=ACADEMIC
+VERSION=10
+EXPIRATION=2026-11-01
+LICENSEID=123456
+`
+
+	// Test
+	info, err := gurobi.ParseLicense(strings.NewReader(contents))
+	if err != nil {
+		t.Errorf("unexpected error parsing the license: %v", err)
+	}
+
+	if info.Type != "ACADEMIC" || info.Version != "10" || info.LicenseID != "123456" {
+		t.Errorf("unexpected license info: %+v", info)
+	}
+
+	if !info.Expires() || info.Expiration.Format("2006-01-02") != "2026-11-01" {
+		t.Errorf("unexpected expiration: %v", info.Expiration)
+	}
+}
+
+/*
+TestParseLicense2
+Description:
+
+	Tests that a WLS license is detected and that its secret is redacted.
+*/
+func TestParseLicense2(t *testing.T) {
+	// Constants
+	contents := "WLSACCESSID=abc\nWLSSECRET=supersecret\nLICENSEID=42\n"
+
+	// Test
+	info, err := gurobi.ParseLicense(strings.NewReader(contents))
+	if err != nil {
+		t.Errorf("unexpected error parsing the license: %v", err)
+	}
+
+	if !info.IsWLS() || info.Type != "WLS" {
+		t.Errorf("expected a WLS license; received %+v", info)
+	}
+
+	if info.Fields["WLSSECRET"] == "supersecret" {
+		t.Errorf("expected the WLS secret to be redacted")
+	}
+
+	if info.Expires() {
+		t.Errorf("did not expect a WLS license without EXPIRATION to expire")
+	}
+}
+
+/*
+TestLicenseInfo_CheckExpiry1
+Description:
+
+	Tests that CheckExpiry() warns within the window and reports an expired license afterwards.
+*/
+func TestLicenseInfo_CheckExpiry1(t *testing.T) {
+	// Constants
+	info := gurobi.LicenseInfo{Expiration: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)}
+
+	// Test
+	if err := info.CheckExpiry(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), gurobi.DefaultLicenseExpiryWarning); err != nil {
+		t.Errorf("did not expect a warning five months before expiry; received %v", err)
+	}
+
+	err := info.CheckExpiry(time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), gurobi.DefaultLicenseExpiryWarning)
+	var warning gurobi.LicenseExpiryWarning
+	if !errors.As(err, &warning) {
+		t.Errorf("expected a LicenseExpiryWarning; received %v", err)
+	}
+
+	err = info.CheckExpiry(time.Date(2026, 11, 3, 0, 0, 0, 0, time.UTC), gurobi.DefaultLicenseExpiryWarning)
+	var expired gurobi.LicenseExpiredError
+	if !errors.As(err, &expired) {
+		t.Errorf("expected a LicenseExpiredError; received %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+listener_test.go
+Description:
+	Tests the listeners which are notified of changes to a model.
+*/
+
+/*
+TestModel_AddListener1
+Description:
+
+	Tests that additions, attribute changes and removals are reported, and
+	that a removed listener is no longer notified.
+*/
+func TestModel_AddListener1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("listener1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("listener1.log")
+
+	model, err := gurobi.NewModel("listener1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	events := []gurobi.ModelEvent{}
+	remove := model.AddListener(gurobi.ModelListenerFunc(func(event gurobi.ModelEvent) {
+		events = append(events, event)
+	}))
+
+	// Algorithm
+	vars, err := model.AddVarsWithTypes(2, gurobi.Continuous)
+	if err != nil {
+		t.Errorf("There was an issue adding variables: %v", err)
+	}
+	if _, err := model.AddConstr(vars, []float64{1, 1}, gurobi.Le, 1.0, "drop_me"); err != nil {
+		t.Errorf("There was an issue adding a constraint: %v", err)
+	}
+	if err := vars[1].SetDouble("UB", 5.0); err != nil {
+		t.Errorf("There was an issue setting an upper bound: %v", err)
+	}
+	if _, err := model.RemoveWhere(func(name string, kind gurobi.ItemKind) bool { return name == "drop_me" }); err != nil {
+		t.Errorf("There was an issue removing the constraint: %v", err)
+	}
+	remove()
+	if err := model.SetModelSense(gurobi.Maximize); err != nil {
+		t.Errorf("There was an issue setting the model sense: %v", err)
+	}
+
+	// Test
+	expected := []gurobi.ModelEvent{
+		{Kind: gurobi.EventVarsAdded, Indices: []int32{0, 1}},
+		{Kind: gurobi.EventConstrsAdded, Indices: []int32{0}},
+		{Kind: gurobi.EventElementAttrChanged, Attr: "UB", Indices: []int32{1}},
+		{Kind: gurobi.EventConstrsRemoved, Indices: []int32{0}},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("expected %v events; received %v", expected, events)
+	}
+	for i, event := range events {
+		if event.Kind != expected[i].Kind || event.Attr != expected[i].Attr || len(event.Indices) != len(expected[i].Indices) {
+			t.Errorf("event %v: expected %+v; received %+v", i, expected[i], event)
+			continue
+		}
+		for k := range event.Indices {
+			if event.Indices[k] != expected[i].Indices[k] {
+				t.Errorf("event %v: expected %+v; received %+v", i, expected[i], event)
+			}
+		}
+	}
+}
+
+/*
+TestModel_AddListener2
+Description:
+
+	Tests that listeners can be removed in any order and that removing one
+	twice is harmless.
+*/
+func TestModel_AddListener2(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("listener2")
+	noop := gurobi.ModelListenerFunc(func(event gurobi.ModelEvent) {})
+
+	// Test
+	removeA := model.AddListener(noop)
+	removeB := model.AddListener(noop)
+	removeA()
+	removeA()
+	removeB()
+	model.AddListener(nil)()
+
+	if gurobi.EventVarsRemoved.String() != "VarsRemoved" || gurobi.ModelEventKind(99).String() != "ModelEventKind(99)" {
+		t.Errorf("unexpected names: %v, %v", gurobi.EventVarsRemoved, gurobi.ModelEventKind(99))
+	}
+}
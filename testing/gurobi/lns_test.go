@@ -0,0 +1,116 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+lns_test.go
+Description:
+	Tests the large neighborhood search driver.
+*/
+
+/*
+TestLNSConfig_Check1
+Description:
+
+	Verifies that the default configuration is valid and that invalid
+	iteration counts, time limits and fractions are rejected.
+*/
+func TestLNSConfig_Check1(t *testing.T) {
+	if err := gurobi.DefaultLNSConfig().Check(); err != nil {
+		t.Errorf("expected the default configuration to be valid; received %v", err)
+	}
+
+	for _, modify := range []func(*gurobi.LNSConfig){
+		func(c *gurobi.LNSConfig) { c.Iterations = 0 },
+		func(c *gurobi.LNSConfig) { c.SubTimeLimit = 0 },
+		func(c *gurobi.LNSConfig) { c.FixFraction = 1.0 },
+	} {
+		config := gurobi.DefaultLNSConfig()
+		modify(&config)
+		if err := config.Check(); err == nil {
+			t.Errorf("expected an error for %+v, but none were thrown!", config)
+		}
+	}
+}
+
+/*
+TestModel_LNS1
+Description:
+
+	Starts from a poor incumbent of a small knapsack problem and verifies
+	that LNS never makes the incumbent worse and restores the bounds.
+*/
+func TestModel_LNS1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("lns1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("lns1.log")
+
+	model, err := gurobi.NewModel("lns1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	values := []float64{10, 13, 7, 8, 9, 4}
+	weights := []float64{5, 6, 3, 4, 4, 2}
+	vars := make([]*gurobi.Var, len(values))
+	for i := range values {
+		if vars[i], err = model.AddVar(gurobi.Binary, values[i], 0.0, 1.0, "", []*gurobi.Constr{}, []float64{}); err != nil {
+			t.Errorf("There was an issue adding a variable to the model: %v", err)
+		}
+	}
+	if _, err := model.AddConstr(vars, weights, gurobi.Le, 12.0, "capacity"); err != nil {
+		t.Errorf("There was an issue adding a constraint to the model: %v", err)
+	}
+	if err := model.SetMaximize(); err != nil {
+		t.Errorf("unexpected error setting the model sense: %v", err)
+	}
+
+	// Find a first (poor) incumbent.
+	if err := model.SetIntParam("SolutionLimit", 1); err != nil {
+		t.Errorf("There was an issue setting the solution limit: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+	initial, err := model.ObjVal()
+	if err != nil {
+		t.Errorf("There was an issue getting the objective: %v", err)
+	}
+	if err := model.SetIntParam("SolutionLimit", 2000000000); err != nil {
+		t.Errorf("There was an issue resetting the solution limit: %v", err)
+	}
+
+	config := gurobi.DefaultLNSConfig()
+	config.Iterations = 5
+	config.SubTimeLimit = time.Second
+	config.FixFraction = 0.5
+	result, err := model.LNS(config)
+	if err != nil {
+		t.Errorf("There was an issue running LNS: %v", err)
+	}
+
+	if result.Objective < initial || len(result.Objectives) != 5 {
+		t.Errorf("unexpected LNS result %+v for the initial objective %v", result, initial)
+	}
+
+	for _, v := range vars {
+		ub, err := v.GetDouble("UB")
+		if err != nil {
+			t.Errorf("There was an issue getting an upper bound: %v", err)
+		}
+		if ub != 1.0 {
+			t.Errorf("expected the upper bound to be restored; received %v", ub)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package gurobi_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+logrotation_test.go
+Description:
+	Tests the per-solve log files and the rotation of log files.
+*/
+
+/*
+TestModel_EnableLogRotation1
+Description:
+
+	Verifies that EnableLogRotation and EnablePerSolveLogs validate their arguments.
+*/
+func TestModel_EnableLogRotation1(t *testing.T) {
+	model := gurobi.NewDryRunModel("logrotation1")
+	defer model.Free()
+
+	if _, err := model.EnableLogRotation("", 1024, 3); err == nil {
+		t.Errorf("expected an error for an empty path, but none were thrown!")
+	}
+	if _, err := model.EnableLogRotation("gurobi.log", 0, 3); err == nil {
+		t.Errorf("expected an error for a maximum size of 0, but none were thrown!")
+	}
+	if _, err := model.EnableLogRotation("gurobi.log", 1024, -1); err == nil {
+		t.Errorf("expected an error for a negative number of kept logs, but none were thrown!")
+	}
+	if _, err := model.EnablePerSolveLogs(""); err == nil {
+		t.Errorf("expected an error for an empty directory, but none were thrown!")
+	}
+}
+
+/*
+TestModel_EnablePerSolveLogs1
+Description:
+
+	Solves a model twice and verifies that each solve wrote its own log file.
+*/
+func TestModel_EnablePerSolveLogs1(t *testing.T) {
+	dir, err := os.MkdirTemp("", "solvelogs")
+	if err != nil {
+		t.Errorf("There was an issue creating a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Create environment.
+	env, err := gurobi.NewEnv("persolvelogs1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("persolvelogs1.log")
+
+	model, err := gurobi.NewModel("persolvelogs1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	if _, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, 3.0, "x", []*gurobi.Constr{}, []float64{}); err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+
+	lr, err := model.EnablePerSolveLogs(dir)
+	if err != nil {
+		t.Errorf("There was an issue enabling per-solve logs: %v", err)
+	}
+
+	logs := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		if err := model.Optimize(); err != nil {
+			t.Errorf("There was an issue optimizing the model: %v", err)
+		}
+		logs[lr.LastLog] = true
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.log"))
+	if err != nil {
+		t.Errorf("There was an issue listing the logs: %v", err)
+	}
+	if len(logs) != 2 || len(files) != 2 {
+		t.Errorf("expected two log files; received %v", files)
+	}
+}
@@ -0,0 +1,123 @@
+package gurobi_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+lpformat_test.go
+Description:
+	Tests the pure-Go LP reader.
+*/
+
+const lpModel = `\ A small MIQP with every supported section
+Maximize
+  obj: 3 x + 2 y - z + 5 + [ 2 x ^ 2 + 4 x * y ] / 2
+Subject To
+  c0: x + y
+      + z <= 10
+  c1: x - y >= -2
+  2 x + 3 z = 6
+Bounds
+  x <= 4
+  -1 <= z <= 1
+  y free
+Binaries
+  b
+Generals
+  y
+End
+`
+
+/*
+TestReadModelSpecLP1
+Description:
+
+	Tests that the objective, constraints spanning several lines, bounds and
+	variable types are read.
+*/
+func TestReadModelSpecLP1(t *testing.T) {
+	// Algorithm
+	spec, err := gurobi.ReadModelSpecLP(strings.NewReader(lpModel))
+	if err != nil {
+		t.Fatalf("There was an issue reading the LP model: %v", err)
+	}
+
+	// Test
+	if spec.Sense != gurobi.Maximize || spec.ObjCon != 5.0 {
+		t.Errorf("unexpected sense or constant: %v, %v", spec.Sense, spec.ObjCon)
+	}
+	if len(spec.Vars) != 4 {
+		t.Fatalf("expected 4 variables; received %v", len(spec.Vars))
+	}
+	x, y, z, b := spec.Vars[0], spec.Vars[1], spec.Vars[2], spec.Vars[3]
+	if x.Name != "x" || x.Obj != 3.0 || x.LB != 0.0 || x.UB != 4.0 {
+		t.Errorf("unexpected x: %+v", x)
+	}
+	if y.Type != gurobi.Integer || y.Obj != 2.0 || y.LB != -gurobi.INFINITY || y.UB != gurobi.INFINITY {
+		t.Errorf("unexpected y: %+v", y)
+	}
+	if z.Obj != -1.0 || z.LB != -1.0 || z.UB != 1.0 {
+		t.Errorf("unexpected z: %+v", z)
+	}
+	if b.Type != gurobi.Binary || b.LB != 0.0 || b.UB != 1.0 {
+		t.Errorf("unexpected b: %+v", b)
+	}
+
+	if len(spec.Constrs) != 3 {
+		t.Fatalf("expected 3 constraints; received %v", len(spec.Constrs))
+	}
+	c0, c1, r2 := spec.Constrs[0], spec.Constrs[1], spec.Constrs[2]
+	if c0.Name != "c0" || len(c0.Ind) != 3 || c0.Sense != gurobi.Le || c0.RHS != 10.0 {
+		t.Errorf("unexpected c0: %+v", c0)
+	}
+	if c1.Val[1] != -1.0 || c1.Sense != gurobi.Ge || c1.RHS != -2.0 {
+		t.Errorf("unexpected c1: %+v", c1)
+	}
+	if r2.Name != "R2" || r2.Val[0] != 2.0 || r2.Val[1] != 3.0 || r2.Sense != gurobi.Eq || r2.RHS != 6.0 {
+		t.Errorf("unexpected third constraint: %+v", r2)
+	}
+
+	// [ 2 x^2 + 4 x y ] / 2 = x^2 + 2 x y
+	if len(spec.QObj) != 2 || spec.QObj[0] != (gurobi.QTermSpec{Row: 0, Col: 0, Val: 1.0}) || spec.QObj[1] != (gurobi.QTermSpec{Row: 0, Col: 1, Val: 2.0}) {
+		t.Errorf("unexpected quadratic objective: %v", spec.QObj)
+	}
+}
+
+/*
+TestReadModelSpecLP2
+Description:
+
+	Tests the alternative spellings of comparisons and the rejection of
+	quadratic constraints, indicator constraints and unsupported sections.
+*/
+func TestReadModelSpecLP2(t *testing.T) {
+	// Constants
+	spec, err := gurobi.ReadModelSpecLP(strings.NewReader("Minimize\n x\nst\n x =>1.5e0\n x + y =< 3\nbounds\n x >= -inf\nend\n"))
+	if err != nil {
+		t.Fatalf("There was an issue reading the LP model: %v", err)
+	}
+
+	// Test
+	if spec.Constrs[0].Sense != gurobi.Ge || spec.Constrs[0].RHS != 1.5 || spec.Constrs[1].Sense != gurobi.Le {
+		t.Errorf("unexpected constraints: %+v", spec.Constrs)
+	}
+	if spec.Vars[0].LB != -gurobi.INFINITY {
+		t.Errorf("expected x to have no lower bound; received %v", spec.Vars[0].LB)
+	}
+
+	invalid := []string{
+		"Minimize\n x\nSubject To\n q: [ x ^ 2 ] <= 1\nEnd\n",
+		"Minimize\n x\nSubject To\n i: b = 1 -> x <= 1\nEnd\n",
+		"Minimize\n x\nSOS\n s1: S1 :: x:1 y:2\nEnd\n",
+		"Minimize\n x\nSubject To\n c: x + y\nEnd\n",
+	}
+	for _, lp := range invalid {
+		if _, err := gurobi.ReadModelSpecLP(strings.NewReader(lp)); err == nil {
+			t.Errorf("expected an error for %q, but none were thrown!", lp)
+		}
+	}
+}
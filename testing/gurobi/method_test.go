@@ -0,0 +1,66 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+method_test.go
+Description:
+	Tests the Method and Crossover types of the gurobi package.
+*/
+
+/*
+TestMethod_Check1
+Description:
+
+	Tests that Check() accepts every named method and rejects an unknown one.
+*/
+func TestMethod_Check1(t *testing.T) {
+	// Constants
+	methods := []gurobi.Method{
+		gurobi.MethodAuto, gurobi.MethodPrimalSimplex, gurobi.MethodDualSimplex,
+		gurobi.MethodBarrier, gurobi.MethodConcurrent, gurobi.MethodDetConcurrent,
+	}
+
+	// Test
+	for _, method := range methods {
+		if err := method.Check(); err != nil {
+			t.Errorf("unexpected error for method %v: %v", method, err)
+		}
+	}
+
+	if err := gurobi.Method(7).Check(); err == nil {
+		t.Errorf("expected an error for Method(7), but none were thrown!")
+	}
+}
+
+/*
+TestMethod_String1
+Description:
+
+	Tests the names of the methods.
+*/
+func TestMethod_String1(t *testing.T) {
+	if gurobi.MethodBarrier.String() != "Barrier" {
+		t.Errorf("unexpected name for MethodBarrier: %v", gurobi.MethodBarrier)
+	}
+}
+
+/*
+TestEnv_SetCrossover1
+Description:
+
+	Tests that an invalid crossover strategy is rejected before calling Gurobi.
+*/
+func TestEnv_SetCrossover1(t *testing.T) {
+	// Constants
+	var env0 *gurobi.Env
+
+	// Test
+	if err := env0.SetCrossover(gurobi.Crossover(9)); err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
@@ -236,7 +236,7 @@ func TestModel_AddVars1(t *testing.T) {
 	var model0 *gurobi.Model
 
 	// Test
-	_, err := model0.AddVars(
+	_, err := model0.AddVarsInt8(
 		[]int8{gurobi.CONTINUOUS},
 		[]float64{0.0},
 		[]float64{-1.0},
@@ -276,7 +276,7 @@ func TestModel_AddVars2(t *testing.T) {
 	}
 
 	// Test
-	_, err = model0.AddVars(
+	_, err = model0.AddVarsInt8(
 		[]int8{gurobi.CONTINUOUS, gurobi.INTEGER},
 		[]float64{0.0},
 		[]float64{-1.0},
@@ -324,7 +324,7 @@ func TestModel_AddVars3(t *testing.T) {
 	}
 
 	// Test
-	_, err = model0.AddVars(
+	_, err = model0.AddVarsInt8(
 		[]int8{gurobi.CONTINUOUS},
 		[]float64{0.0},
 		[]float64{-1.0, 1.0},
@@ -372,7 +372,7 @@ func TestModel_AddVars4(t *testing.T) {
 	}
 
 	// Test
-	_, err = model0.AddVars(
+	_, err = model0.AddVarsInt8(
 		[]int8{gurobi.CONTINUOUS},
 		[]float64{0.0},
 		[]float64{-1.0},
@@ -420,7 +420,7 @@ func TestModel_AddVars5(t *testing.T) {
 	}
 
 	// Test
-	_, err = model0.AddVars(
+	_, err = model0.AddVarsInt8(
 		[]int8{gurobi.CONTINUOUS},
 		[]float64{0.0},
 		[]float64{-1.0},
@@ -468,7 +468,7 @@ func TestModel_AddVars6(t *testing.T) {
 	}
 
 	// Test
-	_, err = model0.AddVars(
+	_, err = model0.AddVarsInt8(
 		[]int8{gurobi.CONTINUOUS},
 		[]float64{0.0},
 		[]float64{-1.0},
@@ -519,7 +519,7 @@ func TestModel_AddVars7(t *testing.T) {
 	}
 
 	// Test
-	_, err = model0.AddVars(
+	_, err = model0.AddVarsInt8(
 		[]int8{gurobi.CONTINUOUS},
 		[]float64{0.0},
 		[]float64{-1.0},
@@ -570,7 +570,7 @@ func TestModel_AddVars8(t *testing.T) {
 	}
 
 	// Test
-	vSlice0, err := model0.AddVars(
+	vSlice0, err := model0.AddVarsInt8(
 		[]int8{gurobi.CONTINUOUS},
 		[]float64{0.0},
 		[]float64{-1.0},
@@ -637,7 +637,7 @@ func TestModel_AddVars9(t *testing.T) {
 	)
 
 	// Test
-	vSlice0, err := model0.AddVars(
+	vSlice0, err := model0.AddVarsInt8(
 		[]int8{gurobi.CONTINUOUS, gurobi.CONTINUOUS},
 		[]float64{-1.0, -1.0},
 		[]float64{-1e3, -1e3},
@@ -709,7 +709,7 @@ func TestModel_AddConstrs1(t *testing.T) {
 	var model0 *gurobi.Model
 
 	// Test
-	_, err := model0.AddConstrs(
+	_, err := model0.AddConstrsInt8(
 		[][]*gurobi.Var{},
 		[][]float64{},
 		[]int8{},
@@ -749,7 +749,7 @@ func TestModel_AddConstrs2(t *testing.T) {
 	}
 
 	// Test
-	_, err = model0.AddConstrs(
+	_, err = model0.AddConstrsInt8(
 		[][]*gurobi.Var{},
 		[][]float64{{1.0}},
 		[]int8{},
@@ -794,7 +794,7 @@ func TestModel_AddConstrs3(t *testing.T) {
 	}
 
 	// Test
-	_, err = model0.AddConstrs(
+	_, err = model0.AddConstrsInt8(
 		[][]*gurobi.Var{},
 		[][]float64{},
 		[]int8{1},
@@ -839,7 +839,7 @@ func TestModel_AddConstrs4(t *testing.T) {
 	}
 
 	// Test
-	_, err = model0.AddConstrs(
+	_, err = model0.AddConstrsInt8(
 		[][]*gurobi.Var{},
 		[][]float64{},
 		[]int8{},
@@ -884,7 +884,7 @@ func TestModel_AddConstrs5(t *testing.T) {
 	}
 
 	// Test
-	_, err = model0.AddConstrs(
+	_, err = model0.AddConstrsInt8(
 		[][]*gurobi.Var{},
 		[][]float64{},
 		[]int8{},
@@ -929,7 +929,7 @@ func TestModel_AddConstrs6(t *testing.T) {
 	}
 
 	// Create variables
-	vSlice0, err := model0.AddVars(
+	vSlice0, err := model0.AddVarsInt8(
 		[]int8{gurobi.CONTINUOUS, gurobi.CONTINUOUS},
 		[]float64{-1.0, -1.0},
 		[]float64{-1e2, -1e2},
@@ -943,7 +943,7 @@ func TestModel_AddConstrs6(t *testing.T) {
 	}
 
 	// Test
-	_, err = model0.AddConstrs(
+	_, err = model0.AddConstrsInt8(
 		[][]*gurobi.Var{vSlice0},
 		[][]float64{{1.0, 1.0}},
 		[]int8{
@@ -992,3 +992,27 @@ func TestModel_AddConstrs6(t *testing.T) {
 	}
 
 }
+
+/*
+TestModel_AddConstr1
+Description:
+
+	Tests that AddConstr() rejects a coefficient slice which is shorter than
+	the variable slice, instead of passing it on to Gurobi.
+*/
+func TestModel_AddConstr1(t *testing.T) {
+	// Constants
+	model0 := gurobi.NewDryRunModel("testmodel-addconstr1")
+	vSlice0, err := model0.AddVarsWithTypes(2, gurobi.Continuous)
+	if err != nil {
+		t.Errorf("unexpected error: %v!", err)
+	}
+
+	// Test
+	_, err = model0.AddConstr(vSlice0, []float64{1.0}, gurobi.Le, 2.0, "test-constr1")
+	if err == nil {
+		t.Errorf("expected an error for mismatched lengths, but none were thrown!")
+	} else if err.Error() != (gurobi.MismatchedLengthError{Length1: 2, Name1: "vars", Length2: 1, Name2: "val"}).Error() {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+modelkind_test.go
+Description:
+	Tests the classification of models with Model.Kind.
+*/
+
+/*
+TestModel_Kind1
+Description:
+
+	Tests the classification of dry-run models as LP, MILP and MIQP.
+*/
+func TestModel_Kind1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("kind1")
+
+	vars, err := model.AddVarsWithTypes(2, gurobi.Continuous)
+	if err != nil {
+		t.Errorf("There was an issue adding variables: %v", err)
+	}
+
+	// Test
+	if kind, err := model.Kind(); err != nil || kind != gurobi.KindLP {
+		t.Errorf("expected an LP; received %v (%v)", kind, err)
+	}
+
+	if _, err := model.AddVarsWithTypes(1, gurobi.Binary); err != nil {
+		t.Errorf("There was an issue adding a binary variable: %v", err)
+	}
+	if kind, err := model.Kind(); err != nil || kind != gurobi.KindMILP || !kind.IsMIP() || kind.IsQuadratic() {
+		t.Errorf("expected a MILP; received %v (%v)", kind, err)
+	}
+
+	if numBin, err := model.NumBinVars(); err != nil || numBin != 1 {
+		t.Errorf("expected 1 binary variable; received %v (%v)", numBin, err)
+	}
+
+	obj := &gurobi.QuadExpr{}
+	obj.AddQTerm(vars[0], vars[1], 1.0)
+	if err := model.SetObjective(obj, gurobi.Minimize); err != nil {
+		t.Errorf("There was an issue setting the objective: %v", err)
+	}
+	if kind, err := model.Kind(); err != nil || kind != gurobi.KindMIQP {
+		t.Errorf("expected a MIQP; received %v (%v)", kind, err)
+	}
+}
+
+/*
+TestModelKind_String1
+Description:
+
+	Tests the names of the model kinds.
+*/
+func TestModelKind_String1(t *testing.T) {
+	if gurobi.KindMIQCP.String() != "MIQCP" || gurobi.ModelKind(42).String() != "Unknown" {
+		t.Errorf("unexpected names: %v, %v", gurobi.KindMIQCP, gurobi.ModelKind(42))
+	}
+}
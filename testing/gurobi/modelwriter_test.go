@@ -0,0 +1,169 @@
+package gurobi_test
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+modelwriter_test.go
+Description:
+	Tests the streaming model writer.
+*/
+
+/*
+TestModelWriter_Flush1
+Description:
+
+	Streams a chain of constraints x[i] - x[i+1] <= 1 into a dry-run model
+	with a small block size and checks the indices, the number of blocks and
+	the recorded statistics.
+*/
+func TestModelWriter_Flush1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("writer1")
+	defer model.Free()
+	numVars := 10
+
+	w, err := gurobi.NewModelWriter(model, 4)
+	if err != nil {
+		t.Errorf("There was an issue creating the writer: %v", err)
+	}
+
+	// Algorithm
+	for i := 0; i < numVars; i++ {
+		ind, err := w.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x")
+		if err != nil {
+			t.Errorf("There was an issue adding variable %v: %v", i, err)
+		}
+		if ind != int32(i) {
+			t.Errorf("expected variable index %v; received %v", i, ind)
+		}
+	}
+	for i := 0; i < numVars-1; i++ {
+		ind, err := w.AddConstr([]int32{int32(i), int32(i + 1)}, []float64{1.0, -1.0}, gurobi.Le, 1.0, "chain")
+		if err != nil {
+			t.Errorf("There was an issue adding constraint %v: %v", i, err)
+		}
+		if ind != int32(i) {
+			t.Errorf("expected constraint index %v; received %v", i, ind)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("There was an issue closing the writer: %v", err)
+	}
+
+	// Test
+	// 10 variables in blocks of 4 and 18 nonzeros in blocks of 4.
+	if w.Flushes() != 3+5 {
+		t.Errorf("expected 8 blocks; received %v", w.Flushes())
+	}
+	if w.NumNZs() != 18 {
+		t.Errorf("expected 18 nonzeros; received %v", w.NumNZs())
+	}
+	if len(model.Variables) != numVars || len(model.Constraints) != numVars-1 {
+		t.Errorf("expected %v variables and %v constraints; received %v and %v", numVars, numVars-1, len(model.Variables), len(model.Constraints))
+	}
+
+	dr := model.DryRun()
+	if dr.NumVars != numVars || dr.NumConstrs != numVars-1 || dr.NumNZs != 18 {
+		t.Errorf("unexpected statistics: %v", dr.Summary())
+	}
+	if _, err := w.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "y"); err == nil {
+		t.Errorf("expected an error for adding to a closed writer, but none were thrown!")
+	}
+}
+
+/*
+TestModelWriter_AddConstr1
+Description:
+
+	Verifies that invalid constraints are rejected when they are added
+	instead of when their block is flushed.
+*/
+func TestModelWriter_AddConstr1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("writer2")
+	defer model.Free()
+
+	w, err := gurobi.NewModelWriter(model, 0)
+	if err != nil {
+		t.Errorf("There was an issue creating the writer: %v", err)
+	}
+	if _, err := w.AddVar(gurobi.Binary, 0.0, 0.0, 1.0, "z"); err != nil {
+		t.Errorf("There was an issue adding z: %v", err)
+	}
+
+	// Test
+	if _, err := w.AddVar(gurobi.Continuous, 0.0, 2.0, 1.0, "bad"); err == nil {
+		t.Errorf("expected an error for crossed bounds, but none were thrown!")
+	}
+	if _, err := w.AddConstr([]int32{1}, []float64{1.0}, gurobi.Le, 1.0, "c"); err == nil {
+		t.Errorf("expected an error for an unknown variable index, but none were thrown!")
+	}
+	if _, err := w.AddConstr([]int32{0}, []float64{1.0, 2.0}, gurobi.Le, 1.0, "c"); err == nil {
+		t.Errorf("expected an error for mismatched lengths, but none were thrown!")
+	}
+	if _, err := w.AddConstr([]int32{0}, []float64{math.NaN()}, gurobi.Le, 1.0, "c"); err == nil {
+		t.Errorf("expected an error for a NaN coefficient, but none were thrown!")
+	}
+	if w.Flushes() != 0 {
+		t.Errorf("expected no blocks before closing; received %v", w.Flushes())
+	}
+}
+
+/*
+TestModelWriter_Close1
+Description:
+
+	Streams a small LP (minimize -x - y s.t. x + 2y <= 4, 3x + y <= 6) into
+	a model in blocks of two nonzeros and checks its optimal value.
+*/
+func TestModelWriter_Close1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("writer3.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("writer3.log")
+
+	model, err := gurobi.NewModel("writer3", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	w, err := gurobi.NewModelWriter(model, 2)
+	if err != nil {
+		t.Errorf("There was an issue creating the writer: %v", err)
+	}
+
+	// Algorithm
+	x, _ := w.AddVar(gurobi.Continuous, -1.0, 0.0, gurobi.INFINITY, "x")
+	y, _ := w.AddVar(gurobi.Continuous, -1.0, 0.0, gurobi.INFINITY, "y")
+	if _, err := w.AddConstr([]int32{x, y}, []float64{1.0, 2.0}, gurobi.Le, 4.0, "c0"); err != nil {
+		t.Errorf("There was an issue adding c0: %v", err)
+	}
+	if _, err := w.AddConstr([]int32{x, y}, []float64{3.0, 1.0}, gurobi.Le, 6.0, "c1"); err != nil {
+		t.Errorf("There was an issue adding c1: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("There was an issue closing the writer: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing: %v", err)
+	}
+
+	// Test
+	objval, err := model.GetDoubleAttr(gurobi.DBL_ATTR_OBJVAL)
+	if err != nil {
+		t.Errorf("There was an issue getting the objective value: %v", err)
+	}
+	if math.Abs(objval-(-2.8)) > 1e-6 {
+		t.Errorf("expected an objective value of -2.8; received %v", objval)
+	}
+}
@@ -0,0 +1,116 @@
+package gurobi_test
+
+import (
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+monitor_test.go
+Description:
+	Tests the Monitor, which reports the progress of a solve to other goroutines.
+*/
+
+/*
+TestMonitor_Snapshot1
+Description:
+
+	Verifies the snapshot of a monitor which has not seen a callback yet.
+*/
+func TestMonitor_Snapshot1(t *testing.T) {
+	// Constants
+	monitor := gurobi.NewMonitor()
+
+	// Algorithm
+	snapshot := monitor.Snapshot()
+
+	// Test
+	if snapshot.Callbacks != 0 || snapshot.Solving {
+		t.Errorf("expected an idle monitor; received %+v", snapshot)
+	}
+	if !math.IsInf(snapshot.Gap(), 1) {
+		t.Errorf("expected an infinite gap without an incumbent; received %v", snapshot.Gap())
+	}
+}
+
+/*
+TestMonitor_Snapshot2
+Description:
+
+	Solves a small MIP with a monitor, reading snapshots from another
+	goroutine, and checks that the model refuses queries from another
+	goroutine during the solve.
+*/
+func TestMonitor_Snapshot2(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("monitor2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("monitor2.log")
+
+	model, err := gurobi.NewModel("monitor2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	xs := []*gurobi.Var{}
+	for i := 0; i < 4; i++ {
+		x, err := model.AddVar(gurobi.Binary, -float64(i+1), 0.0, 1.0, "", []*gurobi.Constr{}, []float64{})
+		if err != nil {
+			t.Errorf("There was an issue adding a variable: %v", err)
+		}
+		xs = append(xs, x)
+	}
+	if _, err := model.AddConstr(xs, []float64{3.0, 4.0, 5.0, 6.0}, gurobi.Le, 10.0, "capacity"); err != nil {
+		t.Errorf("There was an issue adding the constraint: %v", err)
+	}
+
+	monitor := gurobi.NewMonitor()
+	queryErrs := make(chan error, 1)
+	err = monitor.InstallWith(model, func(cb *gurobi.CallbackContext) error {
+		if cb.Where != gurobi.WhereMIP || len(queryErrs) > 0 {
+			return nil
+		}
+		// A dashboard goroutine which queries the model instead of the monitor.
+		result := make(chan error)
+		go func() {
+			_ = monitor.Snapshot()
+			_, err := model.GetIntAttr("NumVars")
+			result <- err
+		}()
+		queryErrs <- <-result
+		return nil
+	})
+	if err != nil {
+		t.Errorf("There was an issue installing the monitor: %v", err)
+	}
+
+	// Algorithm
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+
+	// Test
+	snapshot := monitor.Snapshot()
+	if snapshot.Callbacks == 0 || snapshot.Solving {
+		t.Errorf("expected a finished solve with some callbacks; received %+v", snapshot)
+	}
+	if model.Solving() {
+		t.Errorf("expected the model to be idle after the solve")
+	}
+	select {
+	case err := <-queryErrs:
+		if !errors.Is(err, gurobi.ErrSolving) && !errors.Is(err, gurobi.ErrInCallback) {
+			t.Errorf("expected the query during the solve to be refused; received %v", err)
+		}
+	default:
+		t.Errorf("expected a MIP callback, but none were seen")
+	}
+}
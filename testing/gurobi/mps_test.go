@@ -0,0 +1,173 @@
+package gurobi_test
+
+import (
+	"compress/gzip"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+mps_test.go
+Description:
+	Tests the pure-Go MPS reader.
+*/
+
+const mpsModel = `* A small MIP with every supported section
+NAME          mps1
+OBJSENSE
+    MAX
+ROWS
+ N  obj
+ L  c1
+ G  c2
+ E  c3
+COLUMNS
+    x         obj       1.0        c1        1.0
+    x         c2        1.0
+    MARKER    'MARKER'  'INTORG'
+    y         obj       2.0        c1        1.0
+    y         c3        1.0
+    MARKER    'MARKER'  'INTEND'
+    z         c3        1.0
+RHS
+    rhs       obj       -3.0       c1        4.0
+    rhs       c2        1.0        c3        2.0
+RANGES
+    rng       c1        2.0
+BOUNDS
+ UP bnd       x         10.0
+ MI bnd       z
+ BV bnd       y
+QUADOBJ
+    x         x         2.0
+    x         z         1.0
+ENDATA
+`
+
+/*
+TestReadModelSpecMPS1
+Description:
+
+	Tests that rows, columns, integer markers, right-hand sides, ranges,
+	bounds and quadratic terms are read.
+*/
+func TestReadModelSpecMPS1(t *testing.T) {
+	// Algorithm
+	spec, err := gurobi.ReadModelSpecMPS(strings.NewReader(mpsModel))
+	if err != nil {
+		t.Fatalf("There was an issue reading the MPS model: %v", err)
+	}
+
+	// Test
+	if spec.Name != "mps1" || spec.Sense != gurobi.Maximize || spec.ObjCon != 3.0 {
+		t.Errorf("unexpected header: %v, %v, %v", spec.Name, spec.Sense, spec.ObjCon)
+	}
+	if len(spec.Vars) != 3 {
+		t.Fatalf("expected 3 variables; received %v", len(spec.Vars))
+	}
+	x, y, z := spec.Vars[0], spec.Vars[1], spec.Vars[2]
+	if x.Type != gurobi.Continuous || x.Obj != 1.0 || x.LB != 0.0 || x.UB != 10.0 {
+		t.Errorf("unexpected x: %+v", x)
+	}
+	if y.Type != gurobi.Binary || y.Obj != 2.0 || y.UB != 1.0 {
+		t.Errorf("unexpected y: %+v", y)
+	}
+	if z.LB != -gurobi.INFINITY || z.UB != gurobi.INFINITY {
+		t.Errorf("unexpected z: %+v", z)
+	}
+
+	// c1 is ranged, so its other side is added as the fourth constraint.
+	if len(spec.Constrs) != 4 {
+		t.Fatalf("expected 4 constraints; received %v", len(spec.Constrs))
+	}
+	c1, c2, c3, c1Range := spec.Constrs[0], spec.Constrs[1], spec.Constrs[2], spec.Constrs[3]
+	if c1.Sense != gurobi.Le || c1.RHS != 4.0 || len(c1.Ind) != 2 {
+		t.Errorf("unexpected c1: %+v", c1)
+	}
+	if c1Range.Name != "c1_range" || c1Range.Sense != gurobi.Ge || c1Range.RHS != 2.0 {
+		t.Errorf("unexpected range of c1: %+v", c1Range)
+	}
+	if c2.Sense != gurobi.Ge || c2.RHS != 1.0 || c3.Sense != gurobi.Eq || c3.RHS != 2.0 {
+		t.Errorf("unexpected c2 and c3: %+v, %+v", c2, c3)
+	}
+
+	// 0.5 * (2 x^2 + 2 * 1 x z) = x^2 + x z
+	q := map[[2]int32]float64{}
+	for _, term := range spec.QObj {
+		q[[2]int32{term.Row, term.Col}] += term.Val
+	}
+	if len(q) != 2 || q[[2]int32{0, 0}] != 1.0 || q[[2]int32{0, 2}] != 1.0 {
+		t.Errorf("unexpected quadratic objective: %v", spec.QObj)
+	}
+}
+
+/*
+TestReadModelSpecMPS2
+Description:
+
+	Tests a free MPS file without set names, the rule for negative upper
+	bounds, and the rejection of unsupported sections.
+*/
+func TestReadModelSpecMPS2(t *testing.T) {
+	// Constants
+	free := "NAME free\nROWS\n N cost\n E r\nCOLUMNS\n x cost 1 r 1\nRHS\n r -2\nRANGES\n r -1\nBOUNDS\n UP x -1\nENDATA\n"
+
+	// Algorithm
+	spec, err := gurobi.ReadModelSpecMPS(strings.NewReader(free))
+	if err != nil {
+		t.Fatalf("There was an issue reading the free MPS model: %v", err)
+	}
+
+	// Test
+	if x := spec.Vars[0]; x.LB != -gurobi.INFINITY || x.UB != -1.0 {
+		t.Errorf("expected a negative upper bound to free the lower bound; received %+v", x)
+	}
+	if r, rng := spec.Constrs[0], spec.Constrs[1]; r.Sense != gurobi.Le || r.RHS != -2.0 || rng.Sense != gurobi.Ge || math.Abs(rng.RHS+3.0) > 1e-12 {
+		t.Errorf("expected -3 <= r <= -2; received %+v, %+v", r, rng)
+	}
+
+	sos := "NAME sos\nROWS\n N cost\nCOLUMNS\n x cost 1\nSOS\n S1 SOS s1 1\nENDATA\n"
+	if _, err := gurobi.ReadModelSpecMPS(strings.NewReader(sos)); err == nil {
+		t.Errorf("expected an error for an SOS section, but none were thrown!")
+	}
+}
+
+/*
+TestReadModelSpecFile1
+Description:
+
+	Tests that a gzipped MPS file is read and named after the file.
+*/
+func TestReadModelSpecFile1(t *testing.T) {
+	// Constants
+	path := "readspec1.mps.gz"
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("There was an issue creating the file: %v", err)
+	}
+	defer os.Remove(path)
+
+	gz := gzip.NewWriter(file)
+	gz.Write([]byte(strings.Replace(mpsModel, "NAME          mps1", "NAME", 1)))
+	gz.Close()
+	file.Close()
+
+	// Algorithm
+	spec, err := gurobi.ReadModelSpecFile(path)
+
+	// Test
+	if err != nil {
+		t.Fatalf("There was an issue reading the file: %v", err)
+	}
+	if spec.Name != "readspec1" || len(spec.Vars) != 3 {
+		t.Errorf("unexpected spec: %v with %v variables", spec.Name, len(spec.Vars))
+	}
+
+	if _, err := gurobi.ReadModelSpecFile("readspec1.txt"); err == nil {
+		t.Errorf("expected an error for a file which cannot be read, but none were thrown!")
+	}
+}
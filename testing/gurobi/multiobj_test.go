@@ -0,0 +1,324 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+multiobj_test.go
+Description:
+	Tests the multi-objective functions of the Model object.
+*/
+
+/*
+TestModel_GetObjNVal1
+Description:
+
+	Optimizes a model with two objectives and verifies that reading the value
+	of the first objective does not change the objective selected with
+	ObjNumber.
+*/
+func TestModel_GetObjNVal1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("getobjnval1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("getobjnval1.log")
+
+	model, err := gurobi.NewModel("getobjnval1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	if _, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{}); err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	if err := model.SetIntAttr(gurobi.INT_ATTR_NUMOBJ, 2); err != nil {
+		t.Errorf("There was an issue setting the number of objectives: %v", err)
+	}
+
+	// Algorithm
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+	if err := model.SetObjNumber(1); err != nil {
+		t.Errorf("There was an issue selecting the second objective: %v", err)
+	}
+	val, err := model.GetObjNVal(0)
+
+	// Test
+	if err != nil || val != 0.0 {
+		t.Errorf("expected the first objective to have the value 0; received %v (%v)", val, err)
+	}
+	if objNumber, err := model.GetIntParam("ObjNumber"); err != nil || objNumber != 1 {
+		t.Errorf("expected ObjNumber to remain 1; received %v (%v)", objNumber, err)
+	}
+	if _, err := model.GetObjNVal(2); err == nil {
+		t.Errorf("expected an error for an objective that does not exist, but none were thrown!")
+	}
+}
+
+/*
+TestModel_SetMultiObjMethod1
+Description:
+
+	Verifies that SetMultiObjMethod() rejects a method that is out of range
+	before calling Gurobi.
+*/
+func TestModel_SetMultiObjMethod1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Test
+	err := model0.SetMultiObjMethod(gurobi.MultiObjMethod(5))
+	if err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
+
+/*
+TestModel_SetMultiObjPre1
+Description:
+
+	Verifies that SetMultiObjPre() rejects a presolve level that is out of range
+	before calling Gurobi.
+*/
+func TestModel_SetMultiObjPre1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Test
+	err := model0.SetMultiObjPre(gurobi.MultiObjPre(-2))
+	if err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
+
+/*
+TestModel_SetObjNRelTol1
+Description:
+
+	Verifies that SetObjNRelTol() rejects a negative tolerance.
+*/
+func TestModel_SetObjNRelTol1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Test
+	err := model0.SetObjNRelTol(0, -0.1)
+	if err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
+
+/*
+TestModel_SetObjectiveN1
+Description:
+
+	Sets two objectives of a dry-run model and verifies that they are
+	counted and that invalid tolerances and variables are rejected.
+*/
+func TestModel_SetObjectiveN1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("multiobj1")
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	y, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+
+	// Algorithm
+	cost := (&gurobi.LinExpr{}).AddTerm(x, 1.0).AddTerm(y, 2.0)
+	if err := model.SetObjectiveN(cost, 0, 2, 1.0, 0.0, 0.1, "cost"); err != nil {
+		t.Errorf("There was an issue setting the cost objective: %v", err)
+	}
+	if err := model.SetObjectiveN((&gurobi.LinExpr{}).AddTerm(y, 1.0), 1, 1, 1.0, 0.0, 0.0, "y"); err != nil {
+		t.Errorf("There was an issue setting the second objective: %v", err)
+	}
+
+	// Test
+	if numObj, err := model.NumObj(); err != nil || numObj != 2 {
+		t.Errorf("expected 2 objectives; received %v (%v)", numObj, err)
+	}
+	if err := model.SetObjectiveN(cost, 0, 2, 1.0, -1.0, 0.0, "cost"); err == nil {
+		t.Errorf("expected an error for a negative tolerance, but none were thrown!")
+	}
+	other := gurobi.NewDryRunModel("multiobj1_other")
+	defer other.Free()
+	if err := other.SetObjectiveN(cost, 0, 0, 1.0, 0.0, 0.0, "cost"); err == nil {
+		t.Errorf("expected an error for variables of another model, but none were thrown!")
+	}
+}
+
+/*
+TestModel_SetObjectiveN2
+Description:
+
+	Optimizes a hierarchical model (maximize x + y, then minimize x) and
+	checks the value and coefficients of each objective.
+*/
+func TestModel_SetObjectiveN2(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("multiobj2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("multiobj2.log")
+
+	model, err := gurobi.NewModel("multiobj2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	y, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+
+	// Algorithm (the model minimizes, so x + y is maximized with a weight of -1)
+	if err := model.SetObjectiveN((&gurobi.LinExpr{}).AddTerm(x, 1.0).AddTerm(y, 1.0), 0, 2, -1.0, 0.0, 0.0, "total"); err != nil {
+		t.Errorf("There was an issue setting the first objective: %v", err)
+	}
+	if err := model.SetObjectiveN((&gurobi.LinExpr{}).AddTerm(x, 1.0), 1, 1, 1.0, 0.0, 0.0, "x"); err != nil {
+		t.Errorf("There was an issue setting the second objective: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+
+	// Test
+	if val, err := model.GetObjNVal(0); err != nil || val != 2.0 {
+		t.Errorf("expected the first objective to be 2; received %v (%v)", val, err)
+	}
+	coeffs, err := model.GetObjN(1, []*gurobi.Var{x, y})
+	if err != nil {
+		t.Errorf("There was an issue reading the coefficients: %v", err)
+	}
+	if len(coeffs) != 2 || coeffs[0] != 1.0 || coeffs[1] != 0.0 {
+		t.Errorf("expected the coefficients [1 0]; received %v", coeffs)
+	}
+}
+
+/*
+TestModel_SetObjNRelTol2
+Description:
+
+	Sets the tolerances of the second objective of a dry-run model and
+	verifies that the objective selected with ObjNumber is restored afterwards.
+*/
+func TestModel_SetObjNRelTol2(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("multiobj_reltol2")
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := model.SetObjectiveN((&gurobi.LinExpr{}).AddTerm(x, 1.0), i, 2-i, 1.0, 0.0, 0.0, ""); err != nil {
+			t.Errorf("There was an issue setting objective %v: %v", i, err)
+		}
+	}
+
+	// Algorithm
+	if err := model.SetObjNumber(0); err != nil {
+		t.Errorf("There was an issue selecting the first objective: %v", err)
+	}
+	if err := model.SetObjNRelTol(1, 0.2); err != nil {
+		t.Errorf("There was an issue setting the relative tolerance: %v", err)
+	}
+	if err := model.SetObjNAbsTol(1, 0.5); err != nil {
+		t.Errorf("There was an issue setting the absolute tolerance: %v", err)
+	}
+
+	// Test
+	if objNumber := model.DryRun().Params["ObjNumber"]; objNumber != "0" {
+		t.Errorf("expected ObjNumber to be restored to 0; received %q", objNumber)
+	}
+	if err := model.SetObjNRelTol(2, 0.2); err == nil {
+		t.Errorf("expected an error for an objective that does not exist, but none were thrown!")
+	}
+}
+
+/*
+TestModel_ObjectiveResults1
+Description:
+
+	Optimizes a hierarchical model and verifies that ObjectiveResults() and the
+	ObjN getters return every objective without changing the selected ObjNumber.
+*/
+func TestModel_ObjectiveResults1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("objectiveresults1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("objectiveresults1.log")
+
+	model, err := gurobi.NewModel("objectiveresults1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	y, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+
+	// Algorithm
+	if err := model.SetObjectiveN((&gurobi.LinExpr{}).AddTerm(x, 1.0).AddTerm(y, 1.0), 0, 2, -1.0, 0.0, 0.0, "total"); err != nil {
+		t.Errorf("There was an issue setting the first objective: %v", err)
+	}
+	if err := model.SetObjectiveN((&gurobi.LinExpr{}).AddTerm(x, 1.0), 1, 1, 1.0, 0.0, 0.0, "x"); err != nil {
+		t.Errorf("There was an issue setting the second objective: %v", err)
+	}
+	if err := model.SetObjNAbsTol(0, 0.25); err != nil {
+		t.Errorf("There was an issue setting the absolute tolerance: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+	if err := model.SetObjNumber(1); err != nil {
+		t.Errorf("There was an issue selecting the second objective: %v", err)
+	}
+
+	results, err := model.ObjectiveResults()
+	if err != nil {
+		t.Errorf("There was an issue reading the objective results: %v", err)
+	}
+
+	// Test
+	if len(results) != 2 || results[0].Name != "total" || results[1].Name != "x" {
+		t.Errorf("expected the objectives [total x]; received %v", results)
+	}
+	if absTol, err := model.GetObjNAbsTol(0); err != nil || absTol != 0.25 {
+		t.Errorf("expected an absolute tolerance of 0.25; received %v (%v)", absTol, err)
+	}
+	if objNumber, err := model.GetIntParam("ObjNumber"); err != nil || objNumber != 1 {
+		t.Errorf("expected ObjNumber to remain 1; received %v (%v)", objNumber, err)
+	}
+}
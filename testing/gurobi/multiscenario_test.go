@@ -0,0 +1,167 @@
+package gurobi_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+multiscenario_test.go
+Description:
+	Tests the multi-scenario functions of the Model object.
+*/
+
+/*
+TestModel_SetNumScenarios1
+Description:
+
+	Verifies that invalid scenario counts and variables are rejected and that
+	a dry-run model has no single-scenario model.
+*/
+func TestModel_SetNumScenarios1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("scenarios1")
+	defer model.Free()
+
+	// Test
+	if err := model.SetNumScenarios(-1); err == nil {
+		t.Errorf("expected an error for a negative number of scenarios, but none were thrown!")
+	}
+	if err := model.SetScenNLB(0, nil, 1.0); err == nil {
+		t.Errorf("expected an error for a nil variable, but none were thrown!")
+	}
+	if _, err := model.SingleScenarioModel(0); !errors.Is(err, gurobi.ErrDryRun) {
+		t.Errorf("expected ErrDryRun; received %v", err)
+	}
+}
+
+/*
+TestModel_SingleScenarioModel1
+Description:
+
+	Maximizes a single variable under two demand scenarios (upper bounds of
+	5 and 8) and compares the scenario objectives with the objectives of
+	the corresponding single-scenario models.
+*/
+func TestModel_SingleScenarioModel1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("scenarios2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("scenarios2.log")
+
+	model, err := gurobi.NewModel("scenarios2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, -1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+
+	// Algorithm
+	if err := model.SetNumScenarios(2); err != nil {
+		t.Errorf("There was an issue setting the number of scenarios: %v", err)
+	}
+	demands := []float64{5.0, 8.0}
+	for i, demand := range demands {
+		if err := model.SetScenNUB(i, x, demand); err != nil {
+			t.Errorf("There was an issue setting the demand of scenario %v: %v", i, err)
+		}
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+
+	// Test
+	for i, demand := range demands {
+		objVal, err := model.GetScenNObjVal(i)
+		if err != nil || objVal != -demand {
+			t.Errorf("expected the objective %v in scenario %v; received %v (%v)", -demand, i, objVal, err)
+		}
+
+		single, err := model.SingleScenarioModel(i)
+		if err != nil {
+			t.Errorf("There was an issue creating the model of scenario %v: %v", i, err)
+			continue
+		}
+		if err := single.Optimize(); err != nil {
+			t.Errorf("There was an issue optimizing the model of scenario %v: %v", i, err)
+		}
+		if singleObj, err := single.ObjVal(); err != nil || singleObj != objVal {
+			t.Errorf("expected the single-scenario objective %v; received %v (%v)", objVal, singleObj, err)
+		}
+		single.Free()
+	}
+	if _, err := model.SingleScenarioModel(2); err == nil {
+		t.Errorf("expected an error for an out of range scenario, but none were thrown!")
+	}
+}
+
+/*
+TestModel_SetScenarioNumber1
+Description:
+
+	Sets and reads the attributes of the second scenario right after
+	setting the number of scenarios and verifies that the scenario selected
+	with ScenarioNumber is restored afterwards.
+*/
+func TestModel_SetScenarioNumber1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("scenarios3.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("scenarios3.log")
+
+	model, err := gurobi.NewModel("scenarios3", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, -1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+
+	// Algorithm
+	if err := model.SetNumScenarios(2); err != nil {
+		t.Errorf("There was an issue setting the number of scenarios: %v", err)
+	}
+	if err := model.SetScenarioNumber(0); err != nil {
+		t.Errorf("There was an issue selecting the first scenario: %v", err)
+	}
+	if err := model.SetScenNUB(1, x, 8.0); err != nil {
+		t.Errorf("There was an issue setting the upper bound of the second scenario: %v", err)
+	}
+	if err := model.SetScenNName(1, "high"); err != nil {
+		t.Errorf("There was an issue naming the second scenario: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+	val, err := model.GetScenNObjVal(1)
+	if err != nil {
+		t.Errorf("There was an issue reading the objective of the second scenario: %v", err)
+	}
+
+	// Test
+	if val != -8.0 {
+		t.Errorf("expected the second scenario to reach -8; received %v", val)
+	}
+	if scenarioNumber, err := model.GetIntParam("ScenarioNumber"); err != nil || scenarioNumber != 0 {
+		t.Errorf("expected ScenarioNumber to remain 0; received %v (%v)", scenarioNumber, err)
+	}
+	if err := model.SetScenNUB(2, x, 1.0); err == nil {
+		t.Errorf("expected an error for a scenario that does not exist, but none were thrown!")
+	}
+}
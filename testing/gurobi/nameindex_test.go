@@ -0,0 +1,121 @@
+package gurobi_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+nameindex_test.go
+Description:
+	Tests looking up variables and constraints by name.
+*/
+
+/*
+TestModel_GetVarByName1
+Description:
+
+	Adds named variables and constraints to a dry-run model, renames one
+	variable and checks the lookups by name and by prefix.
+*/
+func TestModel_GetVarByName1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("names1")
+	defer model.Free()
+
+	xs := []*gurobi.Var{}
+	for i := 0; i < 3; i++ {
+		x, err := model.AddVar(gurobi.Binary, 0.0, 0.0, 1.0, fmt.Sprintf("x[%v]", i), []*gurobi.Constr{}, []float64{})
+		if err != nil {
+			t.Errorf("There was an issue adding x[%v]: %v", i, err)
+		}
+		xs = append(xs, x)
+	}
+	y, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+	c, err := model.AddConstr(xs, []float64{1.0, 1.0, 1.0}, gurobi.Le, 1.0, "pick_one")
+	if err != nil {
+		t.Errorf("There was an issue adding the constraint: %v", err)
+	}
+
+	// Algorithm
+	if err := y.SetString("VarName", "x[3]"); err != nil {
+		t.Errorf("There was an issue renaming y: %v", err)
+	}
+
+	// Test
+	if v, err := model.GetVarByName("x[1]"); err != nil || v != xs[1] {
+		t.Errorf("expected x[1] to be found; received %v (%v)", v, err)
+	}
+	if _, err := model.GetVarByName("y"); err == nil {
+		t.Errorf("expected an error for the old name of a renamed variable, but none were thrown!")
+	}
+	if constr, err := model.GetConstrByName("pick_one"); err != nil || constr != c {
+		t.Errorf("expected pick_one to be found; received %v (%v)", constr, err)
+	}
+
+	prefixed, err := model.VarsByPrefix("x[")
+	if err != nil {
+		t.Errorf("There was an issue looking up the prefix: %v", err)
+	}
+	if len(prefixed) != 4 || prefixed[3] != y {
+		t.Errorf("expected x[0], x[1], x[2] and the renamed y; received %v", prefixed)
+	}
+}
+
+/*
+TestModel_GetVarByName2
+Description:
+
+	Builds the name index of a model, removes some variables and checks
+	that the index follows the removal.
+*/
+func TestModel_GetVarByName2(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("names2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("names2.log")
+
+	model, err := gurobi.NewModel("names2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	for _, name := range []string{"keep_a", "tmp_b", "keep_c"} {
+		if _, err := model.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, name, []*gurobi.Constr{}, []float64{}); err != nil {
+			t.Errorf("There was an issue adding %v: %v", name, err)
+		}
+	}
+	if _, err := model.GetVarByName("tmp_b"); err != nil {
+		t.Errorf("There was an issue looking up tmp_b: %v", err)
+	}
+
+	// Algorithm
+	_, err = model.RemoveWhere(func(name string, kind gurobi.ItemKind) bool {
+		return strings.HasPrefix(name, "tmp_")
+	})
+	if err != nil {
+		t.Errorf("There was an issue removing the variables: %v", err)
+	}
+
+	// Test
+	if _, err := model.GetVarByName("tmp_b"); err == nil {
+		t.Errorf("expected an error for a removed variable, but none were thrown!")
+	}
+	if v, err := model.GetVarByName("keep_c"); err != nil || v.Index != 1 {
+		t.Errorf("expected keep_c to have the index 1; received %v (%v)", v, err)
+	}
+	if kept, err := model.VarsByPrefix("keep_"); err != nil || len(kept) != 2 {
+		t.Errorf("expected 2 kept variables; received %v (%v)", kept, err)
+	}
+}
@@ -0,0 +1,131 @@
+package gurobi_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+namer_test.go
+Description:
+	Tests the Namer, which produces valid and unique variable names.
+*/
+
+/*
+TestSanitizeName1
+Description:
+
+	Tests that characters which are illegal in LP files are replaced and that
+	names which start like numbers are prefixed.
+*/
+func TestSanitizeName1(t *testing.T) {
+	cases := map[string]string{
+		"flow[a,b]":    "flow_a_b_",
+		"x y":          "x_y",
+		"1st":          "_1st",
+		"e5":           "_e5",
+		"emission":     "emission",
+		"a<=b":         "a__b",
+		"":             "_",
+		"cost(€)":      "cost(_)",
+		".hidden":      "_.hidden",
+		"good_name.01": "good_name.01",
+	}
+	for name, expected := range cases {
+		if sanitized := gurobi.SanitizeName(name); sanitized != expected {
+			t.Errorf("expected %q to be sanitized to %q; received %q", name, expected, sanitized)
+		}
+	}
+
+	if sanitized := gurobi.SanitizeName(strings.Repeat("a", 300)); len(sanitized) != 255 {
+		t.Errorf("expected long names to be truncated to 255 characters; received %v", len(sanitized))
+	}
+}
+
+/*
+TestNamer_Name1
+Description:
+
+	Tests that duplicates get a suffix and that generated names skip names
+	which are already used.
+*/
+func TestNamer_Name1(t *testing.T) {
+	// Constants
+	namer := &gurobi.Namer{Prefix: "v", Width: 3}
+
+	// Test
+	if name := namer.Name("v000"); name != "v000" {
+		t.Errorf("expected v000; received %v", name)
+	}
+	if name := namer.Next(); name != "v001" {
+		t.Errorf("expected the generated name to skip v000; received %v", name)
+	}
+	if name := namer.Name(""); name != "v002" {
+		t.Errorf("expected an empty name to be generated; received %v", name)
+	}
+	if a, b := namer.Name("x y"), namer.Name("x y"); a != "x_y" || b != "x_y_1" {
+		t.Errorf("expected x_y and x_y_1; received %v and %v", a, b)
+	}
+}
+
+/*
+TestModel_SetNamer1
+Description:
+
+	Tests that the names passed to AddVars are not modified by the Namer of a model.
+*/
+func TestModel_SetNamer1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("namer1")
+	model.SetNamer(gurobi.NewNamer("x"))
+	names := []string{"a b", "a b"}
+
+	// Algorithm
+	_, err := model.AddVars(
+		[]gurobi.VarType{gurobi.Continuous, gurobi.Continuous},
+		[]float64{0, 0}, []float64{0, 0}, []float64{1, 1},
+		names, [][]*gurobi.Constr{}, [][]float64{},
+	)
+	if err != nil {
+		t.Errorf("There was an issue adding variables: %v", err)
+	}
+
+	// Test
+	if names[0] != "a b" || names[1] != "a b" {
+		t.Errorf("expected the names to be unchanged; received %v", names)
+	}
+	if name := model.Namer().Name("a b"); name != "a_b_2" {
+		t.Errorf("expected the Namer to have used a_b and a_b_1; received %v", name)
+	}
+}
+
+/*
+TestModel_SetNamer2
+Description:
+
+	Tests that the Namer of a dry-run model names the variables added by
+	AddVarsWithTypes.
+*/
+func TestModel_SetNamer2(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("namer2")
+	model.SetNamer(gurobi.NewNamer("x"))
+
+	// Algorithm
+	vars, err := model.AddVarsWithTypes(2, gurobi.Binary)
+	if err != nil {
+		t.Errorf("There was an issue adding variables: %v", err)
+	}
+
+	// Test
+	for _, name := range []string{"x0", "x1"} {
+		if _, err := model.GetVarByName(name); err != nil {
+			t.Errorf("expected a variable named %v: %v", name, err)
+		}
+	}
+	if len(vars) != 2 {
+		t.Errorf("expected 2 variables; received %v", len(vars))
+	}
+}
@@ -0,0 +1,127 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+nogood_test.go
+Description:
+	Tests the no-good cut, local branching and objective improvement helpers.
+*/
+
+/*
+TestNoGoodCut1
+Description:
+
+	Verifies the no-good cut of the solution (1, 0, 1):
+	-x0 + x1 - x2 >= 1 - 2.
+*/
+func TestNoGoodCut1(t *testing.T) {
+	vars := []*gurobi.Var{{Index: 0}, {Index: 1}, {Index: 2}}
+
+	tc, err := gurobi.NoGoodCut(vars, []float64{1.0, 0.0, 1.0})
+	if err != nil {
+		t.Errorf("There was an issue creating the cut: %v", err)
+	}
+
+	expected := []float64{-1.0, 1.0, -1.0}
+	for i := range expected {
+		if tc.LHS.Val[i] != expected[i] {
+			t.Errorf("expected the coefficient %v at position %v; received %v", expected[i], i, tc.LHS.Val[i])
+		}
+	}
+	if tc.Sense != gurobi.Ge || tc.NormalizedRHS() != -1.0 {
+		t.Errorf("expected the constraint to be >= -1; received %v %v", tc.Sense, tc.NormalizedRHS())
+	}
+
+	if _, err := gurobi.NoGoodCut(vars, []float64{1.0, 0.5, 1.0}); err == nil {
+		t.Errorf("expected an error for a fractional value, but none were thrown!")
+	}
+}
+
+/*
+TestModel_AddLocalBranching1
+Description:
+
+	Verifies that AddLocalBranching adds a constraint and rejects negative distances.
+*/
+func TestModel_AddLocalBranching1(t *testing.T) {
+	model := gurobi.NewDryRunModel("localbranching1")
+	defer model.Free()
+
+	vars, err := model.AddVarsWithTypes(3, gurobi.Binary)
+	if err != nil {
+		t.Errorf("There was an issue adding variables: %v", err)
+	}
+
+	if _, err := model.AddLocalBranching(vars, []float64{1, 0, 0}, -1, "lb"); err == nil {
+		t.Errorf("expected an error for a negative distance, but none were thrown!")
+	}
+	if _, err := model.AddLocalBranching(vars, []float64{1, 0, 0}, 1, "lb"); err != nil {
+		t.Errorf("There was an issue adding the constraint: %v", err)
+	}
+	if _, err := model.AddNoGoodCut(vars, []float64{1, 0, 0}, "nogood"); err != nil {
+		t.Errorf("There was an issue adding the cut: %v", err)
+	}
+	if n := model.DryRun().NumConstrs; n != 2 {
+		t.Errorf("expected 2 constraints; received %v", n)
+	}
+}
+
+/*
+TestModel_AddObjectiveImprovement1
+Description:
+
+	Requires an improvement of 2 over the incumbent 9 of max x + 5 with
+	x <= 10, and verifies that the optimum is still found while the model
+	becomes infeasible when the improvement is impossible.
+*/
+func TestModel_AddObjectiveImprovement1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("objimprovement1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("objimprovement1.log")
+
+	model, err := gurobi.NewModel("objimprovement1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	if _, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{}); err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	if err := model.SetObjConstant(5.0); err != nil {
+		t.Errorf("There was an issue setting the objective constant: %v", err)
+	}
+	if err := model.SetMaximize(); err != nil {
+		t.Errorf("unexpected error setting the model sense: %v", err)
+	}
+
+	if _, err := model.AddObjectiveImprovement(9.0, 2.0, "improve"); err != nil {
+		t.Errorf("There was an issue adding the constraint: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+	if obj, err := model.ObjVal(); err != nil || obj != 15.0 {
+		t.Errorf("expected the objective 15; received %v (%v)", obj, err)
+	}
+
+	if _, err := model.AddObjectiveImprovement(15.0, 1.0, "impossible"); err != nil {
+		t.Errorf("There was an issue adding the constraint: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+	if status, _ := model.Status(); status != gurobi.StatusInfeasible && status != gurobi.StatusInfOrUnbd {
+		t.Errorf("expected the model to be infeasible; received %v", status)
+	}
+}
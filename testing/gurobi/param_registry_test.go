@@ -0,0 +1,63 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+param_registry_test.go
+Description:
+	Tests the parameter registry in the gurobi package.
+*/
+
+/*
+TestLookupParam1
+Description:
+
+	Tests that LookupParam() ignores case and returns the canonical parameter name.
+*/
+func TestLookupParam1(t *testing.T) {
+	// Test
+	name, paramType, ok := gurobi.LookupParam("TIMELIMIT")
+	if !ok {
+		t.Errorf("expected TIMELIMIT to be found in the registry")
+	}
+
+	if name != "TimeLimit" {
+		t.Errorf("expected canonical name TimeLimit; received %v", name)
+	}
+
+	if paramType != gurobi.DBLParam {
+		t.Errorf("expected TimeLimit to be a %v parameter; received %v", gurobi.DBLParam, paramType)
+	}
+}
+
+/*
+TestLookupParam2
+Description:
+
+	Tests that LookupParam() does not find a parameter which does not exist.
+*/
+func TestLookupParam2(t *testing.T) {
+	if _, _, ok := gurobi.LookupParam("NotAParameter"); ok {
+		t.Errorf("did not expect NotAParameter to be found in the registry")
+	}
+}
+
+/*
+TestIsValidDBLParam1
+Description:
+
+	Tests that IsValidDBLParam() only accepts double parameters.
+*/
+func TestIsValidDBLParam1(t *testing.T) {
+	if !gurobi.IsValidDBLParam("MIPGapAbs") {
+		t.Errorf("expected MIPGapAbs to be a valid double parameter")
+	}
+
+	if gurobi.IsValidDBLParam("Threads") {
+		t.Errorf("did not expect Threads to be a valid double parameter")
+	}
+}
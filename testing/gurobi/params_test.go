@@ -0,0 +1,82 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+params_test.go
+Description:
+	Tests the parameter setters and getters of the Model object.
+*/
+
+/*
+TestModel_SetIntParam1
+Description:
+
+	Verifies that SetIntParam() returns an error when called on a nil model.
+*/
+func TestModel_SetIntParam1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Test
+	err := model0.SetIntParam("Threads", 1)
+	if err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	} else {
+		if err.Error() != model0.MakeUninitializedError().Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestModel_SetIntParam2
+Description:
+
+	Verifies that setting a parameter on the model does not change the value
+	of that parameter in the environment used to create the model.
+*/
+func TestModel_SetIntParam2(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("setintparam2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("setintparam2.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("setintparam2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	// Test
+	if err := model.SetIntParam("Threads", 1); err != nil {
+		t.Errorf("unexpected error setting Threads: %v", err)
+	}
+
+	modelThreads, err := model.GetIntParam("Threads")
+	if err != nil {
+		t.Errorf("unexpected error getting Threads: %v", err)
+	}
+
+	if modelThreads != 1 {
+		t.Errorf("expected the model's Threads to be %v; received %v", 1, modelThreads)
+	}
+
+	envThreads, err := env.GetIntParam("Threads")
+	if err != nil {
+		t.Errorf("unexpected error getting Threads: %v", err)
+	}
+
+	if envThreads != 0 {
+		t.Errorf("expected the environment's Threads to be unchanged (%v); received %v", 0, envThreads)
+	}
+}
@@ -0,0 +1,189 @@
+package gurobi_test
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+pool_test.go
+Description:
+	Tests the solution pool helpers.
+*/
+
+/*
+TestModel_SetPoolSearchMode1
+Description:
+
+	Sets the pool parameters of a dry-run model and checks that they are
+	recorded and that invalid values are rejected.
+*/
+func TestModel_SetPoolSearchMode1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("pool1")
+	defer model.Free()
+
+	// Algorithm
+	if err := model.SetPoolSearchMode(gurobi.PoolSearchBest); err != nil {
+		t.Errorf("There was an issue setting the pool search mode: %v", err)
+	}
+	if err := model.SetPoolSolutions(5); err != nil {
+		t.Errorf("There was an issue setting the pool size: %v", err)
+	}
+	if err := model.SetPoolGap(0.1); err != nil {
+		t.Errorf("There was an issue setting the pool gap: %v", err)
+	}
+
+	// Test
+	params := model.DryRun().Params
+	if params["PoolSearchMode"] != "2" || params["PoolSolutions"] != "5" || params["PoolGap"] != "0.1" {
+		t.Errorf("expected the pool parameters to be recorded; received %v", params)
+	}
+	if err := model.SetPoolSearchMode(gurobi.PoolSearchMode(3)); err == nil {
+		t.Errorf("expected an error for an unknown pool search mode, but none were thrown!")
+	}
+	if err := model.SetPoolSolutions(0); err == nil {
+		t.Errorf("expected an error for an empty pool, but none were thrown!")
+	}
+	if err := model.SetPoolGap(math.NaN()); err == nil {
+		t.Errorf("expected an error for a NaN pool gap, but none were thrown!")
+	}
+}
+
+/*
+TestModel_PoolSolution1
+Description:
+
+	Searches for the 3 best solutions of a small knapsack problem and checks
+	that the pool returns them from best to worst.
+*/
+func TestModel_PoolSolution1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("pool2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("pool2.log")
+
+	model, err := gurobi.NewModel("pool2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	costs := []float64{1.0, 2.0, 3.0, 4.0}
+	xs := []*gurobi.Var{}
+	for _, cost := range costs {
+		x, err := model.AddVar(gurobi.Binary, cost, 0.0, 1.0, "", []*gurobi.Constr{}, []float64{})
+		if err != nil {
+			t.Errorf("There was an issue adding a variable: %v", err)
+		}
+		xs = append(xs, x)
+	}
+	if _, err := model.AddConstr(xs, []float64{1.0, 1.0, 1.0, 1.0}, gurobi.Ge, 2.0, "pick_two"); err != nil {
+		t.Errorf("There was an issue adding the constraint: %v", err)
+	}
+
+	// Algorithm
+	if err := model.SetPoolSearchMode(gurobi.PoolSearchBest); err != nil {
+		t.Errorf("There was an issue setting the pool search mode: %v", err)
+	}
+	if err := model.SetPoolSolutions(3); err != nil {
+		t.Errorf("There was an issue setting the pool size: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+
+	// Test
+	count, err := model.PoolSolutionCount()
+	if err != nil || count != 3 {
+		t.Errorf("expected 3 pool solutions; received %v (%v)", count, err)
+	}
+	solutions, err := model.PoolSolutions()
+	if err != nil {
+		t.Errorf("There was an issue reading the pool: %v", err)
+	}
+	for i, solution := range solutions {
+		if len(solution.X) != len(xs) {
+			t.Errorf("expected %v values in solution %v; received %v", len(xs), i, len(solution.X))
+		}
+		if i > 0 && solution.ObjVal < solutions[i-1].ObjVal {
+			t.Errorf("expected the pool to be sorted; solution %v has the objective %v", i, solution.ObjVal)
+		}
+	}
+	if len(solutions) > 0 && solutions[0].ObjVal != 3.0 {
+		t.Errorf("expected the best objective to be 3; received %v", solutions[0].ObjVal)
+	}
+	if _, err := model.PoolSolution(count); err == nil {
+		t.Errorf("expected an error for an out of range pool solution, but none were thrown!")
+	}
+}
+
+/*
+TestModel_PoolSolution2
+Description:
+
+	Selects the second pool solution, reads the third with PoolSolution and
+	verifies that PoolObjVal still refers to the second solution afterwards.
+*/
+func TestModel_PoolSolution2(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("pool3.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("pool3.log")
+
+	model, err := gurobi.NewModel("pool3", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	xs := []*gurobi.Var{}
+	for _, cost := range []float64{1.0, 2.0, 3.0, 4.0} {
+		x, err := model.AddVar(gurobi.Binary, cost, 0.0, 1.0, "", []*gurobi.Constr{}, []float64{})
+		if err != nil {
+			t.Errorf("There was an issue adding a variable: %v", err)
+		}
+		xs = append(xs, x)
+	}
+	if _, err := model.AddConstr(xs, []float64{1.0, 1.0, 1.0, 1.0}, gurobi.Ge, 2.0, "pick_two"); err != nil {
+		t.Errorf("There was an issue adding the constraint: %v", err)
+	}
+	if err := model.SetPoolSearchMode(gurobi.PoolSearchBest); err != nil {
+		t.Errorf("There was an issue setting the pool search mode: %v", err)
+	}
+	if err := model.SetPoolSolutions(3); err != nil {
+		t.Errorf("There was an issue setting the pool size: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+
+	// Algorithm
+	if err := model.SetIntParam("SolutionNumber", 1); err != nil {
+		t.Errorf("There was an issue selecting the second solution: %v", err)
+	}
+	second, err := model.GetDoubleAttr(gurobi.DBL_ATTR_POOLOBJVAL)
+	if err != nil {
+		t.Errorf("There was an issue reading the second objective: %v", err)
+	}
+	if _, err := model.PoolSolution(2); err != nil {
+		t.Errorf("There was an issue reading the third solution: %v", err)
+	}
+
+	// Test
+	if solutionNumber, err := model.GetIntParam("SolutionNumber"); err != nil || solutionNumber != 1 {
+		t.Errorf("expected SolutionNumber to remain 1; received %v (%v)", solutionNumber, err)
+	}
+	if objVal, err := model.GetDoubleAttr(gurobi.DBL_ATTR_POOLOBJVAL); err != nil || objVal != second {
+		t.Errorf("expected PoolObjVal to remain %v; received %v (%v)", second, objVal, err)
+	}
+}
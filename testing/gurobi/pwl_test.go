@@ -0,0 +1,80 @@
+package gurobi_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+pwl_test.go
+Description:
+	Tests the piecewise-linear approximation builder of the gurobi package.
+*/
+
+/*
+TestPWLApproximation1
+Description:
+
+	Tests that a linear function is approximated with just its two endpoints.
+*/
+func TestPWLApproximation1(t *testing.T) {
+	// Test
+	xpts, ypts, err := gurobi.PWLApproximation(
+		func(x float64) float64 { return 2*x + 1 },
+		0.0, 10.0,
+		gurobi.PWLOptions{MaxError: 1e-6},
+	)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if len(xpts) != 2 || xpts[0] != 0.0 || xpts[1] != 10.0 || ypts[0] != 1.0 || ypts[1] != 21.0 {
+		t.Errorf("unexpected breakpoints: %v, %v", xpts, ypts)
+	}
+}
+
+/*
+TestPWLApproximation2
+Description:
+
+	Tests that the approximation of exp(x) respects the maximum error between breakpoints.
+*/
+func TestPWLApproximation2(t *testing.T) {
+	// Constants
+	maxError := 1e-3
+
+	// Algorithm
+	xpts, ypts, err := gurobi.PWLApproximation(math.Exp, 0.0, 2.0, gurobi.PWLOptions{MaxError: maxError})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Test
+	for i := 1; i < len(xpts); i++ {
+		if xpts[i] <= xpts[i-1] {
+			t.Errorf("breakpoints are not increasing: %v", xpts)
+		}
+
+		mid := (xpts[i-1] + xpts[i]) / 2
+		interpolated := (ypts[i-1] + ypts[i]) / 2
+		if math.Abs(math.Exp(mid)-interpolated) > maxError {
+			t.Errorf("the error at x = %v exceeds %v", mid, maxError)
+		}
+	}
+}
+
+/*
+TestPWLApproximation3
+Description:
+
+	Tests that an error is returned when the tolerance cannot be met with MaxPoints breakpoints.
+*/
+func TestPWLApproximation3(t *testing.T) {
+	// Test
+	_, _, err := gurobi.PWLApproximation(math.Sin, 0.0, 100.0, gurobi.PWLOptions{MaxError: 1e-9, MaxPoints: 5})
+	if err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
@@ -0,0 +1,90 @@
+package gurobi_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+qconstr_test.go
+Description:
+	Tests the introspection of quadratic constraints.
+*/
+
+/*
+TestModel_GetQConstr1
+Description:
+
+	Reads a QCQP model from an LP file and checks the structure of its quadratic constraint.
+*/
+func TestModel_GetQConstr1(t *testing.T) {
+	// Constants
+	lp := "Minimize\n x + y\nSubject To\n qc0: x + [ x ^ 2 + 2 x * y ] <= 5\nBounds\n x <= 10\n y <= 10\nEnd\n"
+	if err := os.WriteFile("getqconstr1.lp", []byte(lp), 0644); err != nil {
+		t.Fatalf("There was an issue writing the LP file: %v", err)
+	}
+	defer os.Remove("getqconstr1.lp")
+
+	env, err := gurobi.NewEnv("getqconstr1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("getqconstr1.log")
+
+	model, err := gurobi.NewModel("getqconstr1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	if err := model.Read("getqconstr1.lp"); err != nil {
+		t.Errorf("There was an issue reading the model: %v", err)
+	}
+
+	// Test
+	qconstrs, err := model.QConstrs()
+	if err != nil || len(qconstrs) != 1 {
+		t.Fatalf("expected 1 quadratic constraint; received %v (%v)", len(qconstrs), err)
+	}
+
+	data, err := qconstrs[0].Data()
+	if err != nil {
+		t.Fatalf("There was an issue reading the quadratic constraint: %v", err)
+	}
+	if data.Name != "qc0" || data.Sense != gurobi.Le || data.RHS != 5.0 {
+		t.Errorf("unexpected name, sense or rhs: %+v", data)
+	}
+	if len(data.LinVars) != 1 || data.LinVals[0] != 1.0 || len(data.QVals) != 2 {
+		t.Errorf("unexpected terms: %+v", data)
+	}
+
+	if err := qconstrs[0].SetRHS(7.0); err != nil {
+		t.Errorf("There was an issue setting QCRHS: %v", err)
+	}
+	if err := model.Update(); err != nil {
+		t.Errorf("There was an issue updating the model: %v", err)
+	}
+	if rhs, err := qconstrs[0].GetAttr("QCRHS"); err != nil || rhs != 7.0 {
+		t.Errorf("expected QCRHS 7; received %v (%v)", rhs, err)
+	}
+}
+
+/*
+TestModel_GetQConstr2
+Description:
+
+	Tests that a dry-run model cannot return the structure of a quadratic constraint.
+*/
+func TestModel_GetQConstr2(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("getqconstr2")
+
+	// Test
+	if _, err := model.GetQConstr(0); !errors.Is(err, gurobi.ErrDryRun) {
+		t.Errorf("expected ErrDryRun; received %v", err)
+	}
+}
@@ -0,0 +1,312 @@
+package gurobi_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+registry_test.go
+Description:
+	Tests creating and freeing many environments and their models.
+*/
+
+/*
+TestEnv_Free1
+Description:
+
+	Frees an environment before its models and checks that the models are
+	freed with it, so that freeing them afterwards does nothing.
+*/
+func TestEnv_Free1(t *testing.T) {
+	// Constants
+	envsBefore := gurobi.NumEnvs()
+	env, err := gurobi.NewEnv("registry1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer os.Remove("registry1.log")
+
+	model1, err := gurobi.NewModel("registry1a", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the first model: %v", err)
+	}
+	model2, err := gurobi.NewModel("registry1b", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the second model: %v", err)
+	}
+	copied, err := model2.Copy()
+	if err != nil {
+		t.Errorf("There was an issue copying the second model: %v", err)
+	}
+
+	// Algorithm
+	model1.Free()
+	numModels := env.NumModels()
+	env.Free()
+
+	// Test
+	if numModels != 2 {
+		t.Errorf("expected 2 live models after freeing one of three; received %v", numModels)
+	}
+	if gurobi.NumEnvs() != envsBefore {
+		t.Errorf("expected %v environments after freeing; received %v", envsBefore, gurobi.NumEnvs())
+	}
+
+	model2.Free()
+	copied.Free()
+	env.Free()
+}
+
+/*
+TestEnv_Concurrent1
+Description:
+
+	Creates and frees environments with a few models each from several
+	goroutines at once, each with its own log file and time limit.
+*/
+func TestEnv_Concurrent1(t *testing.T) {
+	// Constants
+	numWorkers := 8
+	envsBefore := gurobi.NumEnvs()
+
+	// Algorithm
+	errs := make(chan error, numWorkers)
+	var wg sync.WaitGroup
+	for w := 0; w < numWorkers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+
+			logFile := fmt.Sprintf("registry2_%v.log", w)
+			defer os.Remove(logFile)
+			env, err := gurobi.NewEnv(logFile)
+			if err != nil {
+				errs <- err
+				return
+			}
+			defer env.Free()
+
+			if err := env.SetTimeLimit(float64(w + 1)); err != nil {
+				errs <- err
+				return
+			}
+			for m := 0; m < 3; m++ {
+				model, err := gurobi.NewModel(fmt.Sprintf("registry2_%v_%v", w, m), env)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if m%2 == 0 {
+					model.Free()
+				}
+			}
+
+			if limit, err := env.GetTimeLimit(); err != nil || limit != float64(w+1) {
+				errs <- fmt.Errorf("worker %v: expected a time limit of %v; received %v (%v)", w, w+1, limit, err)
+			}
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+
+	// Test
+	for err := range errs {
+		t.Errorf("There was an issue in a worker: %v", err)
+	}
+	if gurobi.NumEnvs() != envsBefore {
+		t.Errorf("expected %v environments after all workers finished; received %v", envsBefore, gurobi.NumEnvs())
+	}
+}
+
+/*
+TestModel_Free2
+Description:
+
+	Verifies that a freed model returns ErrFreed instead of reaching the C
+	API, and that freeing it again does nothing.
+*/
+func TestModel_Free2(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("free1")
+	if _, err := model.AddVarsWithTypes(2, gurobi.Continuous); err != nil {
+		t.Errorf("There was an issue adding variables: %v", err)
+	}
+
+	// Algorithm
+	model.Free()
+	model.Free()
+
+	// Test
+	if err := model.Check(); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from Check; received %v", err)
+	}
+	if _, err := model.AddVar(gurobi.Binary, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{}); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from AddVar; received %v", err)
+	}
+	if err := model.Update(); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from Update; received %v", err)
+	}
+	if err := model.Optimize(); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from Optimize; received %v", err)
+	}
+	if err := model.Write("free1.lp"); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from Write; received %v", err)
+	}
+	if _, err := model.GetIntAttr("NumVars"); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from GetIntAttr; received %v", err)
+	}
+	if err := model.SetDoubleAttr("ObjCon", 1.0); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from SetDoubleAttr; received %v", err)
+	}
+}
+
+/*
+TestEnv_Free2
+Description:
+
+	Verifies that a freed environment and the models freed with it return
+	ErrFreed, and that freeing the environment again does nothing.
+*/
+func TestEnv_Free2(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("registry3.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer os.Remove("registry3.log")
+
+	model, err := gurobi.NewModel("registry3", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+
+	// Algorithm
+	env.Free()
+	env.Free()
+
+	// Test
+	if err := env.Check(); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from the environment; received %v", err)
+	}
+	if err := model.Check(); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from the model; received %v", err)
+	}
+	if err := env.SetIntParam("Threads", 1); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from SetIntParam; received %v", err)
+	}
+	if _, err := env.GetDBLParam("TimeLimit"); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from GetDBLParam; received %v", err)
+	}
+	if _, err := gurobi.NewModel("registry3b", env); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from NewModel; received %v", err)
+	}
+	if _, err := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{}); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from AddVar; received %v", err)
+	}
+	model.Free()
+}
+
+/*
+TestEnv_Concurrent2
+Description:
+
+	Frees the models of an environment from several goroutines while the
+	environment itself is freed, and checks that every model ends up freed.
+*/
+func TestEnv_Concurrent2(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("registry4.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer os.Remove("registry4.log")
+
+	models := []*gurobi.Model{}
+	for m := 0; m < 8; m++ {
+		model, err := gurobi.NewModel(fmt.Sprintf("registry4_%v", m), env)
+		if err != nil {
+			t.Errorf("There was an issue creating model %v: %v", m, err)
+			continue
+		}
+		models = append(models, model)
+	}
+
+	// Algorithm
+	var wg sync.WaitGroup
+	for _, model := range models {
+		wg.Add(1)
+		go func(model *gurobi.Model) {
+			defer wg.Done()
+			model.Free()
+		}(model)
+	}
+	env.Free()
+	wg.Wait()
+
+	// Test
+	for m, model := range models {
+		if err := model.Check(); !errors.Is(err, gurobi.ErrFreed) {
+			t.Errorf("expected ErrFreed from model %v; received %v", m, err)
+		}
+	}
+	if env.NumModels() != 0 {
+		t.Errorf("expected no live models; received %v", env.NumModels())
+	}
+}
+
+/*
+TestModel_Free3
+Description:
+
+	Copies a model into the environment of another model, which was not
+	created with NewEnv, and verifies that freeing the copy releases it.
+*/
+func TestModel_Free3(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("registry_free3.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("registry_free3.log")
+
+	model, err := gurobi.NewModel("registry_free3a", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the first model: %v", err)
+	}
+	defer model.Free()
+	other, err := gurobi.NewModel("registry_free3b", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the second model: %v", err)
+	}
+	defer other.Free()
+
+	otherEnv, err := other.ModelEnv()
+	if err != nil {
+		t.Errorf("There was an issue retrieving the model environment: %v", err)
+	}
+
+	// Algorithm
+	copied, err := model.CopyToEnv(otherEnv)
+	if err != nil {
+		t.Errorf("There was an issue copying the model: %v", err)
+	}
+	copied.Free()
+
+	// Test
+	if err := copied.Check(); !errors.Is(err, gurobi.ErrFreed) {
+		t.Errorf("expected ErrFreed from the freed copy; received %v", err)
+	}
+	if copied.AsGRBModel != nil {
+		t.Errorf("expected the GRBmodel of the copy to be released")
+	}
+	if err := other.Check(); err != nil {
+		t.Errorf("expected the other model to remain usable; received %v", err)
+	}
+}
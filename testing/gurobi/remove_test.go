@@ -0,0 +1,86 @@
+package gurobi_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+remove_test.go
+Description:
+	Tests the removal of model items with RemoveWhere.
+*/
+
+/*
+TestModel_RemoveWhere1
+Description:
+
+	Adds two regular and two temporary ("tmp_") variables and constraints,
+	removes the temporary ones and verifies the counts and the new indices.
+*/
+func TestModel_RemoveWhere1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("remove1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("remove1.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("remove1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	names := []string{"x0", "tmp_x1", "x2", "tmp_x3"}
+	vars, err := model.AddVars(
+		[]gurobi.VarType{gurobi.Continuous, gurobi.Continuous, gurobi.Continuous, gurobi.Continuous},
+		[]float64{0.0, 0.0, 0.0, 0.0}, []float64{0.0, 0.0, 0.0, 0.0}, []float64{1.0, 1.0, 1.0, 1.0},
+		names, [][]*gurobi.Constr{}, [][]float64{},
+	)
+	if err != nil {
+		t.Errorf("There was an issue adding variables to the model: %v", err)
+	}
+	for i, v := range vars {
+		if _, err := model.AddConstr([]*gurobi.Var{v}, []float64{1.0}, gurobi.Le, 1.0, "c_"+names[i]); err != nil {
+			t.Errorf("There was an issue adding a constraint to the model: %v", err)
+		}
+	}
+
+	removed, err := model.RemoveWhere(func(name string, kind gurobi.ItemKind) bool {
+		return strings.Contains(name, "tmp_")
+	})
+	if err != nil {
+		t.Errorf("There was an issue removing the items: %v", err)
+	}
+	if removed != 4 {
+		t.Errorf("expected 4 removed items; received %v", removed)
+	}
+
+	numVars, err := model.NumVars()
+	if err != nil || numVars != 2 {
+		t.Errorf("expected 2 variables; received %v (%v)", numVars, err)
+	}
+	numConstrs, err := model.NumConstrs()
+	if err != nil || numConstrs != 2 {
+		t.Errorf("expected 2 constraints; received %v (%v)", numConstrs, err)
+	}
+
+	if vars[1].Index != -1 || vars[2].Index != 1 {
+		t.Errorf("expected the indices -1 and 1; received %v and %v", vars[1].Index, vars[2].Index)
+	}
+	if len(model.Variables) != 2 || model.Variables[1] != *vars[2] {
+		t.Errorf("expected model.Variables to hold the remaining variables; received %v", model.Variables)
+	}
+	if len(model.Constraints) != 2 || model.Constraints[1].Index != 1 {
+		t.Errorf("expected model.Constraints to hold the remaining constraints; received %v", model.Constraints)
+	}
+	if !model.PendingChanges().IsStructural() {
+		t.Errorf("expected the removal to be a pending structural change")
+	}
+}
@@ -0,0 +1,156 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+result_test.go
+Description:
+	Tests the SolveResult object.
+*/
+
+/*
+TestSolveResult_HasSolution1
+Description:
+
+	Verifies that HasSolution() depends on the solution count.
+*/
+func TestSolveResult_HasSolution1(t *testing.T) {
+	// Test
+	if (&gurobi.SolveResult{}).HasSolution() {
+		t.Errorf("expected no solution when SolCount is 0")
+	}
+
+	if !(&gurobi.SolveResult{SolCount: 2}).HasSolution() {
+		t.Errorf("expected a solution when SolCount is 2")
+	}
+}
+
+/*
+TestModel_OptimizeWithResult1
+Description:
+
+	Solves max x + y subject to x + y <= 3 and verifies the collected result.
+*/
+func TestModel_OptimizeWithResult1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("result1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("result1.log")
+
+	model, err := gurobi.NewModel("result1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, gurobi.INFINITY, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	y, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, gurobi.INFINITY, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+
+	if _, err := model.AddConstr([]*gurobi.Var{x, y}, []float64{1, 1}, gurobi.Le, 3.0, "c0"); err != nil {
+		t.Errorf("There was an issue adding a constraint to the model: %v", err)
+	}
+
+	if err := model.SetMaximize(); err != nil {
+		t.Errorf("unexpected error setting the model sense: %v", err)
+	}
+
+	// Algorithm
+	result, err := model.OptimizeWithResult()
+	if err != nil {
+		t.Errorf("unexpected error optimizing the model: %v", err)
+	}
+
+	// Test
+	if result.Status != gurobi.StatusOptimal || result.ObjVal != 3.0 || result.IsMIP {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	if result.NumVars != 2 || result.NumConstrs != 1 {
+		t.Errorf("unexpected model size in result: %+v", result)
+	}
+}
+
+/*
+TestSolveResult_VariableObjVal1
+Description:
+
+	Verifies that VariableObjVal removes the objective constant and is 0
+	without a solution.
+*/
+func TestSolveResult_VariableObjVal1(t *testing.T) {
+	result := &gurobi.SolveResult{SolCount: 1, ObjVal: 7.0, ObjCon: 2.0}
+	if result.VariableObjVal() != 5.0 {
+		t.Errorf("expected 5; received %v", result.VariableObjVal())
+	}
+
+	if (&gurobi.SolveResult{ObjCon: 2.0}).VariableObjVal() != 0.0 {
+		t.Errorf("expected 0 without a solution")
+	}
+}
+
+/*
+TestModel_SetObjConstant1
+Description:
+
+	Solves max x subject to x <= 3 with the objective constant 10 and
+	verifies that the constant is read back and reported in the result.
+*/
+func TestModel_SetObjConstant1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("objconstant1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("objconstant1.log")
+
+	model, err := gurobi.NewModel("objconstant1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	if _, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, 3.0, "x", []*gurobi.Constr{}, []float64{}); err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	if err := model.SetMaximize(); err != nil {
+		t.Errorf("unexpected error setting the model sense: %v", err)
+	}
+
+	if err := model.SetObjConstant(10.0); err != nil {
+		t.Errorf("There was an issue setting the objective constant: %v", err)
+	}
+	if err := model.Update(); err != nil {
+		t.Errorf("There was an issue updating the model: %v", err)
+	}
+
+	objCon, err := model.GetObjConstant()
+	if err != nil {
+		t.Errorf("There was an issue getting the objective constant: %v", err)
+	}
+	if objCon != 10.0 {
+		t.Errorf("expected the objective constant 10; received %v", objCon)
+	}
+
+	result, err := model.OptimizeWithResult()
+	if err != nil {
+		t.Errorf("unexpected error optimizing the model: %v", err)
+	}
+	if result.ObjVal != 13.0 || result.ObjCon != 10.0 || result.VariableObjVal() != 3.0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
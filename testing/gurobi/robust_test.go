@@ -0,0 +1,97 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+robust_test.go
+Description:
+	Tests the OptimizeRobust retry strategy and its policy.
+*/
+
+/*
+TestRobustPolicy_ShouldRetry1
+Description:
+
+	Tests that the default policy retries on NUMERIC and SUBOPTIMAL, but not on OPTIMAL.
+*/
+func TestRobustPolicy_ShouldRetry1(t *testing.T) {
+	// Constants
+	policy := gurobi.DefaultRobustPolicy()
+
+	// Test
+	if !policy.ShouldRetry(gurobi.StatusNumeric) {
+		t.Errorf("expected the default policy to retry on NUMERIC")
+	}
+
+	if !policy.ShouldRetry(gurobi.StatusSuboptimal) {
+		t.Errorf("expected the default policy to retry on SUBOPTIMAL")
+	}
+
+	if policy.ShouldRetry(gurobi.StatusOptimal) {
+		t.Errorf("did not expect the default policy to retry on OPTIMAL")
+	}
+}
+
+/*
+TestRobustPolicy_ShouldRetry2
+Description:
+
+	Tests that a policy with custom retry statuses only retries on those statuses.
+*/
+func TestRobustPolicy_ShouldRetry2(t *testing.T) {
+	// Constants
+	policy := gurobi.RobustPolicy{
+		MaxRetries:    1,
+		RetryStatuses: []gurobi.Status{gurobi.StatusTimeLimit},
+	}
+
+	// Test
+	if !policy.ShouldRetry(gurobi.StatusTimeLimit) {
+		t.Errorf("expected the policy to retry on TIME_LIMIT")
+	}
+
+	if policy.ShouldRetry(gurobi.StatusNumeric) {
+		t.Errorf("did not expect the policy to retry on NUMERIC")
+	}
+}
+
+/*
+TestModel_OptimizeRobust1
+Description:
+
+	Tests that OptimizeRobust solves a well-conditioned LP in a single attempt.
+*/
+func TestModel_OptimizeRobust1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("robust1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("robust1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	// Add variables
+	if _, err = model.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{}); err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+
+	// Test
+	result, err := model.OptimizeRobust(gurobi.DefaultRobustPolicy())
+	if err != nil {
+		t.Errorf("unexpected error in OptimizeRobust: %v", err)
+	}
+
+	if !result.Succeeded || result.Attempts != 1 || result.Status != gurobi.StatusOptimal {
+		t.Errorf("expected a single successful attempt; received %+v", result)
+	}
+}
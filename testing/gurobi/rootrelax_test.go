@@ -0,0 +1,65 @@
+package gurobi_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+rootrelax_test.go
+Description:
+	Tests the parsing of the root relaxation of a MIP from its log.
+*/
+
+/*
+TestTimingLogParser_Root1
+Description:
+
+	Tests that the objective, iterations and time of the root relaxation are
+	parsed, and that the root gap is computed from them.
+*/
+func TestTimingLogParser_Root1(t *testing.T) {
+	// Constants
+	parser := &gurobi.TimingLogParser{}
+
+	// Algorithm
+	parser.Feed(timingsMIPLog)
+	root := parser.Root
+
+	// Test
+	if !root.Available || root.Status != "objective" || root.Objective != 45.0 || root.Iterations != 12 || root.Time != 0.31 {
+		t.Errorf("unexpected root relaxation: %+v", root)
+	}
+
+	if gap := root.Gap(50.0, gurobi.Minimize); math.Abs(gap-0.1) > 1e-12 {
+		t.Errorf("expected a root gap of 0.1; received %v", gap)
+	}
+}
+
+/*
+TestTimingLogParser_Root2
+Description:
+
+	Tests a root relaxation which was not solved, and a log without one.
+*/
+func TestTimingLogParser_Root2(t *testing.T) {
+	// Constants
+	parser := &gurobi.TimingLogParser{}
+
+	// Algorithm
+	parser.Feed("Root relaxation: infeasible, 3 iterations, 0.01 seconds\n")
+
+	// Test
+	if root := parser.Root; !root.Available || root.Status != "infeasible" || root.Iterations != 3 {
+		t.Errorf("unexpected root relaxation: %+v", root)
+	}
+	if gap := parser.Root.Gap(1.0, gurobi.Minimize); !math.IsInf(gap, 1) {
+		t.Errorf("expected an infinite root gap; received %v", gap)
+	}
+
+	if root := (&gurobi.TimingLogParser{}).Root; root.Available {
+		t.Errorf("expected no root relaxation; received %+v", root)
+	}
+}
@@ -0,0 +1,96 @@
+package gurobi_test
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+scaling_test.go
+Description:
+	Tests the row scaling utility.
+*/
+
+/*
+TestRowScaling_UnscaleDuals1
+Description:
+
+	Verifies that duals are multiplied (and slacks divided) by the row factors.
+*/
+func TestRowScaling_UnscaleDuals1(t *testing.T) {
+	rs := gurobi.RowScaling{Factors: []float64{2.0, 0.5}}
+
+	pi, err := rs.UnscaleDuals([]float64{1.0, 4.0})
+	if err != nil || pi[0] != 2.0 || pi[1] != 2.0 {
+		t.Errorf("unexpected duals %v (%v)", pi, err)
+	}
+
+	slacks, err := rs.UnscaleSlacks([]float64{1.0, 4.0})
+	if err != nil || slacks[0] != 0.5 || slacks[1] != 8.0 {
+		t.Errorf("unexpected slacks %v (%v)", slacks, err)
+	}
+
+	if _, err := rs.UnscaleDuals([]float64{1.0}); err == nil {
+		t.Errorf("expected an error for mismatched lengths, but none were thrown!")
+	}
+}
+
+/*
+TestModel_NormalizeRows1
+Description:
+
+	Maximizes x subject to 1000 x <= 3000, normalizes the row to a largest
+	coefficient of 1 and verifies the factor, the optimum (x = 3) and the
+	original dual (1/1000).
+*/
+func TestModel_NormalizeRows1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("scaling1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("scaling1.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("scaling1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	if _, err := model.AddConstr([]*gurobi.Var{x}, []float64{1000.0}, gurobi.Le, 3000.0, "c0"); err != nil {
+		t.Errorf("There was an issue adding c0: %v", err)
+	}
+	if err := model.SetMaximize(); err != nil {
+		t.Errorf("There was an issue setting the model sense: %v", err)
+	}
+
+	scaling, err := model.NormalizeRows(1.0)
+	if err != nil {
+		t.Errorf("There was an issue normalizing the rows: %v", err)
+	}
+	if scaling.Factors[0] != 0.001 {
+		t.Errorf("expected the factor 0.001; received %v", scaling.Factors)
+	}
+
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+	xVal, err := x.GetDouble(gurobi.DBL_ATTR_X)
+	if err != nil || math.Abs(xVal-3.0) > 1e-9 {
+		t.Errorf("expected x = 3; received %v (%v)", xVal, err)
+	}
+
+	pi, err := scaling.OriginalDuals(model)
+	if err != nil || math.Abs(pi[0]-0.001) > 1e-9 {
+		t.Errorf("expected the original dual 0.001; received %v (%v)", pi, err)
+	}
+}
@@ -0,0 +1,156 @@
+package gurobi_test
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+scenario_test.go
+Description:
+	Tests the scenario batch runner and its comparison table.
+*/
+
+/*
+TestModel_RunScenarios1
+Description:
+
+	Solves max x + y s.t. x + y <= 4, x <= 3 under a scenario which changes
+	the right-hand side and one which changes the bound of x, one after the
+	other and in parallel, and checks that the base model is unchanged.
+*/
+func TestModel_RunScenarios1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("scenarios1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("scenarios1.log")
+
+	model, err := gurobi.NewModel("scenarios1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, 3.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	y, err := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+	c, err := model.AddConstr([]*gurobi.Var{x, y}, []float64{1.0, 1.0}, '<', 4.0, "c")
+	if err != nil {
+		t.Errorf("There was an issue adding c: %v", err)
+	}
+	if err := model.SetModelSense(gurobi.Maximize); err != nil {
+		t.Errorf("There was an issue setting the sense: %v", err)
+	}
+
+	scenarios := []gurobi.Scenario{
+		{Name: "tight", RHS: []gurobi.ConstrOverride{{Constr: c, Value: 2.0}}},
+		{Name: "small-x", UB: []gurobi.VarOverride{{Var: x, Value: 1.0}}, Params: map[string]string{"Threads": "1"}},
+	}
+
+	for _, parallel := range []int{0, 2} {
+		// Algorithm
+		table, err := model.RunScenarios(scenarios, gurobi.ScenarioOptions{Report: []*gurobi.Var{x}, Parallel: parallel})
+		if err != nil {
+			t.Errorf("There was an issue running the scenarios: %v", err)
+			continue
+		}
+
+		// Test
+		if table.VarNames[0] != "x" || len(table.Results) != 2 {
+			t.Errorf("unexpected table: %+v", table)
+			continue
+		}
+		if tight := table.Results[0]; tight.Err != nil || tight.ObjVal != 2.0 {
+			t.Errorf("expected an objective of 2 in the tight scenario; received %+v", tight)
+		}
+		if smallX := table.Results[1]; smallX.Err != nil || smallX.ObjVal != 2.0 || smallX.Values[0] != 1.0 {
+			t.Errorf("expected x = 1 and an objective of 2 in the small-x scenario; received %+v", smallX)
+		}
+	}
+
+	if ub, err := x.GetDouble("UB"); err != nil || ub != 3.0 {
+		t.Errorf("expected the base model to be unchanged; the bound of x is %v (%v)", ub, err)
+	}
+}
+
+/*
+TestModel_RunScenarios2
+Description:
+
+	Tests that invalid scenarios and options are rejected before anything is solved.
+*/
+func TestModel_RunScenarios2(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("scenarios2")
+	x, err := model.AddVar(gurobi.CONTINUOUS, 1.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+
+	invalid := []struct {
+		scenarios []gurobi.Scenario
+		opts      gurobi.ScenarioOptions
+	}{
+		{[]gurobi.Scenario{{Name: ""}}, gurobi.ScenarioOptions{}},
+		{[]gurobi.Scenario{{Name: "a"}, {Name: "a"}}, gurobi.ScenarioOptions{}},
+		{[]gurobi.Scenario{{Name: "a", LB: []gurobi.VarOverride{{Var: nil}}}}, gurobi.ScenarioOptions{}},
+		{[]gurobi.Scenario{{Name: "a", UB: []gurobi.VarOverride{{Var: x, Value: math.NaN()}}}}, gurobi.ScenarioOptions{}},
+		{[]gurobi.Scenario{{Name: "a", Params: map[string]string{"Threads": "1"}}}, gurobi.ScenarioOptions{MultiScenario: true}},
+		{[]gurobi.Scenario{{Name: "a"}}, gurobi.ScenarioOptions{MultiScenario: true, Parallel: 2}},
+	}
+
+	// Test
+	for i, test := range invalid {
+		if _, err := model.RunScenarios(test.scenarios, test.opts); err == nil {
+			t.Errorf("expected an error for case %v, but none were thrown!", i)
+		}
+	}
+}
+
+/*
+TestScenarioTable_Write1
+Description:
+
+	Tests the best scenario of a table and its written form.
+*/
+func TestScenarioTable_Write1(t *testing.T) {
+	// Constants
+	table := &gurobi.ScenarioTable{
+		VarNames: []string{"x"},
+		Results: []gurobi.ScenarioResult{
+			{Scenario: "base", Status: gurobi.StatusOptimal, ObjVal: 3.0, Values: []float64{3.0}},
+			{Scenario: "cheap", Status: gurobi.StatusOptimal, ObjVal: 2.0, Values: []float64{1.0}},
+			{Scenario: "infeasible", Status: gurobi.StatusInfeasible, ObjVal: math.NaN(), Values: []float64{math.NaN()}},
+		},
+	}
+
+	// Test
+	if best := table.Best(gurobi.Minimize); best == nil || best.Scenario != "cheap" {
+		t.Errorf("expected the cheap scenario to be the best when minimizing; received %+v", best)
+	}
+	if best := table.Best(gurobi.Maximize); best == nil || best.Scenario != "base" {
+		t.Errorf("expected the base scenario to be the best when maximizing; received %+v", best)
+	}
+
+	var buf bytes.Buffer
+	if err := table.Write(&buf); err != nil {
+		t.Errorf("There was an issue writing the table: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "SCENARIO") || !strings.HasSuffix(lines[0], "x") || !strings.HasPrefix(lines[2], "cheap") {
+		t.Errorf("unexpected table:\n%v", buf.String())
+	}
+}
@@ -0,0 +1,88 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+sense_test.go
+Description:
+	This file tests the Sense and ObjSense types in the gurobi package.
+*/
+
+/*
+TestSense_Check1
+Description:
+
+	Tests that the legacy char codes are all valid senses.
+*/
+func TestSense_Check1(t *testing.T) {
+	// Constants
+	senses := []gurobi.Sense{'<', '>', '=', gurobi.SenseLessThan, gurobi.Le, gurobi.Ge, gurobi.Eq}
+
+	// Test
+	for _, sense := range senses {
+		if err := sense.Check(); err != nil {
+			t.Errorf("unexpected error for sense %v: %v", sense, err)
+		}
+	}
+}
+
+/*
+TestSense_Check2
+Description:
+
+	Tests that Check() throws an error for an unrecognized sense.
+*/
+func TestSense_Check2(t *testing.T) {
+	// Constants
+	sense := gurobi.Sense('!')
+
+	// Test
+	err := sense.Check()
+	if err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	} else {
+		if err.Error() != (gurobi.InvalidSenseError{Sense: '!'}).Error() {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+/*
+TestSense_String1
+Description:
+
+	Tests the String() method of each valid sense.
+*/
+func TestSense_String1(t *testing.T) {
+	if gurobi.Le.String() != "<=" {
+		t.Errorf("unexpected string for Le: %v", gurobi.Le)
+	}
+
+	if gurobi.Ge.String() != ">=" {
+		t.Errorf("unexpected string for Ge: %v", gurobi.Ge)
+	}
+
+	if gurobi.Eq.String() != "==" {
+		t.Errorf("unexpected string for Eq: %v", gurobi.Eq)
+	}
+}
+
+/*
+TestObjSense_Check1
+Description:
+
+	Tests that the ObjSense constants match the legacy MINIMIZE and MAXIMIZE constants.
+*/
+func TestObjSense_Check1(t *testing.T) {
+	if gurobi.Minimize != gurobi.MINIMIZE || gurobi.Maximize != gurobi.MAXIMIZE {
+		t.Errorf("ObjSense constants do not match the legacy constants!")
+	}
+
+	if err := gurobi.ObjSense(0).Check(); err == nil {
+		t.Errorf("expected an error for ObjSense(0), but none were thrown!")
+	}
+}
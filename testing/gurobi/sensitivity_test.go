@@ -0,0 +1,77 @@
+package gurobi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+sensitivity_test.go
+Description:
+	Tests the SensitivityReport object.
+*/
+
+// sensitivityTestReport is a small report with one variable and one constraint.
+func sensitivityTestReport() *gurobi.SensitivityReport {
+	return &gurobi.SensitivityReport{
+		Vars: []gurobi.VarSensitivity{
+			{Name: "x", Value: 2, Obj: 1, ReducedCost: 0, ObjLow: 0.5, ObjUp: 1e100},
+		},
+		Constrs: []gurobi.ConstrSensitivity{
+			{Name: "c0", RHS: 4, Slack: 0, ShadowPrice: 0.25, RHSLow: 2, RHSUp: 8},
+		},
+	}
+}
+
+/*
+TestSensitivityReport_WriteJSON1
+Description:
+
+	Verifies that the JSON output can be decoded back into an equal report.
+*/
+func TestSensitivityReport_WriteJSON1(t *testing.T) {
+	// Constants
+	report := sensitivityTestReport()
+	var buf bytes.Buffer
+
+	// Algorithm
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	decoded := gurobi.SensitivityReport{}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Errorf("unexpected error decoding the report: %v", err)
+	}
+
+	// Test
+	if decoded.Vars[0] != report.Vars[0] || decoded.Constrs[0] != report.Constrs[0] {
+		t.Errorf("expected %+v; received %+v", report, decoded)
+	}
+}
+
+/*
+TestSensitivityReport_WriteConstrsCSV1
+Description:
+
+	Verifies the CSV output of the constraints.
+*/
+func TestSensitivityReport_WriteConstrsCSV1(t *testing.T) {
+	// Constants
+	report := sensitivityTestReport()
+	var buf bytes.Buffer
+
+	// Algorithm
+	if err := report.WriteConstrsCSV(&buf); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// Test
+	expected := "name,rhs,slack,shadow_price,rhs_low,rhs_up\nc0,4,0,0.25,2,8\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q; received %q", expected, buf.String())
+	}
+}
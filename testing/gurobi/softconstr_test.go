@@ -0,0 +1,121 @@
+package gurobi_test
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+softconstr_test.go
+Description:
+	Tests soft constraints and the reporting of their violations.
+*/
+
+/*
+TestModel_AddSoftConstr1
+Description:
+
+	Tests the slacks of soft constraints of each sense, and that their
+	penalties change sign when the model is maximized.
+*/
+func TestModel_AddSoftConstr1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("soft1")
+	vars, err := model.AddVarsWithTypes(2, gurobi.Continuous)
+	if err != nil {
+		t.Errorf("There was an issue adding variables: %v", err)
+	}
+	sum := &gurobi.LinExpr{}
+	sum.AddTerm(vars[0], 1.0).AddTerm(vars[1], 1.0)
+
+	// Algorithm
+	le, err := model.AddSoftConstr(sum.LessEq(4.0), 2.0, "cap")
+	if err != nil {
+		t.Errorf("There was an issue adding a soft <= constraint: %v", err)
+	}
+	eq, err := model.AddSoftConstr(sum.Equal(3.0), 1.0, "target")
+	if err != nil {
+		t.Errorf("There was an issue adding a soft = constraint: %v", err)
+	}
+
+	// Test
+	if le.Over == nil || le.Under != nil || eq.Over == nil || eq.Under == nil {
+		t.Errorf("unexpected slacks: %+v, %+v", le, eq)
+	}
+	if len(model.SoftConstrs()) != 2 || len(model.Variables) != 5 || len(model.Constraints) != 2 {
+		t.Errorf("unexpected model: %v soft constraints, %v variables, %v constraints", len(model.SoftConstrs()), len(model.Variables), len(model.Constraints))
+	}
+
+	if err := model.SetModelSense(gurobi.Maximize); err != nil {
+		t.Errorf("There was an issue maximizing: %v", err)
+	}
+	calls := model.DryRun().Calls
+	if last := calls[len(calls)-1]; last.Method != "SetObj" || last.Detail != "element 4: -1" {
+		t.Errorf("expected the last penalty to be negated; received %+v", last)
+	}
+
+	if _, err := model.AddSoftConstr(sum.LessEq(1.0), 0.0, "free"); err == nil {
+		t.Errorf("expected an error for a zero weight, but none were thrown!")
+	}
+}
+
+/*
+TestModel_SoftConstrReport1
+Description:
+
+	Maximizes x + y with x, y <= 3 and a soft cap x + y <= 4 of weight 0.5,
+	so that violating the cap by 2 pays off, and checks the report.
+*/
+func TestModel_SoftConstrReport1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("soft2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("soft2.log")
+
+	model, err := gurobi.NewModel("soft2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 3.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	y, err := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 3.0, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+	sum := &gurobi.LinExpr{}
+	sum.AddTerm(x, 1.0).AddTerm(y, 1.0)
+
+	if _, err := model.AddSoftConstr(sum.LessEq(4.0), 0.5, "cap"); err != nil {
+		t.Errorf("There was an issue adding the soft constraint: %v", err)
+	}
+
+	// Algorithm
+	if err := model.SetObjective(sum, gurobi.Maximize); err != nil {
+		t.Errorf("There was an issue setting the objective: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing: %v", err)
+	}
+	reports, total, err := model.SoftConstrReport()
+
+	// Test
+	if err != nil || len(reports) != 1 {
+		t.Fatalf("unexpected report: %v (%v)", reports, err)
+	}
+	if math.Abs(reports[0].Violation-2.0) > 1e-6 || math.Abs(total-1.0) > 1e-6 {
+		t.Errorf("expected a violation of 2 and a total penalty of 1; received %+v, %v", reports[0], total)
+	}
+	if objVal, err := model.ObjVal(); err != nil || math.Abs(objVal-5.0) > 1e-6 {
+		t.Errorf("expected an objective of 5; received %v (%v)", objVal, err)
+	}
+}
@@ -0,0 +1,168 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+solution_test.go
+Description:
+	Tests the name-keyed solution functions.
+*/
+
+/*
+TestSolutionTable_NewSolutionTable1
+Description:
+
+	Verifies that NewSolutionTable() rejects repeated names.
+*/
+func TestSolutionTable_NewSolutionTable1(t *testing.T) {
+	// Test
+	_, err := gurobi.NewSolutionTable([]string{"x", "x"}, []float64{1, 2})
+	if err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
+
+/*
+TestSolutionTable_Vector1
+Description:
+
+	Verifies the prefix, vector, matrix and indexed accessors.
+*/
+func TestSolutionTable_Vector1(t *testing.T) {
+	// Constants
+	names := []string{"x[0]", "x[1]", "y[0,1]", "y[2,3]", "z", "x[a]"}
+	values := []float64{1, 2, 3, 4, 5, 6}
+
+	// Algorithm
+	table, err := gurobi.NewSolutionTable(names, values)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// Test
+	x := table.Vector("x")
+	if len(x) != 2 || x[0] != 1 || x[1] != 2 {
+		t.Errorf("unexpected vector: %v", x)
+	}
+
+	y := table.Matrix("y")
+	if len(y) != 2 || y[[2]int{2, 3}] != 4 {
+		t.Errorf("unexpected matrix: %v", y)
+	}
+
+	if len(table.WithPrefix("x")) != 3 {
+		t.Errorf("expected 3 values with prefix x; received %v", table.WithPrefix("x"))
+	}
+
+	if v, err := table.At("y", 0, 1); err != nil || v != 3 {
+		t.Errorf("expected y[0,1] = 3; received %v (error: %v)", v, err)
+	}
+
+	if _, err := table.Get("w"); err == nil {
+		t.Errorf("expected an error for an unknown name, but none were thrown!")
+	}
+}
+
+/*
+TestSparseSolution_SparsifySolution1
+Description:
+
+	Verifies that only entries above the tolerance are kept and that Get()
+	returns 0 for the others.
+*/
+func TestSparseSolution_SparsifySolution1(t *testing.T) {
+	// Constants
+	x := []float64{0, 1e-9, -2, 0, 3.5, -1e-7}
+
+	// Algorithm
+	sparse := gurobi.SparsifySolution(x, 1e-6)
+
+	// Test
+	if sparse.Len() != 2 || sparse.Indices[0] != 2 || sparse.Indices[1] != 4 {
+		t.Errorf("unexpected sparse solution: %v", sparse)
+	}
+
+	if sparse.Get(4) != 3.5 || sparse.Get(1) != 0 || sparse.Get(10) != 0 {
+		t.Errorf("unexpected values from Get(): %v, %v, %v", sparse.Get(4), sparse.Get(1), sparse.Get(10))
+	}
+}
+
+/*
+TestSolution_Round1
+Description:
+
+	Verifies that near-integer values of integral variables are snapped,
+	continuous values are untouched and values outside the tolerance are reported.
+*/
+func TestSolution_Round1(t *testing.T) {
+	// Constants
+	sol := &gurobi.Solution{
+		Values: []float64{0.9999999, 0.4999, 2.0000001, -1e-9, 0.5},
+		VTypes: []gurobi.VarType{gurobi.Binary, gurobi.Continuous, gurobi.Integer, gurobi.Integer, gurobi.Integer},
+	}
+
+	// Algorithm
+	rounded, violations, err := sol.Round(1e-5)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// Test
+	expected := []float64{1, 0.4999, 2, 0, 0.5}
+	for i, value := range expected {
+		if rounded.Values[i] != value {
+			t.Errorf("expected value %v at index %v; received %v", value, i, rounded.Values[i])
+		}
+	}
+
+	if len(violations) != 1 || violations[0].Index != 4 {
+		t.Errorf("unexpected violations: %v", violations)
+	}
+
+	if sol.Values[0] != 0.9999999 {
+		t.Errorf("Round() should not modify the original solution")
+	}
+}
+
+/*
+TestSolution_ObjectiveBreakdown1
+Description:
+
+	Verifies the contribution of each group to the objective, and that a
+	variable outside of the solution is rejected.
+*/
+func TestSolution_ObjectiveBreakdown1(t *testing.T) {
+	// Constants
+	sol := &gurobi.Solution{
+		Values: []float64{2.0, 3.0, 1.0, 4.0},
+		VTypes: []gurobi.VarType{gurobi.Continuous, gurobi.Continuous, gurobi.Binary, gurobi.Continuous},
+		Obj:    []float64{10.0, 5.0, 100.0, 0.5},
+	}
+	vars := []*gurobi.Var{{Index: 0}, {Index: 1}, {Index: 2}, {Index: 3}}
+
+	// Algorithm
+	breakdown, err := sol.ObjectiveBreakdown(map[string][]*gurobi.Var{
+		"labor":     {vars[0], vars[1]},
+		"transport": {vars[2]},
+		"penalties": {vars[3]},
+	})
+	if err != nil {
+		t.Errorf("There was an issue computing the breakdown: %v", err)
+	}
+
+	// Test
+	expected := map[string]float64{"labor": 35.0, "transport": 100.0, "penalties": 2.0}
+	for group, contribution := range expected {
+		if breakdown[group] != contribution {
+			t.Errorf("expected a contribution of %v for %v; received %v", contribution, group, breakdown[group])
+		}
+	}
+
+	if _, err := sol.ObjectiveBreakdown(map[string][]*gurobi.Var{"other": {{Index: 4}}}); err == nil {
+		t.Errorf("expected an error for a variable outside of the solution, but none were thrown!")
+	}
+}
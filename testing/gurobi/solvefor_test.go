@@ -0,0 +1,77 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+solvefor_test.go
+Description:
+	Tests the bounded solves of SolveFor.
+*/
+
+/*
+TestModel_SolveFor1
+Description:
+
+	Verifies that SolveFor rejects durations which are not positive.
+*/
+func TestModel_SolveFor1(t *testing.T) {
+	model := gurobi.NewDryRunModel("solvefor1")
+	defer model.Free()
+
+	if _, err := model.SolveFor(0); err == nil {
+		t.Errorf("expected an error for a duration of 0, but none were thrown!")
+	}
+}
+
+/*
+TestModel_SolveFor2
+Description:
+
+	Solves max x subject to x <= 3 within a second and verifies that the
+	solve is done, that its incumbent is reported and that the TimeLimit
+	parameter is restored.
+*/
+func TestModel_SolveFor2(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("solvefor2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("solvefor2.log")
+
+	model, err := gurobi.NewModel("solvefor2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	if _, err := model.AddVar(gurobi.Integer, 1.0, 0.0, 3.0, "x", []*gurobi.Constr{}, []float64{}); err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	if err := model.SetMaximize(); err != nil {
+		t.Errorf("unexpected error setting the model sense: %v", err)
+	}
+
+	progress, err := model.SolveFor(time.Second)
+	if err != nil {
+		t.Errorf("There was an issue solving the model: %v", err)
+	}
+	if !progress.Done || progress.SolCount == 0 || progress.Incumbent != 3.0 {
+		t.Errorf("unexpected progress: %+v", progress)
+	}
+
+	timeLimit, err := model.GetDBLParam("TimeLimit")
+	if err != nil {
+		t.Errorf("There was an issue getting the time limit: %v", err)
+	}
+	if timeLimit != gurobi.INFINITY {
+		t.Errorf("expected the time limit to be restored; received %v", timeLimit)
+	}
+}
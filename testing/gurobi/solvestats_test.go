@@ -0,0 +1,60 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+solvestats_test.go
+Description:
+	Tests the parsing of cut and heuristic statistics from a MIP log.
+*/
+
+const statsMIPLog = `Found heuristic solution: objective 60.0000000
+Found heuristic solution: objective 55.0000000
+
+    Nodes    |    Current Node    |     Objective Bounds      |     Work
+ Expl Unexpl |  Obj  Depth IntInf | Incumbent    BestBd   Gap | It/Node Time
+
+     0     0   45.00000    0    2   55.00000   45.00000  18.2%     -    0s
+H    0     0                      50.00000   45.00000  10.0%     -    0s
+*   12     4               5      47.00000   46.00000  2.13%   3.2    0s
+
+Cutting planes:
+  Gomory: 3
+  Flow cover: 2
+  MIR: 5
+
+Explored 20 nodes (80 simplex iterations) in 0.50 seconds (0.02 work units)
+`
+
+/*
+TestTimingLogParser_Stats1
+Description:
+
+	Tests that cuts by family and heuristic and node solutions are counted.
+*/
+func TestTimingLogParser_Stats1(t *testing.T) {
+	// Constants
+	parser := &gurobi.TimingLogParser{}
+
+	// Algorithm
+	parser.Feed(statsMIPLog)
+	stats := parser.Stats
+
+	// Test
+	if stats.Cuts["Gomory"] != 3 || stats.Cuts["Flow cover"] != 2 || stats.Cuts["MIR"] != 5 || len(stats.Cuts) != 3 {
+		t.Errorf("unexpected cuts: %v", stats.Cuts)
+	}
+	if stats.TotalCuts() != 10 {
+		t.Errorf("expected 10 cuts in total; received %v", stats.TotalCuts())
+	}
+	if stats.HeuristicSolutions != 3 || stats.NodeSolutions != 1 {
+		t.Errorf("unexpected solution counts: %+v", stats)
+	}
+	if parser.Timings.Total != 0.5 {
+		t.Errorf("expected the total time to be parsed after the cut summary; received %v", parser.Timings.Total)
+	}
+}
@@ -0,0 +1,91 @@
+package gurobi_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+sos_test.go
+Description:
+	Tests the introspection of SOS constraints.
+*/
+
+/*
+TestModel_GetSOS1
+Description:
+
+	Tests that the type, variables and weights of an SOS constraint can be read back.
+*/
+func TestModel_GetSOS1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("getsos1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("getsos1.log")
+
+	model, err := gurobi.NewModel("getsos1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	vars, err := model.AddVarsWithTypes(3, gurobi.Continuous)
+	if err != nil {
+		t.Errorf("There was an issue adding variables: %v", err)
+	}
+	if _, err := model.AddSOS(vars, []float64{1.0, 2.0, 3.0}, gurobi.SOSType2); err != nil {
+		t.Errorf("There was an issue adding the SOS constraint: %v", err)
+	}
+
+	// Test
+	numSOS, err := model.NumSOS()
+	if err != nil || numSOS != 1 {
+		t.Errorf("expected 1 SOS constraint; received %v (%v)", numSOS, err)
+	}
+
+	data, err := model.SOSs[0].Data()
+	if err != nil {
+		t.Errorf("There was an issue reading the SOS constraint: %v", err)
+	} else if data.Type != gurobi.SOSType2 || len(data.Vars) != 3 || data.Vars[2].Index != vars[2].Index || data.Weights[1] != 2.0 {
+		t.Errorf("unexpected SOS data: %+v", data)
+	}
+
+	if _, err := model.GetSOS(1); err == nil {
+		t.Errorf("expected an error for an out-of-range SOS index")
+	}
+}
+
+/*
+TestModel_GetSOS2
+Description:
+
+	Tests that a dry-run model counts its SOS constraints but cannot return their structure.
+*/
+func TestModel_GetSOS2(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("getsos2")
+
+	vars, err := model.AddVarsWithTypes(2, gurobi.Continuous)
+	if err != nil {
+		t.Errorf("There was an issue adding variables: %v", err)
+	}
+	if _, err := model.AddSOS(vars, []float64{1.0, 2.0}, gurobi.SOSType1); err != nil {
+		t.Errorf("There was an issue adding the SOS constraint: %v", err)
+	}
+
+	// Test
+	numSOS, err := model.NumSOS()
+	if err != nil || numSOS != 1 {
+		t.Errorf("expected 1 SOS constraint; received %v (%v)", numSOS, err)
+	}
+
+	if _, err := model.GetSOS(0); !errors.Is(err, gurobi.ErrDryRun) {
+		t.Errorf("expected ErrDryRun; received %v", err)
+	}
+}
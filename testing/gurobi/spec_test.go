@@ -0,0 +1,125 @@
+package gurobi_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+spec_test.go
+Description:
+	Tests the ModelSpec in-memory model description.
+*/
+
+// makeSpec returns max x + 2 y s.t. x + y <= 1, with x continuous and y binary.
+func makeSpec() *gurobi.ModelSpec {
+	spec := gurobi.NewModelSpec("spec")
+	spec.Sense = gurobi.Maximize
+	x := spec.AddVar(gurobi.Continuous, 1.0, 0.0, 1.0, "x")
+	y := spec.AddVar(gurobi.Binary, 2.0, 0.0, 1.0, "y")
+	spec.AddConstr([]int32{x, y}, []float64{1.0, 1.0}, gurobi.Le, 1.0, "c0")
+	return spec
+}
+
+/*
+TestModelSpec_Check1
+Description:
+
+	Verifies that Check() rejects an out-of-range index and accepts a valid spec.
+*/
+func TestModelSpec_Check1(t *testing.T) {
+	spec := makeSpec()
+	if err := spec.Check(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	spec.AddConstr([]int32{2}, []float64{1.0}, gurobi.Ge, 0.0, "bad")
+	if err := spec.Check(); err == nil {
+		t.Errorf("expected an error for an out-of-range index, but none were thrown!")
+	}
+}
+
+/*
+TestModelSpec_JSON1
+Description:
+
+	Verifies that a spec survives a round trip through JSON and that
+	Relax() does not modify the original spec.
+*/
+func TestModelSpec_JSON1(t *testing.T) {
+	spec := makeSpec()
+	relaxed := spec.Relax()
+	if relaxed.Vars[1].Type != gurobi.Continuous || spec.Vars[1].Type != gurobi.Binary {
+		t.Errorf("Relax() did not produce an independent continuous copy")
+	}
+
+	var buf bytes.Buffer
+	if err := spec.WriteJSON(&buf); err != nil {
+		t.Errorf("There was an issue writing the spec: %v", err)
+	}
+
+	read, err := gurobi.ReadModelSpecJSON(&buf)
+	if err != nil {
+		t.Errorf("There was an issue reading the spec: %v", err)
+	}
+	if read.Name != "spec" || read.Sense != gurobi.Maximize || len(read.Vars) != 2 || read.NumNZs() != 2 || read.Vars[1].Type != gurobi.Binary {
+		t.Errorf("the spec did not survive the round trip: %+v", read)
+	}
+}
+
+/*
+TestModelSpec_Build1
+Description:
+
+	Builds the spec into a dry-run model and checks the recorded statistics.
+*/
+func TestModelSpec_Build1(t *testing.T) {
+	model := gurobi.NewDryRunModel("spec")
+	defer model.Free()
+
+	if err := makeSpec().Build(model); err != nil {
+		t.Errorf("There was an issue building the spec: %v", err)
+	}
+
+	dr := model.DryRun()
+	if dr.NumVars != 2 || dr.NumConstrs != 1 || dr.NumNZs != 2 || dr.ModelSense != gurobi.Maximize {
+		t.Errorf("unexpected dry-run statistics: %v", dr.Summary())
+	}
+}
+
+/*
+TestModelSpec_Compile1
+Description:
+
+	Compiles the spec into a Gurobi model and verifies the optimal objective (2).
+*/
+func TestModelSpec_Compile1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("spec1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("spec1.log")
+
+	model, err := makeSpec().Compile(env)
+	if err != nil {
+		t.Errorf("There was an issue compiling the spec: %v", err)
+	}
+	defer model.Free()
+
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+
+	obj, err := model.ObjVal()
+	if err != nil {
+		t.Errorf("There was an issue getting the objective value: %v", err)
+	}
+	if obj != 2.0 {
+		t.Errorf("expected an objective of 2; received %v", obj)
+	}
+}
@@ -0,0 +1,74 @@
+package gurobi_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+status_test.go
+Description:
+	Tests the Status type and its explanations.
+*/
+
+/*
+TestStatus_String1
+Description:
+
+	Tests that the String() method matches the names in Gurobi's documentation.
+*/
+func TestStatus_String1(t *testing.T) {
+	if gurobi.StatusOptimal.String() != "OPTIMAL" {
+		t.Errorf("unexpected name for StatusOptimal: %v", gurobi.StatusOptimal)
+	}
+
+	if gurobi.Status(gurobi.ITERATION_LIMIT).String() != "ITERATION_LIMIT" {
+		t.Errorf("unexpected name for ITERATION_LIMIT: %v", gurobi.Status(gurobi.ITERATION_LIMIT))
+	}
+
+	if gurobi.Status(99).String() != "Status(99)" {
+		t.Errorf("unexpected name for an unknown status: %v", gurobi.Status(99))
+	}
+}
+
+/*
+TestStatus_Explain1
+Description:
+
+	Tests that the explanation of a NUMERIC status suggests the NumericFocus parameter.
+*/
+func TestStatus_Explain1(t *testing.T) {
+	// Constants
+	explanation := gurobi.StatusNumeric.Explain()
+
+	// Test
+	if len(explanation.NextSteps) == 0 {
+		t.Errorf("expected at least one next step for NUMERIC; received none")
+	}
+
+	if !strings.Contains(explanation.String(), "NumericFocus") {
+		t.Errorf("expected the explanation to mention NumericFocus; received %v", explanation)
+	}
+}
+
+/*
+TestStatus_Explain2
+Description:
+
+	Tests that the explanation of an ITERATION_LIMIT status suggests raising the IterationLimit.
+*/
+func TestStatus_Explain2(t *testing.T) {
+	// Constants
+	explanation := gurobi.StatusIterationLimit.Explain()
+
+	// Test
+	if !strings.Contains(explanation.String(), "IterationLimit") {
+		t.Errorf("expected the explanation to mention IterationLimit; received %v", explanation)
+	}
+
+	if explanation.Status != gurobi.StatusIterationLimit {
+		t.Errorf("expected the explanation's status to be %v; received %v", gurobi.StatusIterationLimit, explanation.Status)
+	}
+}
@@ -0,0 +1,71 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+subtour_test.go
+Description:
+	Tests the SubtourEliminator.
+*/
+
+/*
+TestSubtourEliminator_Subtours1
+Description:
+
+	Verifies that two separate triangles among six nodes are detected as two subtours.
+*/
+func TestSubtourEliminator_Subtours1(t *testing.T) {
+	// Constants
+	arcs := map[gurobi.Arc]*gurobi.Var{}
+	sol := []float64{}
+	for i := 0; i < 6; i++ {
+		for j := i + 1; j < 6; j++ {
+			arcs[gurobi.Arc{From: i, To: j}] = &gurobi.Var{Index: int32(len(sol))}
+			selected := (i < 3 && j < 3) || (i >= 3 && j >= 3)
+			if selected {
+				sol = append(sol, 1.0)
+			} else {
+				sol = append(sol, 0.0)
+			}
+		}
+	}
+	se := gurobi.NewSubtourEliminator(arcs)
+
+	// Algorithm
+	tours, err := se.Subtours(sol)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// Test
+	if len(tours) != 2 {
+		t.Errorf("expected 2 subtours; received %v", tours)
+	}
+
+	if len(tours[0]) != 3 || tours[0][0] != 0 || tours[1][0] != 3 {
+		t.Errorf("unexpected subtours: %v", tours)
+	}
+}
+
+/*
+TestSubtourEliminator_Subtours2
+Description:
+
+	Verifies that Subtours() throws an error when an arc's variable is outside of the solution.
+*/
+func TestSubtourEliminator_Subtours2(t *testing.T) {
+	// Constants
+	arcs := map[gurobi.Arc]*gurobi.Var{
+		{From: 0, To: 1}: {Index: 3},
+	}
+	se := gurobi.NewSubtourEliminator(arcs)
+
+	// Test
+	if _, err := se.Subtours([]float64{0, 1}); err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
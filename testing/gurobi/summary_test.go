@@ -0,0 +1,85 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+summary_test.go
+Description:
+	Tests the solve summary getters (ObjVal, ObjBound, Runtime, etc.) in the gurobi package.
+*/
+
+/*
+TestModel_ObjVal1
+Description:
+
+	Solves a small LP (min x + y with x, y >= 1) and verifies that ObjVal()
+	and the other summary getters report sensible values.
+*/
+func TestModel_ObjVal1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("summary1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("summary1.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("summary1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	// Add variables
+	x, err := model.AddVar(gurobi.Continuous, 1.0, 1.0, gurobi.INFINITY, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+	y, err := model.AddVar(gurobi.Continuous, 1.0, 1.0, gurobi.INFINITY, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+
+	if _, err = model.AddConstr([]*gurobi.Var{x, y}, []float64{1.0, 1.0}, gurobi.Le, 10.0, "c0"); err != nil {
+		t.Errorf("There was an issue adding a constraint to the model: %v", err)
+	}
+
+	// Optimize
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+
+	// Test
+	objVal, err := model.ObjVal()
+	if err != nil {
+		t.Errorf("unexpected error retrieving ObjVal: %v", err)
+	}
+
+	if objVal != 2.0 {
+		t.Errorf("expected objective value %v; received %v", 2.0, objVal)
+	}
+
+	runtime, err := model.Runtime()
+	if err != nil {
+		t.Errorf("unexpected error retrieving Runtime: %v", err)
+	}
+
+	if runtime < 0.0 {
+		t.Errorf("expected a nonnegative runtime; received %v", runtime)
+	}
+
+	iterCount, err := model.IterCount()
+	if err != nil {
+		t.Errorf("unexpected error retrieving IterCount: %v", err)
+	}
+
+	if iterCount < 0.0 {
+		t.Errorf("expected a nonnegative iteration count; received %v", iterCount)
+	}
+}
@@ -0,0 +1,119 @@
+package gurobi_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+tag_test.go
+Description:
+	Tests the tagging of variables and constraints.
+*/
+
+/*
+TestModel_Tag1
+Description:
+
+	Tags variables and constraints of a dry-run model and verifies that
+	ByTag returns them (sorted by index) and that Untag removes them.
+*/
+func TestModel_Tag1(t *testing.T) {
+	model := gurobi.NewDryRunModel("tag1")
+	defer model.Free()
+
+	vars, err := model.AddVarsWithTypes(3, gurobi.Continuous)
+	if err != nil {
+		t.Errorf("There was an issue adding variables to the model: %v", err)
+	}
+	c, err := model.AddConstr(vars, []float64{1.0, 1.0, 1.0}, gurobi.Le, 5.0, "capacity")
+	if err != nil {
+		t.Errorf("There was an issue adding a constraint to the model: %v", err)
+	}
+
+	if err := model.Tag([]*gurobi.Var{vars[2], vars[0]}, "capacity", "week3"); err != nil {
+		t.Errorf("There was an issue tagging the vars: %v", err)
+	}
+	if err := model.Tag(c, "capacity"); err != nil {
+		t.Errorf("There was an issue tagging the constraint: %v", err)
+	}
+	if err := model.Tag(3.0, "capacity"); err == nil {
+		t.Errorf("expected an error when tagging a float, but none were thrown!")
+	}
+
+	group := model.ByTag("capacity")
+	if group.Size() != 3 || len(group.Vars) != 2 || group.Vars[0].Index != 0 || group.Vars[1].Index != 2 || group.Constrs[0].Index != 0 {
+		t.Errorf("unexpected group: %+v", group)
+	}
+
+	if err := model.Untag(vars[0], "capacity"); err != nil {
+		t.Errorf("There was an issue untagging the var: %v", err)
+	}
+	if len(model.ByTag("capacity").Vars) != 1 || len(model.ByTag("week3").Vars) != 2 {
+		t.Errorf("Untag did not remove exactly one tag")
+	}
+	if tags := model.Tags(); len(tags) != 2 || tags[0] != "capacity" {
+		t.Errorf("unexpected tags: %v", tags)
+	}
+}
+
+/*
+TestTagGroup_Fix1
+Description:
+
+	Solves max x + y with x, y <= 2, fixes the tagged variable x,
+	flips the objective of x and verifies that x stays at 2.
+*/
+func TestTagGroup_Fix1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("tag2.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("tag2.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("tag2", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, 2.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	if _, err := model.AddVar(gurobi.Continuous, 1.0, 0.0, 2.0, "y", []*gurobi.Constr{}, []float64{}); err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+	if err := model.SetMaximize(); err != nil {
+		t.Errorf("There was an issue setting the model sense: %v", err)
+	}
+	if err := model.Tag(x, "fixed"); err != nil {
+		t.Errorf("There was an issue tagging x: %v", err)
+	}
+
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+	if err := model.ByTag("fixed").Fix(); err != nil {
+		t.Errorf("There was an issue fixing the group: %v", err)
+	}
+	if err := x.SetObj(-1.0); err != nil {
+		t.Errorf("There was an issue changing the objective of x: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing the model: %v", err)
+	}
+
+	values, err := model.ByTag("fixed").Values()
+	if err != nil {
+		t.Errorf("There was an issue getting the values: %v", err)
+	}
+	if len(values) != 1 || values[0] != 2.0 {
+		t.Errorf("expected x to stay at 2; received %v", values)
+	}
+}
@@ -0,0 +1,62 @@
+package gurobi_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+targets_test.go
+Description:
+	Tests the objective target helpers.
+*/
+
+/*
+TestModel_StopWhenBetterThan1
+Description:
+
+	Verifies that StopWhenBetterThan sets both BestObjStop and Cutoff and
+	that NaN targets are rejected.
+*/
+func TestModel_StopWhenBetterThan1(t *testing.T) {
+	model := gurobi.NewDryRunModel("targets1")
+	defer model.Free()
+
+	if err := model.StopWhenBetterThan(42.0); err != nil {
+		t.Errorf("There was an issue setting the targets: %v", err)
+	}
+
+	params := model.DryRun().Params
+	if params["BestObjStop"] != "42" || params["Cutoff"] != "42" {
+		t.Errorf("expected BestObjStop and Cutoff to be 42; received %v", params)
+	}
+
+	if err := model.SetBestBdStop(math.NaN()); err == nil {
+		t.Errorf("expected an error for a NaN target, but none were thrown!")
+	}
+}
+
+/*
+TestModel_ClearObjTargets1
+Description:
+
+	Verifies that ClearObjTargets restores the defaults of a maximization model.
+*/
+func TestModel_ClearObjTargets1(t *testing.T) {
+	model := gurobi.NewDryRunModel("targets2")
+	defer model.Free()
+
+	if err := model.SetMaximize(); err != nil {
+		t.Errorf("There was an issue setting the model sense: %v", err)
+	}
+	if err := model.ClearObjTargets(); err != nil {
+		t.Errorf("There was an issue clearing the targets: %v", err)
+	}
+
+	params := model.DryRun().Params
+	if params["BestObjStop"] != "1e+100" || params["BestBdStop"] != "-1e+100" || params["Cutoff"] != "-1e+100" {
+		t.Errorf("unexpected targets for a maximization model: %v", params)
+	}
+}
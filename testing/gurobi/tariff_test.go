@@ -0,0 +1,126 @@
+package gurobi_test
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+tariff_test.go
+Description:
+	Tests the fixed-charge and multi-block tariff helpers.
+*/
+
+/*
+TestTariff_Cost1
+Description:
+
+	Verifies the validation, convexity and evaluated costs of fixed charges and tariffs.
+*/
+func TestTariff_Cost1(t *testing.T) {
+	// Constants
+	fc := gurobi.FixedCharge{Fixed: 10, Rate: 2}
+	discount := gurobi.Tariff{Blocks: []gurobi.TariffBlock{
+		{Width: 10, Rate: 3},
+		{Width: gurobi.INFINITY, Rate: 1, Fee: 5},
+	}}
+	convex := gurobi.Tariff{Blocks: []gurobi.TariffBlock{{Width: 10, Rate: 1}, {Width: 5, Rate: 2}}}
+
+	// Test
+	if fc.Cost(0) != 0 || fc.Cost(3) != 16 {
+		t.Errorf("unexpected fixed-charge costs: %v, %v", fc.Cost(0), fc.Cost(3))
+	}
+	if err := (gurobi.FixedCharge{Fixed: -1}).Check(); err == nil {
+		t.Errorf("expected an error for a negative fixed charge, but none were thrown!")
+	}
+
+	if err := discount.Check(); err != nil || discount.Convex() || !convex.Convex() {
+		t.Errorf("unexpected check or convexity: %v, %v, %v", err, discount.Convex(), convex.Convex())
+	}
+	if discount.Cost(4) != 12 || discount.Cost(15) != 40 {
+		t.Errorf("expected costs of 12 and 40; received %v and %v", discount.Cost(4), discount.Cost(15))
+	}
+	if convex.Cost(20) != 30 {
+		t.Errorf("expected consumption past the last block at the last rate; received %v", convex.Cost(20))
+	}
+
+	invalid := []gurobi.Tariff{
+		{},
+		{Blocks: []gurobi.TariffBlock{{Width: 0, Rate: 1}}},
+		{Blocks: []gurobi.TariffBlock{{Width: gurobi.INFINITY, Rate: 1}, {Width: 1, Rate: 1}}},
+		{Blocks: []gurobi.TariffBlock{{Width: 1, Rate: math.NaN()}}},
+	}
+	for i, tariff := range invalid {
+		if err := tariff.Check(); err == nil {
+			t.Errorf("expected an error for tariff %v, but none were thrown!", i)
+		}
+	}
+}
+
+/*
+TestModel_AddTariff1
+Description:
+
+	Buys at least 15 units under a volume-discount tariff and a fixed charge,
+	and checks that the optimal cost matches the evaluated tariff.
+*/
+func TestModel_AddTariff1(t *testing.T) {
+	// Constants
+	env, err := gurobi.NewEnv("tariff1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the environment: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("tariff1.log")
+
+	model, err := gurobi.NewModel("tariff1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the model: %v", err)
+	}
+	defer model.Free()
+
+	tariff := gurobi.Tariff{Blocks: []gurobi.TariffBlock{
+		{Width: 10, Rate: 3},
+		{Width: gurobi.INFINITY, Rate: 1, Fee: 5},
+	}}
+	fc := gurobi.FixedCharge{Fixed: 10, Rate: 2}
+
+	x, err := model.AddVar(gurobi.CONTINUOUS, 0.0, 15.0, 30.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	y, err := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 10.0, "y", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+
+	// Algorithm
+	tv, err := model.AddTariff(x, tariff, "x_tariff")
+	if err != nil {
+		t.Errorf("There was an issue adding the tariff: %v", err)
+	}
+	fv, err := model.AddFixedCharge(y, fc, "y_charge")
+	if err != nil {
+		t.Errorf("There was an issue adding the fixed charge: %v", err)
+	}
+	if fv.BigM != 10.0 || len(tv.Active) != 2 {
+		t.Errorf("unexpected big-M %v or number of binaries %v", fv.BigM, len(tv.Active))
+	}
+
+	obj := &gurobi.LinExpr{}
+	obj.AddTerm(tv.Cost, 1.0).AddTerm(fv.Cost, 1.0)
+	if err := model.SetObjective(obj, gurobi.Minimize); err != nil {
+		t.Errorf("There was an issue setting the objective: %v", err)
+	}
+	if err := model.Optimize(); err != nil {
+		t.Errorf("There was an issue optimizing: %v", err)
+	}
+
+	// Test
+	if objVal, err := model.ObjVal(); err != nil || math.Abs(objVal-tariff.Cost(15)) > 1e-6 {
+		t.Errorf("expected an objective of %v; received %v (%v)", tariff.Cost(15), objVal, err)
+	}
+}
@@ -0,0 +1,76 @@
+package gurobi_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+threads_test.go
+Description:
+	Tests the thread budgeting helpers.
+*/
+
+/*
+TestDetectCPUs1
+Description:
+
+	Verifies that DetectCPUs honours a cgroup v2 quota of 1.5 CPUs (rounded
+	up to 2) and ignores an unlimited quota.
+*/
+func TestDetectCPUs1(t *testing.T) {
+	root, err := os.MkdirTemp("", "cgroup")
+	if err != nil {
+		t.Errorf("There was an issue creating a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	oldRoot := gurobi.CgroupRoot
+	gurobi.CgroupRoot = root
+	defer func() { gurobi.CgroupRoot = oldRoot }()
+
+	if err := os.WriteFile(filepath.Join(root, "cpu.max"), []byte("150000 100000\n"), 0o644); err != nil {
+		t.Errorf("There was an issue writing cpu.max: %v", err)
+	}
+	expected := 2
+	if runtime.NumCPU() < expected {
+		expected = runtime.NumCPU()
+	}
+	if cpus := gurobi.DetectCPUs(); cpus != expected {
+		t.Errorf("expected %v CPUs; received %v", expected, cpus)
+	}
+
+	if err := os.WriteFile(filepath.Join(root, "cpu.max"), []byte("max 100000\n"), 0o644); err != nil {
+		t.Errorf("There was an issue writing cpu.max: %v", err)
+	}
+	if cpus := gurobi.DetectCPUs(); cpus != runtime.NumCPU() {
+		t.Errorf("expected %v CPUs; received %v", runtime.NumCPU(), cpus)
+	}
+}
+
+/*
+TestModel_SetThreads1
+Description:
+
+	Verifies that SetThreads rejects negative values other than AutoThreads
+	and records the detected number of CPUs for AutoThreads.
+*/
+func TestModel_SetThreads1(t *testing.T) {
+	model := gurobi.NewDryRunModel("threads1")
+	defer model.Free()
+
+	if err := model.SetThreads(-2); err == nil {
+		t.Errorf("expected an error for -2 threads, but none were thrown!")
+	}
+
+	if err := model.SetThreads(gurobi.AutoThreads); err != nil {
+		t.Errorf("There was an issue setting the threads: %v", err)
+	}
+	if threads := model.DryRun().Params["Threads"]; threads == "" || threads == "-1" {
+		t.Errorf("expected the detected number of threads to be recorded; received %q", threads)
+	}
+}
@@ -0,0 +1,64 @@
+package gurobi_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+timeseries_test.go
+Description:
+	Tests the time-indexed variable grid.
+*/
+
+/*
+TestModel_AddTimeSeriesVars1
+Description:
+
+	Tests the lookup of the variables of an hourly grid by time and their
+	sums over windows.
+*/
+func TestModel_AddTimeSeriesVars1(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("timeseries1")
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Algorithm
+	output, err := model.AddTimeSeriesVars(24, time.Hour, start, gurobi.Continuous, 0.0, 100.0, "output")
+	if err != nil {
+		t.Fatalf("There was an issue adding the time series: %v", err)
+	}
+
+	// Test
+	if output.Len() != 24 || len(model.Variables) != 24 || !output.End().Equal(start.Add(24*time.Hour)) {
+		t.Errorf("unexpected grid: %v periods until %v", output.Len(), output.End())
+	}
+
+	if v, err := output.At(start.Add(90 * time.Minute)); err != nil || v != output.Vars[1] {
+		t.Errorf("expected 01:30 to fall in period 1; received %v (%v)", v, err)
+	}
+	if _, err := output.At(start.Add(24 * time.Hour)); err == nil {
+		t.Errorf("expected an error for a time at the end of the horizon, but none were thrown!")
+	}
+	if _, err := output.At(start.Add(-time.Second)); err == nil {
+		t.Errorf("expected an error for a time before the horizon, but none were thrown!")
+	}
+
+	// The periods starting at 06:00, 07:00 and 08:00.
+	sum, err := output.Sum(start.Add(5*time.Hour+time.Minute), start.Add(9*time.Hour))
+	if err != nil || len(sum.ToMap()) != 3 || sum.ToMap()[output.Vars[6]] != 1.0 || sum.ToMap()[output.Vars[8]] != 1.0 {
+		t.Errorf("expected the sum of periods 6 to 8; received %v (%v)", sum, err)
+	}
+	if all, err := output.Sum(start.Add(-time.Hour), start.Add(48*time.Hour)); err != nil || len(all.ToMap()) != 24 {
+		t.Errorf("expected a window past the horizon to be clipped; received %v (%v)", all, err)
+	}
+	if _, err := output.Sum(start.Add(time.Hour), start); err == nil {
+		t.Errorf("expected an error for a reversed window, but none were thrown!")
+	}
+
+	if _, err := model.AddTimeSeriesVars(0, time.Hour, start, gurobi.Continuous, 0.0, 1.0, "empty"); err == nil {
+		t.Errorf("expected an error for an empty horizon, but none were thrown!")
+	}
+}
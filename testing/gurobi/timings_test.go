@@ -0,0 +1,61 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+timings_test.go
+Description:
+	Tests the parsing of the phase timings of a solve from its log.
+*/
+
+const timingsMIPLog = `Presolve removed 2 rows and 1 columns
+Presolve time: 0.05s
+Presolved: 10 rows, 12 columns, 40 nonzeros
+
+Root relaxation: objective 4.500000e+01, 12 iterations, 0.31 seconds (0.01 work units)
+
+Explored 1 nodes (12 simplex iterations) in 1.25 seconds (0.02 work units)
+Thread count was 8 (of 8 available processors)
+`
+
+/*
+TestParseSolveTimings1
+Description:
+
+	Tests that the presolve, root relaxation and total times are parsed from a MIP log.
+*/
+func TestParseSolveTimings1(t *testing.T) {
+	// Algorithm
+	timings := gurobi.ParseSolveTimings(timingsMIPLog)
+
+	// Test
+	if timings.Presolve != 0.05 || timings.RootRelaxation != 0.31 || timings.Total != 1.25 {
+		t.Errorf("unexpected timings: %+v", timings)
+	}
+}
+
+/*
+TestTimingLogParser_Feed1
+Description:
+
+	Tests that lines which are split over several messages are parsed, and
+	that the root relaxation time of an LP is 0.
+*/
+func TestTimingLogParser_Feed1(t *testing.T) {
+	// Constants
+	parser := &gurobi.TimingLogParser{}
+
+	// Algorithm
+	parser.Feed("Presolve ti")
+	parser.Feed("me: 0.20s\nSolved in 3 iterations and 0")
+	parser.Feed(".40 seconds (0.00 work units)\n")
+
+	// Test
+	if parser.Timings.Presolve != 0.20 || parser.Timings.RootRelaxation != 0 || parser.Timings.Total != 0.40 {
+		t.Errorf("unexpected timings: %+v", parser.Timings)
+	}
+}
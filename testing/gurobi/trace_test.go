@@ -0,0 +1,50 @@
+package gurobi_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+trace_test.go
+Description:
+	Tests the tracing of calls into the Gurobi C library.
+*/
+
+/*
+TestSetTraceWriter1
+Description:
+
+	Verifies that the creation of an environment is traced with its argument
+	and that nothing is written once tracing is turned off.
+*/
+func TestSetTraceWriter1(t *testing.T) {
+	// Constants
+	var buf bytes.Buffer
+	gurobi.SetTraceWriter(&buf)
+	defer gurobi.SetTraceWriter(nil)
+
+	// Algorithm
+	env, err := gurobi.NewEnv("trace1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+
+	// Test
+	if !strings.Contains(buf.String(), `GRBloadenv("trace1.log") = 0`) {
+		t.Errorf("expected the call to GRBloadenv to be traced; received %q", buf.String())
+	}
+
+	gurobi.SetTraceWriter(nil)
+	buf.Reset()
+	env2, _ := gurobi.NewEnv("trace1.log")
+	defer env2.Free()
+
+	if buf.Len() != 0 {
+		t.Errorf("expected no trace output after turning tracing off; received %q", buf.String())
+	}
+}
@@ -0,0 +1,103 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+tune_test.go
+Description:
+	Tests the tuning functions of the gurobi package.
+*/
+
+/*
+TestTuneLogParser_Feed1
+Description:
+
+	Tests that the parser turns the tuner's log output into trials, even
+	when lines are split across messages.
+*/
+func TestTuneLogParser_Feed1(t *testing.T) {
+	// Constants
+	parser := gurobi.TuneLogParser{}
+	messages := []string{
+		"Testing candidate parameter set 2...\n",
+		"\n\tMethod 2\n\tPresolve 0\n\n",
+		"Solving with random seed #1 ... runtime 0.52s\n",
+		"Solving with random seed #2 ... run",
+		"time 0.48s\n",
+		"Progress so far: baseline runtime 0.61s, best runtime 0.50s\n",
+	}
+
+	// Algorithm
+	trials := []gurobi.TuneTrial{}
+	for _, message := range messages {
+		trials = append(trials, parser.Feed(message)...)
+	}
+
+	// Test
+	if len(trials) != 1 {
+		t.Fatalf("expected 1 trial; received %v", len(trials))
+	}
+
+	trial := trials[0]
+	if trial.Candidate != 2 {
+		t.Errorf("expected candidate 2; received %v", trial.Candidate)
+	}
+
+	if trial.Params["Method"] != "2" || trial.Params["Presolve"] != "0" || len(trial.Params) != 2 {
+		t.Errorf("unexpected parameters: %v", trial.Params)
+	}
+
+	if len(trial.Runtimes) != 2 || trial.Runtime() != 0.5 {
+		t.Errorf("unexpected runtimes: %v", trial.Runtimes)
+	}
+
+	if trial.MIPGap >= 0 {
+		t.Errorf("expected no MIP gap to be reported; received %v", trial.MIPGap)
+	}
+}
+
+/*
+TestTuneLogParser_Feed2
+Description:
+
+	Tests that the parser records the MIP gap of a trial.
+*/
+func TestTuneLogParser_Feed2(t *testing.T) {
+	// Constants
+	parser := gurobi.TuneLogParser{}
+
+	// Algorithm
+	parser.Feed("Testing candidate parameter set 5...\n\n\tMIPFocus 1\n\n")
+	parser.Feed("Solving with random seed #1 ... MIP gap 2.50%\n")
+	trial, ok := parser.Flush()
+
+	// Test
+	if !ok {
+		t.Fatalf("expected a trial to be flushed")
+	}
+
+	if trial.MIPGap != 0.025 {
+		t.Errorf("expected a MIP gap of 0.025; received %v", trial.MIPGap)
+	}
+}
+
+/*
+TestParseParamsFile1
+Description:
+
+	Tests the parsing of a .prm file.
+*/
+func TestParseParamsFile1(t *testing.T) {
+	// Constants
+	contents := "# Parameter settings\nMethod  2\nLogFile my log.txt\n\n"
+
+	// Test
+	params := gurobi.ParseParamsFile(contents)
+	if len(params) != 2 || params["Method"] != "2" || params["LogFile"] != "my log.txt" {
+		t.Errorf("unexpected parameters: %v", params)
+	}
+}
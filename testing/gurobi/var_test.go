@@ -1,6 +1,7 @@
 package gurobi_test
 
 import (
+	"os"
 	"testing"
 
 	"github.com/MatProGo-dev/Gurobi.go/gurobi"
@@ -208,3 +209,91 @@ func TestVar_SetObj1(t *testing.T) {
 		t.Errorf("The new obj value was %v; expected %v", newObjVal, 1.0)
 	}
 }
+
+/*
+TestVar_SetAttr1
+Description:
+
+	Tests that SetAttr converts an int to the type of the UB attribute and
+	that GetAttr returns it as a float64.
+*/
+func TestVar_SetAttr1(t *testing.T) {
+	// Create environment.
+	env, err := gurobi.NewEnv("setattr1.log")
+	if err != nil {
+		t.Errorf("There was an issue creating the new Env: %v", err)
+	}
+	defer env.Free()
+	defer os.Remove("setattr1.log")
+
+	// Create an empty model.
+	model, err := gurobi.NewModel("setattr1", env)
+	if err != nil {
+		t.Errorf("There was an issue creating the new model: %v", err)
+	}
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+
+	if err := x.SetAttr("UB", 3); err != nil {
+		t.Errorf("There was an issue setting the upper bound: %v", err)
+	}
+	if err := model.Update(); err != nil {
+		t.Errorf("There was an issue updating the model: %v", err)
+	}
+
+	ub, err := x.GetAttr("UB")
+	if err != nil {
+		t.Errorf("There was an issue getting the upper bound: %v", err)
+	}
+	if ub != 3.0 {
+		t.Errorf("expected an upper bound of 3.0; received %v (%T)", ub, ub)
+	}
+
+	name, err := x.GetAttr("VarName")
+	if err != nil {
+		t.Errorf("There was an issue getting the name: %v", err)
+	}
+	if name != "x" {
+		t.Errorf("expected the name x; received %v", name)
+	}
+
+	if err := x.SetAttr("RHS", 1.0); err == nil {
+		t.Errorf("expected an error when setting a constraint attribute on a variable, but none were thrown!")
+	}
+}
+
+/*
+TestVar_SetAttr2
+Description:
+
+	Tests that SetAttr records values of every supported type on a dry-run
+	model and rejects values of other types.
+*/
+func TestVar_SetAttr2(t *testing.T) {
+	model := gurobi.NewDryRunModel("setattr2")
+	defer model.Free()
+
+	x, err := model.AddVar(gurobi.CONTINUOUS, 0.0, 0.0, 10.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable to the model: %v", err)
+	}
+
+	for attr, value := range map[string]interface{}{
+		"UB":             5.0,
+		"VType":          gurobi.Binary,
+		"BranchPriority": int32(2),
+		"VarName":        "y",
+	} {
+		if err := x.SetAttr(attr, value); err != nil {
+			t.Errorf("There was an issue setting %v: %v", attr, err)
+		}
+	}
+
+	if err := x.SetAttr("UB", []float64{1.0}); err == nil {
+		t.Errorf("expected an error for a slice value, but none were thrown!")
+	}
+}
@@ -0,0 +1,150 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+vartype_test.go
+Description:
+	This file tests the VarType type in the gurobi package.
+*/
+
+/*
+TestVarType_String1
+Description:
+
+	Tests that each of the valid VarTypes has the expected name.
+*/
+func TestVarType_String1(t *testing.T) {
+	// Constants
+	expected := map[gurobi.VarType]string{
+		gurobi.Continuous: "Continuous",
+		gurobi.Binary:     "Binary",
+		gurobi.Integer:    "Integer",
+		gurobi.SemiCont:   "SemiCont",
+		gurobi.SemiInt:    "SemiInt",
+	}
+
+	// Test
+	for vt, name := range expected {
+		if vt.String() != name {
+			t.Errorf("expected VarType %v to be named %v; received %v", int8(vt), name, vt.String())
+		}
+	}
+}
+
+/*
+TestVarType_Check1
+Description:
+
+	Tests that Check() rejects a vtype which is not recognized by Gurobi.
+*/
+func TestVarType_Check1(t *testing.T) {
+	// Constants
+	vt := gurobi.VarType('X')
+
+	// Test
+	err := vt.Check()
+	if err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+
+	if vt.String() != "VarType(88)" {
+		t.Errorf("unexpected string for invalid VarType: %v", vt.String())
+	}
+}
+
+/*
+TestVarType_ToVarType1
+Description:
+
+	Tests that the legacy int8 constants are accepted by ToVarType().
+*/
+func TestVarType_ToVarType1(t *testing.T) {
+	// Constants
+	legacy := []int8{gurobi.CONTINUOUS, gurobi.BINARY, gurobi.INTEGER}
+
+	// Test
+	for _, vtype := range legacy {
+		vt, err := gurobi.ToVarType(vtype)
+		if err != nil {
+			t.Errorf("unexpected error converting %v: %v", vtype, err)
+		}
+
+		if int8(vt) != vtype {
+			t.Errorf("expected %v; received %v", vtype, int8(vt))
+		}
+	}
+}
+
+/*
+TestModel_AddVarInt8
+Description:
+
+	Tests that AddVarInt8() adds variables whose type is a typed int8 and
+	rejects an invalid type.
+*/
+func TestModel_AddVarInt8(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("addvarint8")
+	defer model.Free()
+	var vtype int8 = gurobi.BINARY
+
+	// Algorithm
+	x, err := model.AddVarInt8(vtype, 1.0, 0.0, 1.0, "x", []*gurobi.Constr{}, []float64{})
+	if err != nil {
+		t.Errorf("There was an issue adding a variable with an int8 type: %v", err)
+	}
+
+	// Test
+	if x == nil || x.Index != 0 {
+		t.Errorf("expected the first variable of the model; received %v", x)
+	}
+	if _, err := model.AddVarInt8('X', 1.0, 0.0, 1.0, "y", []*gurobi.Constr{}, []float64{}); err == nil {
+		t.Errorf("expected an error for an invalid int8 type, but none were thrown!")
+	}
+}
+
+/*
+TestModel_AddVarsInt8
+Description:
+
+	Tests that AddVarsInt8() and AddConstrsInt8() accept raw int8 types and
+	senses and reject invalid ones, like their typed counterparts.
+*/
+func TestModel_AddVarsInt8(t *testing.T) {
+	// Constants
+	model := gurobi.NewDryRunModel("addvarsint8")
+	defer model.Free()
+
+	// Algorithm
+	vars, err := model.AddVarsInt8(
+		[]int8{gurobi.BINARY, gurobi.CONTINUOUS},
+		[]float64{1.0, 0.0}, []float64{0.0, 0.0}, []float64{1.0, 10.0},
+		[]string{"x", "y"}, [][]*gurobi.Constr{}, [][]float64{},
+	)
+	if err != nil {
+		t.Errorf("There was an issue adding variables with int8 types: %v", err)
+	}
+	_, err = model.AddConstrsInt8(
+		[][]*gurobi.Var{vars}, [][]float64{{1.0, 1.0}},
+		[]int8{gurobi.SenseLessThan}, []float64{5.0}, []string{"c"},
+	)
+	if err != nil {
+		t.Errorf("There was an issue adding a constraint with an int8 sense: %v", err)
+	}
+
+	// Test
+	if len(vars) != 2 {
+		t.Errorf("expected 2 variables; received %v", len(vars))
+	}
+	if _, err := model.AddVarsInt8([]int8{'X'}, []float64{0.0}, []float64{0.0}, []float64{1.0}, []string{"z"}, [][]*gurobi.Constr{}, [][]float64{}); err == nil {
+		t.Errorf("expected an error for an invalid int8 type, but none were thrown!")
+	}
+	if _, err := model.AddConstrsInt8([][]*gurobi.Var{vars}, [][]float64{{1.0, 1.0}}, []int8{'!'}, []float64{5.0}, []string{"d"}); err == nil {
+		t.Errorf("expected an error for an invalid int8 sense, but none were thrown!")
+	}
+}
@@ -0,0 +1,90 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+verify_test.go
+Description:
+	Tests the in-Go solution verifier.
+*/
+
+// verifyTestData is x0 + x1 <= 4, x0 - x1 == 1 with x0 in [0, 3] (integer) and x1 in [0, 10].
+func verifyTestData() *gurobi.LinearData {
+	return &gurobi.LinearData{
+		Beg:    []int32{0, 2, 4},
+		Ind:    []int32{0, 1, 0, 1},
+		Val:    []float64{1, 1, 1, -1},
+		Senses: []gurobi.Sense{gurobi.Le, gurobi.Eq},
+		RHS:    []float64{4, 1},
+		LB:     []float64{0, 0},
+		UB:     []float64{3, 10},
+		VTypes: []gurobi.VarType{gurobi.Integer, gurobi.Continuous},
+	}
+}
+
+/*
+TestLinearData_Verify1
+Description:
+
+	Verifies that a feasible solution produces an empty report.
+*/
+func TestLinearData_Verify1(t *testing.T) {
+	// Algorithm
+	report, err := verifyTestData().Verify([]float64{2, 1}, 1e-6)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// Test
+	if !report.IsFeasible() {
+		t.Errorf("expected a feasible solution; received %+v", report)
+	}
+}
+
+/*
+TestLinearData_Verify2
+Description:
+
+	Verifies that constraint, bound and integrality violations are all reported.
+*/
+func TestLinearData_Verify2(t *testing.T) {
+	// Algorithm
+	report, err := verifyTestData().Verify([]float64{3.5, 1}, 1e-6)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// Test
+	if len(report.Constraints) != 2 {
+		t.Errorf("expected 2 constraint violations; received %v", report.Constraints)
+	}
+
+	if len(report.Bounds) != 1 || report.Bounds[0].Index != 0 {
+		t.Errorf("expected a bound violation for x0; received %v", report.Bounds)
+	}
+
+	if len(report.Integrality) != 1 {
+		t.Errorf("expected an integrality violation; received %v", report.Integrality)
+	}
+
+	if report.MaxViolation != 1.5 {
+		t.Errorf("expected a maximum violation of 1.5; received %v", report.MaxViolation)
+	}
+}
+
+/*
+TestLinearData_Verify3
+Description:
+
+	Verifies that Verify() throws an error when x has the wrong length.
+*/
+func TestLinearData_Verify3(t *testing.T) {
+	// Test
+	if _, err := verifyTestData().Verify([]float64{1}, 1e-6); err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
@@ -0,0 +1,53 @@
+package gurobi_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+)
+
+/*
+violation_test.go
+Description:
+	Tests the ViolationSummary object.
+*/
+
+/*
+TestViolationSummary_Check1
+Description:
+
+	Verifies that Check() only fails when a violation exceeds the tolerance.
+*/
+func TestViolationSummary_Check1(t *testing.T) {
+	// Constants
+	vs := gurobi.ViolationSummary{
+		WorstConstr: gurobi.Offender{Index: 3, Name: "c3", Violation: 1e-7},
+		WorstBound:  gurobi.Offender{Index: -1},
+		WorstInt:    gurobi.Offender{Index: 0, Name: "x0", Violation: 1e-4},
+	}
+
+	// Test
+	if err := vs.Check(1e-3); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := vs.Check(1e-5); err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
+
+/*
+TestModel_ViolationSummary1
+Description:
+
+	Verifies that ViolationSummary() throws an error for an uninitialized model.
+*/
+func TestModel_ViolationSummary1(t *testing.T) {
+	// Constants
+	var model0 *gurobi.Model
+
+	// Test
+	if _, err := model0.ViolationSummary(); err == nil {
+		t.Errorf("expected an error, but none were thrown!")
+	}
+}
@@ -0,0 +1,185 @@
+package queue_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+	"github.com/MatProGo-dev/Gurobi.go/queue"
+)
+
+/*
+queue_test.go
+Description:
+	Tests the job queue with a fake solve, which does not need a license.
+*/
+
+// newFakeQueue creates a queue whose workers have no environment and solve jobs with solveFn.
+func newFakeQueue(workers int, solveFn queue.SolveFunc) *queue.Queue {
+	q := queue.New(workers)
+	q.NewEnv = func() (*gurobi.Env, error) { return nil, nil }
+	q.Solve = solveFn
+	return q
+}
+
+// newJob creates a job with a model of a single variable.
+func newJob(id string) *queue.Job {
+	spec := gurobi.NewModelSpec(id)
+	spec.AddVar(gurobi.Continuous, 1.0, 0.0, 1.0, "x")
+	return &queue.Job{ID: id, Spec: spec, Params: map[string]string{"Threads": "1"}}
+}
+
+/*
+TestQueue_Run1
+Description:
+
+	Solves jobs whose first attempt fails and verifies that they are retried,
+	and that a job which keeps failing reports its last error.
+*/
+func TestQueue_Run1(t *testing.T) {
+	// Constants
+	var calls int32
+	q := newFakeQueue(1, func(ctx context.Context, env *gurobi.Env, job *queue.Job) (*queue.Result, error) {
+		n := atomic.AddInt32(&calls, 1)
+		if job.ID == "broken" || n == 1 {
+			return nil, errors.New("the license server is busy")
+		}
+		return &queue.Result{X: []float64{1.0}}, nil
+	})
+	q.Retry = queue.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond}
+
+	jobs := make(chan *queue.Job, 2)
+	jobs <- newJob("flaky")
+	jobs <- newJob("broken")
+	close(jobs)
+
+	// Algorithm
+	results := map[string]*queue.Result{}
+	for result := range q.Run(context.Background(), jobs) {
+		results[result.JobID] = result
+	}
+
+	// Test
+	if flaky := results["flaky"]; flaky == nil || flaky.Error != "" || flaky.Attempts != 2 {
+		t.Errorf("expected flaky to succeed on the second attempt; received %+v", flaky)
+	}
+	if broken := results["broken"]; broken == nil || broken.Error == "" || broken.Attempts != 3 {
+		t.Errorf("expected broken to fail after 3 attempts; received %+v", broken)
+	}
+}
+
+/*
+TestQueue_Run2
+Description:
+
+	Verifies that an attempt which takes longer than the timeout is
+	abandoned, and that the timeout is not retried if RetryIf refuses it.
+*/
+func TestQueue_Run2(t *testing.T) {
+	// Constants
+	q := newFakeQueue(1, func(ctx context.Context, env *gurobi.Env, job *queue.Job) (*queue.Result, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	q.Timeout = 10 * time.Millisecond
+	q.Retry = queue.RetryPolicy{
+		MaxAttempts: 3,
+		RetryIf: func(err error) bool {
+			return !errors.Is(err, context.DeadlineExceeded)
+		},
+	}
+
+	jobs := make(chan *queue.Job, 1)
+	jobs <- newJob("slow")
+	close(jobs)
+
+	// Algorithm
+	result := <-q.Run(context.Background(), jobs)
+
+	// Test
+	if result == nil || result.Attempts != 1 || result.Error != context.DeadlineExceeded.Error() {
+		t.Errorf("expected a single attempt which timed out; received %+v", result)
+	}
+}
+
+/*
+TestQueue_RunDir1
+Description:
+
+	Writes job bundles to an inbox, solves them into an outbox and verifies
+	that the result bundles are written and that a second run skips them.
+*/
+func TestQueue_RunDir1(t *testing.T) {
+	// Constants
+	inbox, outbox := t.TempDir(), filepath.Join(t.TempDir(), "results")
+	for _, id := range []string{"a", "b"} {
+		if err := queue.WriteJobFile(inbox, newJob(id)); err != nil {
+			t.Errorf("There was an issue writing job %v: %v", id, err)
+		}
+	}
+	q := newFakeQueue(2, func(ctx context.Context, env *gurobi.Env, job *queue.Job) (*queue.Result, error) {
+		if job.Params["Threads"] != "1" || len(job.Spec.Vars) != 1 {
+			return nil, errors.New("the job was not read back")
+		}
+		return &queue.Result{X: []float64{0.0}}, nil
+	})
+
+	// Algorithm
+	solved, err := q.RunDir(context.Background(), inbox, outbox)
+	if err != nil {
+		t.Errorf("There was an issue running the inbox: %v", err)
+	}
+	again, err := q.RunDir(context.Background(), inbox, outbox)
+	if err != nil {
+		t.Errorf("There was an issue running the inbox again: %v", err)
+	}
+
+	// Test
+	if solved != 2 || again != 0 {
+		t.Errorf("expected 2 jobs in the first run and none in the second; received %v and %v", solved, again)
+	}
+	result, err := queue.ReadResultFile(filepath.Join(outbox, "b.result.json"))
+	if err != nil {
+		t.Errorf("There was an issue reading the result of b: %v", err)
+	}
+	if result == nil || result.JobID != "b" || result.Error != "" || len(result.X) != 1 {
+		t.Errorf("expected a successful result for b; received %+v", result)
+	}
+	if entries, _ := os.ReadDir(inbox); len(entries) != 2 {
+		t.Errorf("expected the inbox to keep its 2 bundles; received %v entries", len(entries))
+	}
+}
+
+/*
+TestWriteJobFile1
+Description:
+
+	Verifies that job IDs which could escape the bundle directory are
+	rejected, both when writing and when reading a bundle.
+*/
+func TestWriteJobFile1(t *testing.T) {
+	// Constants
+	dir := t.TempDir()
+	badIDs := []string{"", "../escape", "a/b", `a\b`, ".."}
+
+	// Test
+	for _, id := range badIDs {
+		if err := queue.WriteJobFile(dir, newJob(id)); err == nil {
+			t.Errorf("expected an error for the job ID %q, but none were thrown!", id)
+		}
+	}
+
+	path := filepath.Join(dir, "bad.json")
+	bundle := `{"id": "../escape", "spec": {"name": "bad"}}`
+	if err := os.WriteFile(path, []byte(bundle), 0644); err != nil {
+		t.Errorf("There was an issue writing the bundle: %v", err)
+	}
+	if _, err := queue.ReadJobFile(path); err == nil {
+		t.Errorf("expected an error for a bundle with the ID ../escape, but none were thrown!")
+	}
+}
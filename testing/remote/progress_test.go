@@ -0,0 +1,55 @@
+package remote_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+	"github.com/MatProGo-dev/Gurobi.go/remote"
+)
+
+/*
+progress_test.go
+Description:
+	Tests the Server-Sent Events stream of a Monitor.
+*/
+
+/*
+TestProgressHandler_ServeHTTP1
+Description:
+
+	Connects to the stream of a monitor which has not seen a solve yet and
+	checks that the initial progress event is sent until the client
+	disconnects.
+*/
+func TestProgressHandler_ServeHTTP1(t *testing.T) {
+	// Constants
+	handler := remote.NewProgressHandler(gurobi.NewMonitor())
+	handler.Interval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req := httptest.NewRequest("GET", "/progress", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	// Algorithm
+	handler.ServeHTTP(rec, req)
+
+	// Test
+	if contentType := rec.Header().Get("Content-Type"); contentType != "text/event-stream" {
+		t.Errorf("expected an event stream; received the content type %q", contentType)
+	}
+	body := rec.Body.String()
+	if strings.Count(body, "event: progress\n") != 1 {
+		t.Errorf("expected a single progress event; received %q", body)
+	}
+	if !strings.Contains(body, `"where":""`) || !strings.Contains(body, `"gap":null`) {
+		t.Errorf("expected an empty where code and a null gap; received %q", body)
+	}
+	if strings.Contains(body, "event: done") {
+		t.Errorf("expected no done event before a solve; received %q", body)
+	}
+}
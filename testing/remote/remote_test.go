@@ -0,0 +1,147 @@
+package remote_test
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/Gurobi.go/gurobi"
+	"github.com/MatProGo-dev/Gurobi.go/remote"
+)
+
+/*
+remote_test.go
+Description:
+	Tests remote model mirroring against a server of dry-run models.
+*/
+
+// newPipe connects a Client to a Server without an environment.
+func newPipe() (*remote.Client, func()) {
+	clientConn, serverConn := net.Pipe()
+	server := &remote.Server{}
+	done := make(chan struct{})
+	go func() {
+		server.ServeConn(serverConn)
+		close(done)
+	}()
+	client := remote.NewClient(clientConn)
+	return client, func() {
+		client.Close()
+		<-done
+	}
+}
+
+/*
+TestClient_AddVar1
+Description:
+
+	Tests that variables and constraints added by the client get the remote
+	indices, and that attributes, the sense and parameters can be set.
+*/
+func TestClient_AddVar1(t *testing.T) {
+	// Constants
+	client, closeFn := newPipe()
+	defer closeFn()
+
+	// Algorithm
+	x, err := client.AddVar(gurobi.Continuous, 1.0, 0.0, 10.0, "x")
+	if err != nil {
+		t.Errorf("There was an issue adding x: %v", err)
+	}
+	y, err := client.AddVar(gurobi.Binary, 2.0, 0.0, 1.0, "y")
+	if err != nil {
+		t.Errorf("There was an issue adding y: %v", err)
+	}
+	c, err := client.AddConstr([]int32{x, y}, []float64{1.0, 1.0}, gurobi.Le, 5.0, "c")
+	if err != nil {
+		t.Errorf("There was an issue adding c: %v", err)
+	}
+
+	// Test
+	if x != 0 || y != 1 || c != 0 {
+		t.Errorf("unexpected indices: x=%v, y=%v, c=%v", x, y, c)
+	}
+
+	if err := client.SetVarAttr(x, "UB", 4.0); err != nil {
+		t.Errorf("There was an issue setting the bound of x: %v", err)
+	}
+	if err := client.SetModelSense(gurobi.Maximize); err != nil {
+		t.Errorf("There was an issue setting the sense: %v", err)
+	}
+	if err := client.SetParam("TimeLimit", "10"); err != nil {
+		t.Errorf("There was an issue setting a parameter: %v", err)
+	}
+	if err := client.SetParam("mipgap", "0.01"); err != nil {
+		t.Errorf("There was an issue setting a parameter in lower case: %v", err)
+	}
+	for _, name := range []string{"LogFile", "ResultFile", "NodefileDir", "Unknown"} {
+		if err := client.SetParam(name, "/tmp/out"); err == nil {
+			t.Errorf("expected an error for setting %v remotely, but none were thrown!", name)
+		}
+	}
+}
+
+/*
+TestClient_Errors1
+Description:
+
+	Tests that errors of the remote model are returned as RemoteErrors.
+*/
+func TestClient_Errors1(t *testing.T) {
+	// Constants
+	client, closeFn := newPipe()
+	defer closeFn()
+
+	// Test
+	if _, err := client.AddConstr([]int32{3}, []float64{1.0}, gurobi.Eq, 1.0, "c"); err == nil {
+		t.Errorf("expected an error for a constraint on a missing variable, but none were thrown!")
+	}
+
+	if _, err := client.Optimize(); err == nil {
+		t.Errorf("expected an error for optimizing a dry-run model, but none were thrown!")
+	} else {
+		var remoteErr remote.RemoteError
+		if !errors.As(err, &remoteErr) || remoteErr.Op != remote.OpOptimize {
+			t.Errorf("expected a RemoteError for the optimize operation; received %v", err)
+		}
+		if !strings.Contains(err.Error(), gurobi.ErrDryRun.Error()) {
+			t.Errorf("expected the dry-run error to be reported; received %v", err)
+		}
+	}
+}
+
+/*
+TestClient_Load1
+Description:
+
+	Tests that a model spec is loaded on a dry-run server.
+*/
+func TestClient_Load1(t *testing.T) {
+	// Constants
+	client, closeFn := newPipe()
+	defer closeFn()
+
+	spec := &gurobi.ModelSpec{
+		Name:  "load1",
+		Sense: gurobi.Minimize,
+		Vars: []gurobi.VarSpec{
+			{Name: "x", Type: gurobi.Continuous, UB: 1.0},
+			{Name: "y", Type: gurobi.Continuous, UB: 1.0},
+		},
+		Constrs: []gurobi.ConstrSpec{
+			{Name: "c", Ind: []int32{0, 1}, Val: []float64{1.0, 1.0}, Sense: gurobi.Ge, RHS: 1.0},
+		},
+	}
+
+	// Algorithm
+	if err := client.Load(spec); err != nil {
+		t.Errorf("There was an issue loading the spec: %v", err)
+	}
+	z, err := client.AddVar(gurobi.Continuous, 0.0, 0.0, 1.0, "z")
+
+	// Test
+	if err != nil || z != 2 {
+		t.Errorf("expected z to be the third variable; received %v (%v)", z, err)
+	}
+}